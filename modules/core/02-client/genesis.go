@@ -32,6 +32,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		}
 
 		k.SetClientState(ctx, client.ClientId, cs)
+		k.Hooks().AfterClientStateChanged(ctx, client.ClientId, cs)
 	}
 
 	for _, cs := range gs.ClientsConsensus {
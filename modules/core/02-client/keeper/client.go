@@ -1,13 +1,20 @@
 package keeper
 
 import (
+	"bytes"
+
 	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
 )
 
 // CreateClient generates a new client identifier and isolated prefix store for the provided client state.
@@ -31,10 +38,12 @@ func (k Keeper) CreateClient(
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
 	clientStore := k.ClientStore(ctx, clientID)
 
-	if err := clientState.Initialize(ctx, k.cdc, clientStore, consensusState); err != nil {
+	if err := clientState.Initialize(k.ClientContext(types.WithClientID(ctx, clientID)), k.cdc, clientStore, consensusState); err != nil {
 		return "", err
 	}
 
+	k.afterClientStateChanged(ctx, clientID)
+
 	k.Logger(ctx).Info("client created at height", "client-id", clientID, "height", clientState.GetLatestHeight().String())
 
 	defer telemetry.IncrCounterWithLabels(
@@ -61,13 +70,15 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
-	if err := clientState.VerifyClientMessage(ctx, k.cdc, clientStore, clientMsg); err != nil {
+	clientCtx := k.ClientContext(types.WithClientID(ctx, clientID))
+
+	if err := clientState.VerifyClientMessage(clientCtx, k.cdc, clientStore, clientMsg); err != nil {
 		return err
 	}
 
-	foundMisbehaviour := clientState.CheckForMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+	foundMisbehaviour := clientState.CheckForMisbehaviour(clientCtx, k.cdc, clientStore, clientMsg)
 	if foundMisbehaviour {
-		clientState.UpdateStateOnMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+		clientState.UpdateStateOnMisbehaviour(clientCtx, k.cdc, clientStore, clientMsg)
 
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
 
@@ -86,7 +97,7 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 		return nil
 	}
 
-	consensusHeights := clientState.UpdateState(ctx, k.cdc, clientStore, clientMsg)
+	consensusHeights := clientState.UpdateState(clientCtx, k.cdc, clientStore, clientMsg)
 
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
@@ -122,12 +133,14 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
-	if err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
+	if err := clientState.VerifyUpgradeAndUpdateState(k.ClientContext(types.WithClientID(ctx, clientID)), k.cdc, clientStore,
 		upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
 
+	k.afterClientStateChanged(ctx, clientID)
+
 	k.Logger(ctx).Info("client state upgraded", "client-id", clientID, "height", upgradedClient.GetLatestHeight().String())
 
 	defer telemetry.IncrCounterWithLabels(
@@ -143,3 +156,177 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 
 	return nil
 }
+
+// MigrateClientType migrates the client with the given identifier to a client of the given type.
+// The client identifier is kept, so the connections and channels built on top of the client remain
+// usable. Only 08-wasm clients wrapping 07-tendermint or 10-grandpa client states can be migrated,
+// to the wrapped client type: the wrapped client state and every stored consensus state are decoded
+// and stored in place of their 08-wasm wrappers, and the consensus metadata of the wrapped client
+// type the contract did not store is rebuilt. Other client metadata stored by the wasm contract is
+// left untouched. The migration is only committed if the migrated client is valid and Active.
+func (k Keeper) MigrateClientType(ctx sdk.Context, clientID, clientType string) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot migrate client with ID %s", clientID)
+	}
+
+	if clientState.ClientType() == clientType {
+		return sdkerrors.Wrapf(types.ErrInvalidClientTypeMigration, "client (%s) is already of type %s", clientID, clientType)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsAllowedClient(clientType) {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "client type %s is not registered in the allowlist", clientType)
+	}
+
+	wasmClientState, ok := clientState.(*wasmtypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidClientTypeMigration, "cannot migrate client of type %s, expected %s", clientState.ClientType(), exported.Wasm)
+	}
+
+	// the consensus metadata required by the migrated client is only known for 07-tendermint and 10-grandpa clients
+	if clientType != exported.Tendermint && clientType != exported.Grandpa {
+		return sdkerrors.Wrapf(types.ErrInvalidClientTypeMigration, "cannot migrate client to type %s, expected %s or %s", clientType, exported.Tendermint, exported.Grandpa)
+	}
+
+	migratedClientState, err := k.UnmarshalClientState(wasmClientState.Data)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidClientTypeMigration, "failed to decode wrapped client state: %s", err)
+	}
+
+	if migratedClientState.ClientType() != clientType {
+		return sdkerrors.Wrapf(types.ErrInvalidClientTypeMigration, "wrapped client state is of type %s, expected %s", migratedClientState.ClientType(), clientType)
+	}
+
+	if err := migratedClientState.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid wrapped client state")
+	}
+
+	// the migration is written to a cached context and committed once the migrated client is verified
+	cacheCtx, writeFn := ctx.CacheContext()
+	clientStore := k.ClientStore(cacheCtx, clientID)
+	heights, err := k.migrateConsensusStates(clientStore, clientType)
+	if err != nil {
+		return err
+	}
+
+	for _, height := range heights {
+		switch clientType {
+		case exported.Tendermint:
+			setTendermintConsensusMetadata(cacheCtx, clientStore, height)
+		case exported.Grandpa:
+			setGrandpaConsensusMetadata(cacheCtx, clientStore, height)
+		}
+	}
+
+	k.SetClientState(cacheCtx, clientID, migratedClientState)
+
+	if !k.HasClientConsensusState(cacheCtx, clientID, migratedClientState.GetLatestHeight()) {
+		return sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "no consensus state for the latest height %s of the migrated client", migratedClientState.GetLatestHeight())
+	}
+
+	if status := k.GetClientStatus(cacheCtx, migratedClientState, clientID); status != exported.Active {
+		return sdkerrors.Wrapf(types.ErrClientNotActive, "migrated client (%s) has status %s", clientID, status)
+	}
+
+	writeFn()
+
+	k.afterClientStateChanged(ctx, clientID)
+
+	k.Logger(ctx).Info("client type migrated", "client-id", clientID, "client-type", clientType)
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "migrate"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientType),
+			telemetry.NewLabel(types.LabelClientID, clientID),
+		},
+	)
+
+	EmitMigrateClientTypeEvent(ctx, clientID, clientState.ClientType(), migratedClientState)
+
+	return nil
+}
+
+// migrateConsensusStates replaces every 08-wasm consensus state in the client store with the
+// consensus state it wraps, which must be of the given client type. The heights of the migrated
+// consensus states are returned.
+func (k Keeper) migrateConsensusStates(clientStore sdk.KVStore, clientType string) ([]exported.Height, error) {
+	store := prefix.NewStore(clientStore, []byte(host.KeyConsensusStatePrefix+"/"))
+
+	var keys, values [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		// skip consensus state metadata, stored under "consensusStates/<height>/<key>"
+		if bytes.Contains(iterator.Key(), []byte("/")) {
+			continue
+		}
+
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	heights := make([]exported.Height, len(keys))
+	for i, key := range keys {
+		height, err := types.ParseHeight(string(key))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidConsensus, "invalid consensus state height %s: %s", key, err)
+		}
+		heights[i] = height
+
+		consensusState, err := k.UnmarshalConsensusState(values[i])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidConsensus, "failed to decode consensus state at height %s: %s", key, err)
+		}
+
+		wasmConsensusState, ok := consensusState.(*wasmtypes.ConsensusState)
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidConsensus, "consensus state at height %s is of type %s, expected %s", key, consensusState.ClientType(), exported.Wasm)
+		}
+
+		migratedConsensusState, err := k.UnmarshalConsensusState(wasmConsensusState.Data)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidConsensus, "failed to decode wrapped consensus state at height %s: %s", key, err)
+		}
+
+		if migratedConsensusState.ClientType() != clientType {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidConsensus, "wrapped consensus state at height %s is of type %s, expected %s", key, migratedConsensusState.ClientType(), clientType)
+		}
+
+		if err := migratedConsensusState.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid wrapped consensus state at height %s", key)
+		}
+
+		store.Set(key, k.MustMarshalConsensusState(migratedConsensusState))
+	}
+
+	return heights, nil
+}
+
+// setTendermintConsensusMetadata stores the 07-tendermint metadata of the consensus state at the
+// given height of a migrated client. The processed time and height stored by the wasm contract
+// are kept, the missing ones are set to the current block time and height like for a consensus
+// state created by an update, so that delay periods are counted from the migration at the latest.
+func setTendermintConsensusMetadata(ctx sdk.Context, clientStore sdk.KVStore, height exported.Height) {
+	if len(clientStore.Get(ibctm.ProcessedTimeKey(height))) != 8 {
+		ibctm.SetProcessedTime(clientStore, height, uint64(ctx.BlockTime().UnixNano()))
+	}
+	if _, found := ibctm.GetProcessedHeight(clientStore, height); !found {
+		ibctm.SetProcessedHeight(clientStore, height, types.GetSelfHeight(ctx))
+	}
+	ibctm.SetIterationKey(clientStore, height)
+}
+
+// setGrandpaConsensusMetadata stores the 10-grandpa metadata of the consensus state at the given
+// height of a migrated client. As for 07-tendermint clients, the processed time and height stored by
+// the wasm contract are kept and the missing ones are set to the current block time and height.
+func setGrandpaConsensusMetadata(ctx sdk.Context, clientStore sdk.KVStore, height exported.Height) {
+	if len(clientStore.Get(grandpa.ProcessedTimeKey(height))) != 8 {
+		grandpa.SetProcessedTime(clientStore, height, uint64(ctx.BlockTime().UnixNano()))
+	}
+	if _, found := grandpa.GetProcessedHeight(clientStore, height); !found {
+		grandpa.SetProcessedHeight(clientStore, height, types.GetSelfHeight(ctx))
+	}
+}
@@ -8,12 +8,15 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 	localhost "github.com/cosmos/ibc-go/v7/modules/light-clients/09-localhost"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 )
 
@@ -473,3 +476,277 @@ func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 	}
 	suite.Require().True(contains)
 }
+
+func (suite *KeeperTestSuite) TestMigrateClientType() {
+	var (
+		path       *ibctesting.Path
+		clientType string
+	)
+
+	// wrapClient replaces the tendermint client state and consensus states of endpoint A with
+	// 08-wasm client and consensus states wrapping them, as stored by a wasm tendermint client.
+	wrapClient := func(clientState exported.ClientState) {
+		ctx := suite.chainA.GetContext()
+		clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+		clientKeeper.IterateConsensusStates(ctx, func(clientID string, cs clienttypes.ConsensusStateWithHeight) bool {
+			if clientID != path.EndpointA.ClientID {
+				return false
+			}
+
+			consensusState := cs.ConsensusState.GetCachedValue().(exported.ConsensusState)
+			data, err := suite.chainA.Codec.MarshalInterface(consensusState)
+			suite.Require().NoError(err)
+
+			clientKeeper.SetClientConsensusState(ctx, clientID, cs.Height, &wasmtypes.ConsensusState{
+				Data:      data,
+				Timestamp: consensusState.GetTimestamp(),
+			})
+			return false
+		})
+
+		data, err := suite.chainA.Codec.MarshalInterface(clientState)
+		suite.Require().NoError(err)
+		clientKeeper.SetClientState(ctx, path.EndpointA.ClientID, wasmtypes.NewClientState(data, []byte("code id"), clientState.GetLatestHeight().(clienttypes.Height)))
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {
+				wrapClient(path.EndpointA.GetClientState())
+			}, true,
+		},
+		{
+			"success: client with a 08-wasm identifier whose store was only written by the contract", func() {
+				ctx := suite.chainA.GetContext()
+				clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+				clientState := path.EndpointA.GetClientState()
+				consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+				// the contract stores no 07-tendermint consensus metadata
+				path.EndpointA.ClientID = clientKeeper.GenerateClientIdentifier(ctx, exported.Wasm)
+				clientKeeper.SetClientConsensusState(ctx, path.EndpointA.ClientID, clientState.GetLatestHeight(), consensusState)
+				wrapClient(clientState)
+
+				// the existing channel is built on top of the 08-wasm client
+				connection := path.EndpointA.GetConnection()
+				connection.ClientId = path.EndpointA.ClientID
+				path.EndpointA.SetConnection(connection)
+			}, true,
+		},
+		{
+			"client not found", func() {
+				path.EndpointA.ClientID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"client is already of the requested type", func() {}, false,
+		},
+		{
+			"client type cannot be migrated to", func() {
+				wrapClient(path.EndpointA.GetClientState())
+				clientType = exported.Localhost
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), clienttypes.NewParams(exported.Tendermint, exported.Localhost))
+			}, false,
+		},
+		{
+			"client type not allowed", func() {
+				wrapClient(path.EndpointA.GetClientState())
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), clienttypes.NewParams(exported.Wasm))
+			}, false,
+		},
+		{
+			"wrapped client state is of another client type", func() {
+				wrapClient(path.EndpointA.GetClientState())
+				clientType = exported.Solomachine
+			}, false,
+		},
+		{
+			"invalid wrapped client state", func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.ChainId = ""
+				wrapClient(clientState)
+			}, false,
+		},
+		{
+			"invalid wrapped consensus state", func() {
+				wrapClient(path.EndpointA.GetClientState())
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(
+					suite.chainA.GetContext(), path.EndpointA.ClientID, path.EndpointA.GetClientState().GetLatestHeight(),
+					&wasmtypes.ConsensusState{Data: []byte("invalid data"), Timestamp: 1},
+				)
+			}, false,
+		},
+		{
+			"migrated client is not active", func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				wrapClient(clientState)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			clientType = exported.Tendermint
+
+			expClientState := path.EndpointA.GetClientState()
+			expConsensusState := path.EndpointA.GetConsensusState(expClientState.GetLatestHeight())
+
+			tc.malleate()
+
+			prevClientState, _ := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.MigrateClientType(suite.chainA.GetContext(), path.EndpointA.ClientID, clientType)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expClientState, path.EndpointA.GetClientState())
+				suite.Require().Equal(expConsensusState, path.EndpointA.GetConsensusState(expClientState.GetLatestHeight()))
+
+				// every consensus state has the metadata of a 07-tendermint consensus state
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				ibctm.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+					_, found := ibctm.GetProcessedTime(clientStore, height)
+					suite.Require().True(found)
+					_, found = ibctm.GetProcessedHeight(clientStore, height)
+					suite.Require().True(found)
+					return false
+				})
+				suite.Require().NotNil(ibctm.GetIterationKey(clientStore, expClientState.GetLatestHeight()))
+
+				// the migrated client can be updated and keeps verifying packets of the existing channel
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				sequence, err := path.EndpointB.SendPacket(clienttypes.NewHeight(1, 1000), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(1, 1000), 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+			} else {
+				suite.Require().Error(err)
+
+				clientState, _ := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().Equal(prevClientState, clientState)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateClientTypeGrandpa() {
+	var (
+		clientID        string
+		clientState     *grandpa.ClientState
+		consensusStates map[clienttypes.Height]exported.ConsensusState
+	)
+
+	processedTime := uint64(time.Unix(1, 0).UnixNano())
+	latestHeight := clienttypes.NewHeight(2000, 10)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"wrapped consensus state is of another client type", func() {
+				consensusStates[clienttypes.NewHeight(2000, 9)] = suite.consensusState
+			}, false,
+		},
+		{
+			"no consensus state for the latest height", func() {
+				delete(consensusStates, latestHeight)
+			}, false,
+		},
+		{
+			"migrated client is not active", func() {
+				clientState.XFrozenHeight = &grandpa.ClientState_FrozenHeight{FrozenHeight: 9}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			ctx := suite.chainA.GetContext()
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+			clientState = &grandpa.ClientState{
+				LatestRelayHash:    make([]byte, 32),
+				RelayChain:         grandpa.RelayChain_ROCOCO,
+				ParaId:             uint32(latestHeight.RevisionNumber),
+				LatestParaHeight:   uint32(latestHeight.RevisionHeight),
+				CurrentAuthorities: []*grandpa.Authority{{PublicKey: make([]byte, 32), Weight: 1}},
+			}
+			consensusStates = map[clienttypes.Height]exported.ConsensusState{
+				clienttypes.NewHeight(2000, 9): grandpa.NewConsensusState(time.Unix(1, 0).UTC(), make([]byte, 32)),
+				latestHeight:                   grandpa.NewConsensusState(time.Unix(2, 0).UTC(), make([]byte, 32)),
+			}
+
+			tc.malleate()
+
+			// the client is stored as by a wasm grandpa client, which only stored the processed time of
+			// the consensus state at the first height
+			clientID = clientKeeper.GenerateClientIdentifier(ctx, exported.Wasm)
+			clientStore := clientKeeper.ClientStore(ctx, clientID)
+			for height, consensusState := range consensusStates {
+				data, err := suite.chainA.Codec.MarshalInterface(consensusState)
+				suite.Require().NoError(err)
+				clientKeeper.SetClientConsensusState(ctx, clientID, height, &wasmtypes.ConsensusState{Data: data, Timestamp: consensusState.GetTimestamp()})
+			}
+			grandpa.SetProcessedTime(clientStore, clienttypes.NewHeight(2000, 9), processedTime)
+
+			data, err := suite.chainA.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+			prevClientState := wasmtypes.NewClientState(data, []byte("code id"), latestHeight)
+			clientKeeper.SetClientState(ctx, clientID, prevClientState)
+
+			err = clientKeeper.MigrateClientType(ctx, clientID, exported.Grandpa)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				migratedClientState, found := clientKeeper.GetClientState(ctx, clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(clientState, migratedClientState)
+				suite.Require().Equal(exported.Active, clientKeeper.GetClientStatus(ctx, migratedClientState, clientID))
+
+				// every consensus state is stored as a 10-grandpa consensus state with its metadata, the
+				// processed time stored by the contract is kept
+				for height, consensusState := range consensusStates {
+					migratedConsensusState, found := clientKeeper.GetClientConsensusState(ctx, clientID, height)
+					suite.Require().True(found)
+					suite.Require().Equal(consensusState, migratedConsensusState)
+
+					_, found = grandpa.GetProcessedTime(clientStore, height)
+					suite.Require().True(found)
+					_, found = grandpa.GetProcessedHeight(clientStore, height)
+					suite.Require().True(found)
+				}
+
+				storedProcessedTime, _ := grandpa.GetProcessedTime(clientStore, clienttypes.NewHeight(2000, 9))
+				suite.Require().Equal(processedTime, storedProcessedTime)
+				storedProcessedTime, _ = grandpa.GetProcessedTime(clientStore, latestHeight)
+				suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), storedProcessedTime)
+			} else {
+				suite.Require().Error(err)
+
+				migratedClientState, _ := clientKeeper.GetClientState(ctx, clientID)
+				suite.Require().Equal(prevClientState, migratedClientState)
+			}
+		})
+	}
+}
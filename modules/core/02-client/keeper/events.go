@@ -140,3 +140,20 @@ func EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
 		),
 	})
 }
+
+// EmitMigrateClientTypeEvent emits a migrate client type event
+func EmitMigrateClientTypeEvent(ctx sdk.Context, clientID, prevClientType string, clientState exported.ClientState) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMigrateClientType,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyPrevClientType, prevClientType),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
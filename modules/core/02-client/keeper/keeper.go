@@ -32,6 +32,11 @@ type Keeper struct {
 	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingKeeper
 	upgradeKeeper types.UpgradeKeeper
+	hooks         types.ClientHooks
+
+	// contextDecorator is shared by all copies of the keeper, as the keepers of other IBC
+	// submodules hold copies created before the decorator is set.
+	contextDecorator *types.ContextDecorator
 }
 
 // NewKeeper creates a new NewKeeper instance
@@ -47,7 +52,59 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramt
 		paramSpace:    paramSpace,
 		stakingKeeper: sk,
 		upgradeKeeper: uk,
+
+		contextDecorator: new(types.ContextDecorator),
+	}
+}
+
+// SetHooks sets the client hooks. It panics if the hooks have already been set.
+// The hooks must be set before the keeper is copied into other modules or handlers.
+func (k *Keeper) SetHooks(hooks types.ClientHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set client hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// Hooks returns the client hooks. If no hooks are set, no-op hooks are returned.
+func (k Keeper) Hooks() types.ClientHooks {
+	if k.hooks == nil {
+		return types.MultiClientHooks{}
 	}
+
+	return k.hooks
+}
+
+// SetContextDecorator sets the decorator applied to the context passed to the methods of client
+// states. It panics if the decorator has already been set.
+func (k *Keeper) SetContextDecorator(decorator types.ContextDecorator) *Keeper {
+	if *k.contextDecorator != nil {
+		panic("cannot set client context decorator twice")
+	}
+
+	*k.contextDecorator = decorator
+	return k
+}
+
+// ClientContext returns the context to pass to the methods of client states.
+func (k Keeper) ClientContext(ctx sdk.Context) sdk.Context {
+	if k.contextDecorator == nil || *k.contextDecorator == nil {
+		return ctx
+	}
+
+	return (*k.contextDecorator)(ctx)
+}
+
+// afterClientStateChanged calls the client hooks with the client state currently stored for the given client.
+func (k Keeper) afterClientStateChanged(ctx sdk.Context, clientID string) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return
+	}
+
+	k.Hooks().AfterClientStateChanged(ctx, clientID, clientState)
 }
 
 // Logger returns a module-specific logger.
@@ -178,7 +235,7 @@ func (k Keeper) GetAllClientMetadata(ctx sdk.Context, genClients []types.Identif
 		if err != nil {
 			return nil, err
 		}
-		gms := cs.ExportMetadata(k.ClientStore(ctx, ic.ClientId))
+		gms := cs.ExportMetadata(k.ClientContext(ctx), k.ClientStore(ctx, ic.ClientId))
 		if len(gms) == 0 {
 			continue
 		}
@@ -431,5 +488,5 @@ func (k Keeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientStat
 	if !k.GetParams(ctx).IsAllowedClient(clientState.ClientType()) {
 		return exported.Unauthorized
 	}
-	return clientState.Status(ctx, k.ClientStore(ctx, clientID), k.cdc)
+	return clientState.Status(k.ClientContext(ctx), k.ClientStore(ctx, clientID), k.cdc)
 }
@@ -461,3 +461,54 @@ func (suite KeeperTestSuite) TestIterateClientStates() { //nolint:govet // this
 		})
 	}
 }
+
+// clientHooks records the client states the client hooks are called with.
+type clientHooks map[string]exported.ClientState
+
+func (h clientHooks) AfterClientStateChanged(_ sdk.Context, clientID string, clientState exported.ClientState) {
+	h[clientID] = clientState
+}
+
+func (suite *KeeperTestSuite) TestClientHooks() {
+	// the client hooks of the simapp are already set
+	suite.Require().Panics(func() { suite.keeper.SetHooks(types.NewMultiClientHooks()) })
+
+	app := suite.chainA.GetSimApp()
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(exported.StoreKey), app.GetSubspace(exported.ModuleName), app.StakingKeeper, app.UpgradeKeeper)
+
+	ctx := suite.chainA.GetContext()
+	clientState := ibctm.NewClientState(testChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+
+	// no-op hooks are used if no hooks are set
+	_, err := k.CreateClient(ctx, clientState, suite.consensusState)
+	suite.Require().NoError(err)
+
+	hooks := clientHooks{}
+	k.SetHooks(types.NewMultiClientHooks(hooks))
+
+	clientID, err := k.CreateClient(ctx, clientState, suite.consensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clientHooks{clientID: clientState}, hooks)
+}
+
+type contextKey struct{}
+
+func (suite *KeeperTestSuite) TestClientContext() {
+	// the context decorator of the simapp is already set
+	suite.Require().Panics(func() { suite.keeper.SetContextDecorator(func(ctx sdk.Context) sdk.Context { return ctx }) })
+
+	app := suite.chainA.GetSimApp()
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(exported.StoreKey), app.GetSubspace(exported.ModuleName), app.StakingKeeper, app.UpgradeKeeper)
+	ctx := suite.chainA.GetContext()
+
+	// the context is passed unchanged if no decorator is set
+	suite.Require().Nil(k.ClientContext(ctx).Value(contextKey{}))
+
+	// copies of the keeper created before the decorator is set use the decorator
+	keeperCopy := k
+	k.SetContextDecorator(func(ctx sdk.Context) sdk.Context {
+		return ctx.WithValue(contextKey{}, "value")
+	})
+	suite.Require().Equal("value", k.ClientContext(ctx).Value(contextKey{}))
+	suite.Require().Equal("value", keeperCopy.ClientContext(ctx).Value(contextKey{}))
+}
@@ -44,10 +44,12 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
 	}
 
-	if err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState); err != nil {
+	if err := subjectClientState.CheckSubstituteAndUpdateState(k.ClientContext(types.WithClientID(ctx, p.SubjectClientId)), k.cdc, subjectClientStore, substituteClientStore, substituteClientState); err != nil {
 		return err
 	}
 
+	k.afterClientStateChanged(ctx, p.SubjectClientId)
+
 	k.Logger(ctx).Info("client updated after governance proposal passed", "client-id", p.SubjectClientId)
 
 	defer func() {
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContextDecorator adds the values which light client implementations require to the context
+// passed to the methods of their client states, e.g. the VM owned by the 08-wasm keeper.
+type ContextDecorator func(ctx sdk.Context) sdk.Context
+
+type clientIDContextKey struct{}
+
+// WithClientID returns a copy of the context carrying the identifier of the client whose client
+// state methods are called, so that light clients can tag the events they emit with it.
+func WithClientID(ctx sdk.Context, clientID string) sdk.Context {
+	return ctx.WithValue(clientIDContextKey{}, clientID)
+}
+
+// ClientIDFromContext returns the client identifier carried by the context, if any.
+func ClientIDFromContext(ctx sdk.Context) (string, bool) {
+	clientID, ok := ctx.Value(clientIDContextKey{}).(string)
+	return clientID, ok
+}
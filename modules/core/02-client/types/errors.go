@@ -36,4 +36,5 @@ var (
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client state is not active")
 	ErrFailedMembershipVerification           = sdkerrors.Register(SubModuleName, 30, "membership verification failed")
 	ErrFailedNonMembershipVerification        = sdkerrors.Register(SubModuleName, 31, "non-membership verification failed")
+	ErrInvalidClientTypeMigration             = sdkerrors.Register(SubModuleName, 32, "invalid client type migration")
 )
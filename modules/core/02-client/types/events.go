@@ -18,6 +18,15 @@ const (
 	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 	AttributeKeyUpgradePlanTitle  = "title"
 	AttributeKeyWasmCodeID        = "wasm_code_id"
+	AttributeKeyOldWasmCodeID     = "old_wasm_code_id"
+	AttributeKeyNewWasmCodeID     = "new_wasm_code_id"
+	AttributeKeyPrevClientType    = "prev_client_type"
+	AttributeKeyAllowedQueries    = "allowed_queries"
+	AttributeKeyWasmChecksum      = "wasm_checksum"
+	AttributeKeyWasmUploadID      = "wasm_upload_id"
+	AttributeKeyWasmChunkIndex    = "wasm_chunk_index"
+	AttributeKeyMaxWasmCodeSize   = "max_wasm_code_size"
+	AttributeKeyAllowedCodeIDs    = "allowed_wasm_code_ids"
 )
 
 // IBC client events vars
@@ -31,6 +40,22 @@ var (
 	EventTypeUpgradeClientProposal = "upgrade_client_proposal"
 	EventTypePushWasmCode          = "push_wasm_code"
 	EventTypeUpdateWasmCodeId      = "update_wasm_code_id"
+	EventTypePinWasmCode           = "pin_wasm_code"
+	EventTypeUnpinWasmCode         = "unpin_wasm_code"
+	EventTypeRemoveWasmCode        = "remove_wasm_code"
+	EventTypeMigrateContract       = "migrate_contract"
+	EventTypeMigrateClientType     = "migrate_client_type"
+	EventTypeUpdateAllowedQueries  = "update_allowed_queries"
+	EventTypeApproveWasmChecksum   = "approve_wasm_checksum"
+	EventTypeRevokeWasmChecksum    = "revoke_wasm_checksum"
+	EventTypeBeginWasmUpload       = "begin_wasm_upload"
+	EventTypeUploadWasmChunk       = "upload_wasm_chunk"
+	EventTypeExpireWasmUpload      = "expire_wasm_upload"
+	EventTypeWasmContract          = "wasm_contract"
+	EventTypeUpdateWasmParams      = "update_wasm_params"
+
+	// EventTypePrefixWasmContract prefixes the type of the custom events emitted by 08-wasm contracts
+	EventTypePrefixWasmContract = "wasm_contract-"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
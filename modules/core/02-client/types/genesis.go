@@ -140,7 +140,9 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 
-		if clientType != clientState.ClientType() {
+		// 08-wasm clients migrated to 07-tendermint or 10-grandpa keep their client identifier
+		migrated := clientType == exported.Wasm && (clientState.ClientType() == exported.Tendermint || clientState.ClientType() == exported.Grandpa)
+		if clientType != clientState.ClientType() && !migrated {
 			return fmt.Errorf("client state type %s does not equal client type in client identifier %s", clientState.ClientType(), clientType)
 		}
 
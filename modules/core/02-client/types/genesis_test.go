@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	ibctestingmock "github.com/cosmos/ibc-go/v7/testing/mock"
 )
@@ -20,6 +21,7 @@ const (
 	tmClientID1         = "07-tendermint-1"
 	invalidClientID     = "myclient-0"
 	soloMachineClientID = "06-solomachine-0"
+	wasmClientID0       = "08-wasm-0"
 	clientID            = tmClientID0
 
 	height = 10
@@ -107,6 +109,80 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			),
 			expPass: true,
 		},
+		{
+			name: "valid genesis with 08-wasm client migrated to tendermint",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						wasmClientID0, ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+					),
+				},
+				[]types.ClientConsensusStates{
+					types.NewClientConsensusStates(
+						wasmClientID0,
+						[]types.ConsensusStateWithHeight{
+							types.NewConsensusStateWithHeight(
+								header.GetHeight().(types.Height),
+								ibctm.NewConsensusState(
+									header.GetTime(), commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()), header.Header.NextValidatorsHash,
+								),
+							),
+						},
+					),
+				},
+				nil,
+				types.NewParams(exported.Tendermint),
+				false,
+				1,
+			),
+			expPass: true,
+		},
+		{
+			name: "valid genesis with 08-wasm client migrated to grandpa",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						wasmClientID0, &grandpa.ClientState{
+							LatestRelayHash:    make([]byte, 32),
+							RelayChain:         grandpa.RelayChain_ROCOCO,
+							ParaId:             2000,
+							LatestParaHeight:   height,
+							CurrentAuthorities: []*grandpa.Authority{{PublicKey: make([]byte, 32), Weight: 1}},
+						},
+					),
+				},
+				[]types.ClientConsensusStates{
+					types.NewClientConsensusStates(
+						wasmClientID0,
+						[]types.ConsensusStateWithHeight{
+							types.NewConsensusStateWithHeight(
+								types.NewHeight(2000, height),
+								grandpa.NewConsensusState(now, make([]byte, 32)),
+							),
+						},
+					),
+				},
+				nil,
+				types.NewParams(exported.Grandpa),
+				false,
+				1,
+			),
+			expPass: true,
+		},
+		{
+			name: "invalid 08-wasm client identifier of a client type 08-wasm clients cannot be migrated to",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(wasmClientID0, solomachine.NewClientState(0, &solomachine.ConsensusState{PublicKey: suite.solomachine.ConsensusState().PublicKey, Diversifier: suite.solomachine.Diversifier, Timestamp: suite.solomachine.Time})),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Solomachine),
+				false,
+				1,
+			),
+			expPass: false,
+		},
 		{
 			name: "invalid client type",
 			genState: types.NewGenesisState(
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ClientHooks defines the hooks that other modules can register on the client keeper to be
+// notified when the client state of a client is set outside of regular client updates, i.e.
+// on client creation, upgrade, governance client update, client type migration and genesis
+// import.
+type ClientHooks interface {
+	// AfterClientStateChanged is called with the client state stored for the given client.
+	AfterClientStateChanged(ctx sdk.Context, clientID string, clientState exported.ClientState)
+}
+
+var _ ClientHooks = MultiClientHooks{}
+
+// MultiClientHooks combines multiple client hooks, all hook functions are run in array sequence.
+type MultiClientHooks []ClientHooks
+
+// NewMultiClientHooks returns a new MultiClientHooks instance.
+func NewMultiClientHooks(hooks ...ClientHooks) MultiClientHooks {
+	return hooks
+}
+
+// AfterClientStateChanged implements ClientHooks.
+func (h MultiClientHooks) AfterClientStateChanged(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	for i := range h {
+		h[i].AfterClientStateChanged(ctx, clientID, clientState)
+	}
+}
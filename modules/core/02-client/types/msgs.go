@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgUpdateClient{}
 	_ sdk.Msg = &MsgSubmitMisbehaviour{}
 	_ sdk.Msg = &MsgUpgradeClient{}
+	_ sdk.Msg = &MsgMigrateClientType{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgCreateClient{}
 	_ codectypes.UnpackInterfacesMessage = MsgUpdateClient{}
@@ -263,3 +264,34 @@ func (msg MsgSubmitMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	var misbehaviour exported.ClientMessage
 	return unpacker.UnpackAny(msg.Misbehaviour, &misbehaviour)
 }
+
+// NewMsgMigrateClientType creates a new MsgMigrateClientType instance.
+func NewMsgMigrateClientType(clientID, clientType, signer string) *MsgMigrateClientType {
+	return &MsgMigrateClientType{
+		ClientId:   clientID,
+		ClientType: clientType,
+		Signer:     signer,
+	}
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgMigrateClientType.
+func (msg MsgMigrateClientType) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := ValidateClientType(msg.ClientType); err != nil {
+		return err
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// GetSigners returns the single expected signer for a MsgMigrateClientType.
+func (msg MsgMigrateClientType) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
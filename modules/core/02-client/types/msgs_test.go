@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v7/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
@@ -609,3 +610,52 @@ func (suite *TypesTestSuite) TestMsgSubmitMisbehaviour_ValidateBasic() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgMigrateClientType_ValidateBasic() {
+	var msg *types.MsgMigrateClientType
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid migration to tendermint",
+			func() {},
+			true,
+		},
+		{
+			"invalid client-id",
+			func() {
+				msg.ClientId = ""
+			},
+			false,
+		},
+		{
+			"invalid client type",
+			func() {
+				msg.ClientType = ""
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg = types.NewMsgMigrateClientType("08-wasm-0", exported.Tendermint, suite.chainA.SenderAccount.GetAddress().String())
+
+		tc.malleate()
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -362,6 +362,89 @@ func (m *MsgSubmitMisbehaviourResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitMisbehaviourResponse proto.InternalMessageInfo
 
+// MsgMigrateClientType defines a governance gated sdk.Msg to migrate an existing
+// client to another client type, e.g. a 08-wasm client wrapping a tendermint
+// client to a native 07-tendermint client. The client identifier, and thus all
+// connections and channels built on top of the client, are kept.
+type MsgMigrateClientType struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// client type the client is migrated to
+	ClientType string `protobuf:"bytes,2,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty" yaml:"client_type"`
+	// signer address, must be the governance authority
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgMigrateClientType) Reset()         { *m = MsgMigrateClientType{} }
+func (m *MsgMigrateClientType) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateClientType) ProtoMessage()    {}
+func (*MsgMigrateClientType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{8}
+}
+func (m *MsgMigrateClientType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateClientType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateClientType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateClientType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateClientType.Merge(m, src)
+}
+func (m *MsgMigrateClientType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateClientType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateClientType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateClientType proto.InternalMessageInfo
+
+// MsgMigrateClientTypeResponse defines the Msg/MigrateClientType response type.
+type MsgMigrateClientTypeResponse struct {
+}
+
+func (m *MsgMigrateClientTypeResponse) Reset()         { *m = MsgMigrateClientTypeResponse{} }
+func (m *MsgMigrateClientTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateClientTypeResponse) ProtoMessage()    {}
+func (*MsgMigrateClientTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{9}
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateClientTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateClientTypeResponse.Merge(m, src)
+}
+func (m *MsgMigrateClientTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateClientTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateClientTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateClientTypeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
@@ -371,51 +454,57 @@ func init() {
 	proto.RegisterType((*MsgUpgradeClientResponse)(nil), "ibc.core.client.v1.MsgUpgradeClientResponse")
 	proto.RegisterType((*MsgSubmitMisbehaviour)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviour")
 	proto.RegisterType((*MsgSubmitMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviourResponse")
+	proto.RegisterType((*MsgMigrateClientType)(nil), "ibc.core.client.v1.MsgMigrateClientType")
+	proto.RegisterType((*MsgMigrateClientTypeResponse)(nil), "ibc.core.client.v1.MsgMigrateClientTypeResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xef, 0x8b, 0x9a, 0x69, 0xfa, 0x23, 0x13, 0x52, 0xd7, 0x55, 0xed, 0xc8, 0x74,
-	0x11, 0x04, 0xb5, 0x49, 0xba, 0x00, 0x15, 0x36, 0xa4, 0x2b, 0x16, 0x91, 0xc0, 0x15, 0x0b, 0xd8,
-	0x04, 0xff, 0x4c, 0xa7, 0x23, 0x62, 0x4f, 0xe4, 0xb1, 0x23, 0xf2, 0x06, 0x2c, 0x79, 0x84, 0x0a,
-	0x5e, 0x80, 0xc7, 0x60, 0xd9, 0x05, 0x0b, 0x56, 0x51, 0x95, 0x6c, 0x58, 0xe7, 0x09, 0x50, 0x3c,
-	0x4e, 0xb0, 0x1d, 0x3b, 0x8a, 0x04, 0xec, 0x3c, 0x73, 0xcf, 0x9c, 0x73, 0x8f, 0xcf, 0x1d, 0x1b,
-	0x1c, 0x62, 0xd3, 0xd2, 0x2c, 0xe2, 0x41, 0xcd, 0xea, 0x61, 0xe8, 0xfa, 0xda, 0xa0, 0xa9, 0xf9,
-	0x1f, 0xd4, 0xbe, 0x47, 0x7c, 0xc2, 0xf3, 0xd8, 0xb4, 0xd4, 0x59, 0x51, 0x65, 0x45, 0x75, 0xd0,
-	0x14, 0xab, 0x88, 0x20, 0x12, 0x96, 0xb5, 0xd9, 0x13, 0x43, 0x8a, 0x07, 0x88, 0x10, 0xd4, 0x83,
-	0x5a, 0xb8, 0x32, 0x83, 0x4b, 0xcd, 0x70, 0x87, 0xac, 0xa4, 0xdc, 0x72, 0x60, 0xb7, 0x43, 0xd1,
-	0xb9, 0x07, 0x0d, 0x1f, 0x9e, 0x87, 0x3c, 0xfc, 0x4b, 0x50, 0x61, 0x8c, 0x5d, 0xea, 0x1b, 0x3e,
-	0x14, 0xb8, 0x3a, 0xd7, 0xd8, 0x6a, 0x55, 0x55, 0xc6, 0xa2, 0xce, 0x59, 0xd4, 0xe7, 0xee, 0xb0,
-	0xbd, 0x3f, 0x1d, 0xc9, 0x77, 0x86, 0x86, 0xd3, 0x3b, 0x53, 0xe2, 0x67, 0x14, 0x7d, 0x8b, 0x2d,
-	0x2f, 0x66, 0x2b, 0xfe, 0x0d, 0xd8, 0xb5, 0x88, 0x4b, 0xa1, 0x4b, 0x03, 0x1a, 0x91, 0x6e, 0xac,
-	0x20, 0x15, 0xa7, 0x23, 0xb9, 0x16, 0x91, 0x26, 0x8f, 0x29, 0xfa, 0xce, 0x62, 0x87, 0x51, 0xd7,
-	0x40, 0x89, 0x62, 0xe4, 0x42, 0x4f, 0x28, 0xd6, 0xb9, 0x46, 0x59, 0x8f, 0x56, 0x67, 0x9b, 0x1f,
-	0xaf, 0xe5, 0xc2, 0xcf, 0x6b, 0xb9, 0xa0, 0x1c, 0x80, 0xfd, 0x94, 0x43, 0x1d, 0xd2, 0xfe, 0x8c,
-	0x45, 0xf9, 0xc2, 0xdc, 0xbf, 0xee, 0xdb, 0xbf, 0xdd, 0x37, 0x41, 0x39, 0x72, 0x82, 0xed, 0xd0,
-	0x7a, 0xb9, 0x5d, 0x9d, 0x8e, 0xe4, 0xbd, 0x84, 0x49, 0x6c, 0x2b, 0xfa, 0x26, 0x7b, 0x7e, 0x61,
-	0xf3, 0x4f, 0xc1, 0x4e, 0xb4, 0xef, 0x40, 0x4a, 0x0d, 0xb4, 0xd2, 0x9d, 0xbe, 0xcd, 0xb0, 0x1d,
-	0x06, 0x5d, 0xdb, 0x40, 0xbc, 0xc9, 0x85, 0x81, 0xef, 0x45, 0xb0, 0x17, 0xd6, 0x90, 0x67, 0xd8,
-	0x7f, 0xe0, 0x20, 0x1d, 0xf9, 0xc6, 0xbf, 0x88, 0xbc, 0xf8, 0x97, 0x22, 0x7f, 0x05, 0xaa, 0x7d,
-	0x8f, 0x90, 0xcb, 0x6e, 0xc0, 0x6c, 0x77, 0x99, 0xae, 0xf0, 0x5f, 0x9d, 0x6b, 0x54, 0xda, 0xf2,
-	0x74, 0x24, 0x1f, 0x32, 0xa6, 0x2c, 0x94, 0xa2, 0xf3, 0xe1, 0x76, 0xf2, 0x95, 0xbd, 0x07, 0x47,
-	0x29, 0x70, 0xaa, 0xf7, 0xff, 0x43, 0xee, 0xc6, 0x74, 0x24, 0x1f, 0x67, 0x72, 0xa7, 0x7b, 0x16,
-	0x13, 0x22, 0x79, 0x23, 0x5b, 0xca, 0x49, 0x5c, 0x04, 0x42, 0x3a, 0xd5, 0x45, 0xe4, 0x5f, 0x39,
-	0x70, 0xb7, 0x43, 0xd1, 0x45, 0x60, 0x3a, 0xd8, 0xef, 0x60, 0x6a, 0xc2, 0x2b, 0x63, 0x80, 0x49,
-	0xe0, 0xf1, 0xa7, 0xcb, 0xb9, 0xd7, 0xb2, 0x72, 0x17, 0xb8, 0x58, 0xf2, 0xcf, 0x40, 0xc5, 0x89,
-	0x91, 0xac, 0x4c, 0x7e, 0x43, 0xe0, 0xf4, 0x04, 0x9a, 0x17, 0x93, 0xc3, 0x1b, 0x22, 0x96, 0xed,
-	0xc8, 0xe0, 0x28, 0xb3, 0xe3, 0xb9, 0xa7, 0xd6, 0xe7, 0x22, 0x28, 0x76, 0x28, 0xe2, 0xdf, 0x81,
-	0x4a, 0xe2, 0x4b, 0x74, 0x4f, 0x5d, 0xfe, 0xc6, 0xa9, 0xa9, 0xcb, 0x2c, 0x3e, 0x58, 0x03, 0x34,
-	0x57, 0x9a, 0x29, 0x24, 0x6e, 0x7b, 0x9e, 0x42, 0x1c, 0x94, 0xab, 0x90, 0x75, 0x25, 0x79, 0x0b,
-	0x6c, 0x27, 0x67, 0xeb, 0x38, 0xf7, 0x74, 0x0c, 0x25, 0x3e, 0x5c, 0x07, 0xb5, 0x10, 0xf1, 0x00,
-	0x9f, 0x31, 0x00, 0xf7, 0x73, 0x38, 0x96, 0xa1, 0x62, 0x73, 0x6d, 0xe8, 0x5c, 0xb3, 0xad, 0x7f,
-	0x1b, 0x4b, 0xdc, 0xcd, 0x58, 0xe2, 0x6e, 0xc7, 0x12, 0xf7, 0x69, 0x22, 0x15, 0x6e, 0x26, 0x52,
-	0xe1, 0xc7, 0x44, 0x2a, 0xbc, 0x7d, 0x82, 0xb0, 0x7f, 0x15, 0x98, 0xaa, 0x45, 0x1c, 0xcd, 0x22,
-	0xd4, 0x21, 0x54, 0xc3, 0xa6, 0x75, 0x82, 0x88, 0x36, 0x78, 0xac, 0x39, 0xc4, 0x0e, 0x7a, 0x90,
-	0xb2, 0xdf, 0xd8, 0xa3, 0xd6, 0x49, 0xf4, 0x27, 0xf3, 0x87, 0x7d, 0x48, 0xcd, 0x52, 0x38, 0x5f,
-	0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x8f, 0x23, 0x99, 0xe9, 0x06, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x9b, 0x52, 0xb5, 0xb7, 0xe9, 0x03, 0x13, 0xda, 0xd4, 0xa5, 0x76, 0x65, 0xba, 0x08,
+	0x2a, 0xb5, 0x9b, 0x76, 0x51, 0x54, 0xd8, 0x90, 0xae, 0x58, 0x58, 0x02, 0x17, 0x16, 0xb0, 0x09,
+	0xb6, 0x33, 0x9d, 0x8e, 0x88, 0x33, 0x91, 0xc7, 0x8e, 0xc8, 0x1f, 0xb0, 0x44, 0xe2, 0x07, 0x2a,
+	0xc1, 0x07, 0xf0, 0x19, 0x2c, 0xbb, 0x60, 0xc1, 0x2a, 0xaa, 0xda, 0x0d, 0xeb, 0x7c, 0x01, 0xb2,
+	0xc7, 0x71, 0x63, 0x27, 0x29, 0x11, 0x8f, 0x9d, 0xc7, 0xf7, 0xcc, 0x39, 0xf7, 0xf8, 0xdc, 0x99,
+	0x04, 0xd6, 0x89, 0xed, 0xe8, 0x0e, 0xf5, 0x90, 0xee, 0x34, 0x08, 0x6a, 0xfa, 0x7a, 0xbb, 0xa2,
+	0xfb, 0xef, 0xb5, 0x96, 0x47, 0x7d, 0x2a, 0x8a, 0xc4, 0x76, 0xb4, 0xb0, 0xa8, 0xf1, 0xa2, 0xd6,
+	0xae, 0x48, 0x45, 0x4c, 0x31, 0x8d, 0xca, 0x7a, 0xf8, 0xc4, 0x91, 0xd2, 0x1a, 0xa6, 0x14, 0x37,
+	0x90, 0x1e, 0xad, 0xec, 0xe0, 0x44, 0xb7, 0x9a, 0x1d, 0x5e, 0x52, 0x2f, 0x04, 0x58, 0x32, 0x18,
+	0x3e, 0xf2, 0x90, 0xe5, 0xa3, 0xa3, 0x88, 0x47, 0x7c, 0x0e, 0x05, 0xce, 0x58, 0x63, 0xbe, 0xe5,
+	0xa3, 0x92, 0xb0, 0x29, 0x94, 0xe7, 0xf7, 0x8a, 0x1a, 0x67, 0xd1, 0xfa, 0x2c, 0xda, 0xd3, 0x66,
+	0xa7, 0xba, 0xda, 0xeb, 0x2a, 0x77, 0x3a, 0x96, 0xdb, 0x38, 0x54, 0x07, 0xf7, 0xa8, 0xe6, 0x3c,
+	0x5f, 0x1e, 0x87, 0x2b, 0xf1, 0x35, 0x2c, 0x39, 0xb4, 0xc9, 0x50, 0x93, 0x05, 0x2c, 0x26, 0x9d,
+	0xba, 0x81, 0x54, 0xea, 0x75, 0x95, 0x95, 0x98, 0x34, 0xbd, 0x4d, 0x35, 0x17, 0x93, 0x37, 0x9c,
+	0x7a, 0x05, 0x66, 0x18, 0xc1, 0x4d, 0xe4, 0x95, 0xf2, 0x9b, 0x42, 0x79, 0xce, 0x8c, 0x57, 0x87,
+	0xb3, 0x1f, 0xce, 0x94, 0xdc, 0xcf, 0x33, 0x25, 0xa7, 0xae, 0xc1, 0x6a, 0xc6, 0xa1, 0x89, 0x58,
+	0x2b, 0x64, 0x51, 0x3f, 0x73, 0xf7, 0xaf, 0x5a, 0xf5, 0x6b, 0xf7, 0x15, 0x98, 0x8b, 0x9d, 0x90,
+	0x7a, 0x64, 0x7d, 0xae, 0x5a, 0xec, 0x75, 0x95, 0xe5, 0x94, 0x49, 0x52, 0x57, 0xcd, 0x59, 0xfe,
+	0xfc, 0xac, 0x2e, 0x3e, 0x86, 0xc5, 0xf8, 0xbd, 0x8b, 0x18, 0xb3, 0xf0, 0x8d, 0xee, 0xcc, 0x05,
+	0x8e, 0x35, 0x38, 0x74, 0x62, 0x03, 0x83, 0x4d, 0x26, 0x06, 0xbe, 0xe7, 0x61, 0x39, 0xaa, 0x61,
+	0xcf, 0xaa, 0xff, 0x85, 0x83, 0x6c, 0xe4, 0x53, 0xff, 0x23, 0xf2, 0xfc, 0x3f, 0x8a, 0xfc, 0x05,
+	0x14, 0x5b, 0x1e, 0xa5, 0x27, 0xb5, 0x80, 0xdb, 0xae, 0x71, 0xdd, 0xd2, 0xf4, 0xa6, 0x50, 0x2e,
+	0x54, 0x95, 0x5e, 0x57, 0x59, 0xe7, 0x4c, 0xa3, 0x50, 0xaa, 0x29, 0x46, 0xaf, 0xd3, 0x9f, 0xec,
+	0x1d, 0x6c, 0x64, 0xc0, 0x99, 0xde, 0x6f, 0x45, 0xdc, 0xe5, 0x5e, 0x57, 0xd9, 0x1a, 0xc9, 0x9d,
+	0xed, 0x59, 0x4a, 0x89, 0x8c, 0x1b, 0xd9, 0x99, 0x31, 0x89, 0x4b, 0x50, 0xca, 0xa6, 0x9a, 0x44,
+	0xfe, 0x55, 0x80, 0xbb, 0x06, 0xc3, 0xc7, 0x81, 0xed, 0x12, 0xdf, 0x20, 0xcc, 0x46, 0xa7, 0x56,
+	0x9b, 0xd0, 0xc0, 0x13, 0xf7, 0x87, 0x73, 0x5f, 0x19, 0x95, 0x7b, 0x49, 0x18, 0x48, 0xfe, 0x09,
+	0x14, 0xdc, 0x01, 0x92, 0x1b, 0x93, 0x9f, 0x2a, 0x09, 0x66, 0x0a, 0x2d, 0x4a, 0xe9, 0xe1, 0x8d,
+	0x10, 0xc3, 0x76, 0x14, 0xd8, 0x18, 0xd9, 0x71, 0xe2, 0xe9, 0x8b, 0x00, 0x45, 0x83, 0x61, 0x83,
+	0x60, 0x2f, 0x99, 0xf1, 0x97, 0x9d, 0x16, 0xfa, 0x93, 0x51, 0x3e, 0x80, 0x78, 0x0e, 0x6b, 0x7e,
+	0xa7, 0xc5, 0x27, 0x99, 0x7f, 0x07, 0x31, 0xb5, 0x29, 0x2c, 0xaa, 0x26, 0x38, 0xd7, 0x5a, 0xbf,
+	0x3f, 0x88, 0x32, 0xdc, 0x1b, 0xd5, 0x65, 0xdf, 0xc6, 0xde, 0xa7, 0x69, 0xc8, 0x1b, 0x0c, 0x8b,
+	0x6f, 0xa1, 0x90, 0xba, 0x50, 0xef, 0x6b, 0xc3, 0x57, 0xb5, 0x96, 0xb9, 0x93, 0xa4, 0xed, 0x09,
+	0x40, 0x7d, 0xa5, 0x50, 0x21, 0x75, 0x69, 0x8d, 0x53, 0x18, 0x04, 0x49, 0xdb, 0x13, 0x80, 0x12,
+	0x05, 0x07, 0x16, 0xd2, 0x47, 0x64, 0x6b, 0xec, 0xee, 0x01, 0x94, 0xf4, 0x70, 0x12, 0x54, 0x22,
+	0xe2, 0x81, 0x38, 0x62, 0x8e, 0x1f, 0x8c, 0xe1, 0x18, 0x86, 0x4a, 0x95, 0x89, 0xa1, 0x89, 0x26,
+	0x85, 0xdb, 0xc3, 0x73, 0x56, 0x1e, 0xc3, 0x33, 0x84, 0x94, 0x76, 0x27, 0x45, 0xf6, 0x05, 0xab,
+	0xe6, 0xb7, 0x4b, 0x59, 0x38, 0xbf, 0x94, 0x85, 0x8b, 0x4b, 0x59, 0xf8, 0x78, 0x25, 0xe7, 0xce,
+	0xaf, 0xe4, 0xdc, 0x8f, 0x2b, 0x39, 0xf7, 0xe6, 0x11, 0x26, 0xfe, 0x69, 0x60, 0x6b, 0x0e, 0x75,
+	0x75, 0x87, 0x32, 0x97, 0x32, 0x9d, 0xd8, 0xce, 0x0e, 0xa6, 0x7a, 0xfb, 0x40, 0x77, 0x69, 0x3d,
+	0x68, 0x20, 0xc6, 0x7f, 0xfe, 0x77, 0xf7, 0x76, 0xe2, 0x7f, 0x00, 0xe1, 0xdc, 0x32, 0x7b, 0x26,
+	0x3a, 0x97, 0xfb, 0xbf, 0x06, 0x00, 0x4e, 0x0d, 0xea, 0xcf, 0x21, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -438,6 +527,8 @@ type MsgClient interface {
 	UpgradeClient(ctx context.Context, in *MsgUpgradeClient, opts ...grpc.CallOption) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitMisbehaviourResponse, error)
+	// MigrateClientType defines a rpc handler method for MsgMigrateClientType.
+	MigrateClientType(ctx context.Context, in *MsgMigrateClientType, opts ...grpc.CallOption) (*MsgMigrateClientTypeResponse, error)
 }
 
 type msgClient struct {
@@ -484,6 +575,15 @@ func (c *msgClient) SubmitMisbehaviour(ctx context.Context, in *MsgSubmitMisbeha
 	return out, nil
 }
 
+func (c *msgClient) MigrateClientType(ctx context.Context, in *MsgMigrateClientType, opts ...grpc.CallOption) (*MsgMigrateClientTypeResponse, error) {
+	out := new(MsgMigrateClientTypeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/MigrateClientType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
@@ -494,6 +594,8 @@ type MsgServer interface {
 	UpgradeClient(context.Context, *MsgUpgradeClient) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
 	SubmitMisbehaviour(context.Context, *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error)
+	// MigrateClientType defines a rpc handler method for MsgMigrateClientType.
+	MigrateClientType(context.Context, *MsgMigrateClientType) (*MsgMigrateClientTypeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -512,6 +614,9 @@ func (*UnimplementedMsgServer) UpgradeClient(ctx context.Context, req *MsgUpgrad
 func (*UnimplementedMsgServer) SubmitMisbehaviour(ctx context.Context, req *MsgSubmitMisbehaviour) (*MsgSubmitMisbehaviourResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitMisbehaviour not implemented")
 }
+func (*UnimplementedMsgServer) MigrateClientType(ctx context.Context, req *MsgMigrateClientType) (*MsgMigrateClientTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateClientType not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -589,6 +694,24 @@ func _Msg_SubmitMisbehaviour_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateClientType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateClientType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateClientType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/MigrateClientType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateClientType(ctx, req.(*MsgMigrateClientType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -609,6 +732,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitMisbehaviour",
 			Handler:    _Msg_SubmitMisbehaviour_Handler,
 		},
+		{
+			MethodName: "MigrateClientType",
+			Handler:    _Msg_MigrateClientType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -933,6 +1060,73 @@ func (m *MsgSubmitMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateClientType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateClientType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateClientType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateClientTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateClientTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateClientTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1076,6 +1270,36 @@ func (m *MsgSubmitMisbehaviourResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateClientType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateClientTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1990,6 +2214,202 @@ func (m *MsgSubmitMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateClientType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateClientType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateClientType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateClientTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateClientTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateClientTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
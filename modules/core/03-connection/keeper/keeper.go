@@ -100,7 +100,7 @@ func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.Connectio
 		)
 	}
 
-	timestamp, err := clientState.GetTimestampAtHeight(ctx, k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height)
+	timestamp, err := clientState.GetTimestampAtHeight(k.clientKeeper.ClientContext(ctx), k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height)
 	if err != nil {
 		return 0, err
 	}
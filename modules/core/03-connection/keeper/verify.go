@@ -45,7 +45,7 @@ func (k Keeper) VerifyClientState(
 	}
 
 	if err := targetClient.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -87,7 +87,7 @@ func (k Keeper) VerifyClientConsensusState(
 	}
 
 	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -134,7 +134,7 @@ func (k Keeper) VerifyConnectionState(
 	}
 
 	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -182,7 +182,7 @@ func (k Keeper) VerifyChannelState(
 	}
 
 	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -225,7 +225,7 @@ func (k Keeper) VerifyPacketCommitment(
 	}
 
 	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		proof, merklePath, commitmentBytes,
 	); err != nil {
@@ -268,7 +268,7 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	}
 
 	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement),
 	); err != nil {
@@ -311,7 +311,7 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	}
 
 	if err := clientState.VerifyNonMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		proof, merklePath,
 	); err != nil {
@@ -353,7 +353,7 @@ func (k Keeper) VerifyNextSequenceRecv(
 	}
 
 	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+		k.clientKeeper.ClientContext(ctx), clientStore, k.cdc, height,
 		timeDelay, blockDelay,
 		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
 	); err != nil {
@@ -40,5 +40,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*exported.Proof)(nil),
 		&MerkleProof{},
+		&SubstrateProof{},
 	)
 }
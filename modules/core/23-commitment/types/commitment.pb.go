@@ -205,11 +205,60 @@ func (m *MerkleProof) GetProofs() []*_go.CommitmentProof {
 	return nil
 }
 
+// SubstrateProof is a read proof of a Substrate base-16 Patricia-Merkle trie
+// hashed with BlakeTwo256, as returned by the `state_getReadProof` RPC.
+// It is the unordered set of encoded trie nodes needed to look up the proven
+// keys from the state root.
+type SubstrateProof struct {
+	TrieNodes [][]byte `protobuf:"bytes,1,rep,name=trie_nodes,json=trieNodes,proto3" json:"trie_nodes,omitempty" yaml:"trie_nodes"`
+}
+
+func (m *SubstrateProof) Reset()         { *m = SubstrateProof{} }
+func (m *SubstrateProof) String() string { return proto.CompactTextString(m) }
+func (*SubstrateProof) ProtoMessage()    {}
+func (*SubstrateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7921d88972a41469, []int{4}
+}
+func (m *SubstrateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubstrateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubstrateProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubstrateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubstrateProof.Merge(m, src)
+}
+func (m *SubstrateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubstrateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubstrateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubstrateProof proto.InternalMessageInfo
+
+func (m *SubstrateProof) GetTrieNodes() [][]byte {
+	if m != nil {
+		return m.TrieNodes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MerkleRoot)(nil), "ibc.core.commitment.v1.MerkleRoot")
 	proto.RegisterType((*MerklePrefix)(nil), "ibc.core.commitment.v1.MerklePrefix")
 	proto.RegisterType((*MerklePath)(nil), "ibc.core.commitment.v1.MerklePath")
 	proto.RegisterType((*MerkleProof)(nil), "ibc.core.commitment.v1.MerkleProof")
+	proto.RegisterType((*SubstrateProof)(nil), "ibc.core.commitment.v1.SubstrateProof")
 }
 
 func init() {
@@ -217,29 +266,31 @@ func init() {
 }
 
 var fileDescriptor_7921d88972a41469 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
-	0x10, 0xc7, 0x13, 0x2c, 0xd5, 0x6e, 0x0b, 0x62, 0xfc, 0x40, 0x8a, 0xa4, 0x25, 0x07, 0xed, 0xa5,
-	0xbb, 0xb4, 0x15, 0x94, 0xe2, 0x29, 0x0a, 0x9e, 0x84, 0x92, 0x83, 0x07, 0x2f, 0x92, 0xac, 0xdb,
-	0x24, 0xb4, 0x71, 0x42, 0x76, 0x1b, 0xcc, 0x1b, 0x78, 0xf4, 0xe8, 0xd1, 0xc7, 0xf1, 0xd8, 0xa3,
-	0xa7, 0x22, 0xed, 0x1b, 0xf4, 0x09, 0x64, 0x77, 0xad, 0xe6, 0x36, 0xc3, 0xfc, 0xe6, 0xe3, 0xff,
-	0x1f, 0x74, 0x16, 0x07, 0x94, 0x50, 0xc8, 0x18, 0xa1, 0x90, 0x24, 0xb1, 0x48, 0xd8, 0xb3, 0x20,
-	0x79, 0xaf, 0x94, 0xe1, 0x34, 0x03, 0x01, 0xd6, 0x51, 0x1c, 0x50, 0x2c, 0x41, 0x5c, 0x2a, 0xe5,
-	0xbd, 0xe6, 0x41, 0x08, 0x21, 0x28, 0x84, 0xc8, 0x48, 0xd3, 0xcd, 0x13, 0x0a, 0x3c, 0x01, 0x4e,
-	0x62, 0xca, 0xfb, 0x03, 0x39, 0x2f, 0xcd, 0x00, 0xc6, 0x5c, 0x57, 0x9d, 0x53, 0x84, 0xee, 0x58,
-	0x36, 0x99, 0x32, 0x0f, 0x40, 0x58, 0x16, 0xaa, 0x44, 0x3e, 0x8f, 0x8e, 0xcd, 0xb6, 0xd9, 0x69,
-	0x78, 0x2a, 0x1e, 0x56, 0x5e, 0x3f, 0x5a, 0x86, 0x73, 0x83, 0x1a, 0x9a, 0x1b, 0x65, 0x6c, 0x1c,
-	0xbf, 0x58, 0xe7, 0x08, 0x4d, 0x58, 0xf1, 0x98, 0xaa, 0x4c, 0xf3, 0xee, 0xe1, 0x7a, 0xd1, 0xda,
-	0x2b, 0xfc, 0x64, 0x3a, 0x74, 0xfe, 0x6b, 0x8e, 0x57, 0x9b, 0xb0, 0x42, 0x77, 0x39, 0xee, 0x66,
-	0xdb, 0xc8, 0x17, 0x91, 0x85, 0xd1, 0x8e, 0xe2, 0x7c, 0x21, 0x37, 0x6e, 0x75, 0x6a, 0xee, 0xfe,
-	0x7a, 0xd1, 0xda, 0x2d, 0x4d, 0xf0, 0x45, 0xe4, 0x78, 0xdb, 0xb2, 0xdf, 0x17, 0xd1, 0xb0, 0xf2,
-	0x2e, 0x2f, 0xb9, 0x45, 0xf5, 0xcd, 0x25, 0x00, 0x63, 0xeb, 0x12, 0x55, 0xb5, 0x20, 0x35, 0xa2,
-	0xde, 0x6f, 0x63, 0xad, 0x17, 0x2b, 0xbd, 0x38, 0xef, 0xe1, 0xeb, 0x3f, 0x93, 0x54, 0x87, 0xf7,
-	0xcb, 0xbb, 0xf7, 0x9f, 0x4b, 0xdb, 0x9c, 0x2f, 0x6d, 0xf3, 0x7b, 0x69, 0x9b, 0x6f, 0x2b, 0xdb,
-	0x98, 0xaf, 0x6c, 0xe3, 0x6b, 0x65, 0x1b, 0x0f, 0x57, 0x61, 0x2c, 0xa2, 0x59, 0x20, 0xed, 0x25,
-	0x1b, 0xf7, 0x02, 0xda, 0x0d, 0x81, 0xe4, 0x17, 0x24, 0x81, 0xa7, 0xd9, 0x94, 0x71, 0xfd, 0xa9,
-	0xfe, 0xa0, 0x5b, 0x7a, 0x96, 0x28, 0x52, 0xc6, 0x83, 0xaa, 0x72, 0x76, 0xf0, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x90, 0xe5, 0x86, 0x50, 0xd0, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x6e, 0xda, 0x40,
+	0x10, 0xc6, 0x6d, 0x15, 0xd1, 0xb2, 0xa0, 0x56, 0x75, 0xff, 0xa8, 0x42, 0x95, 0x41, 0x7b, 0x68,
+	0xb9, 0xb0, 0x2b, 0xfe, 0x48, 0xad, 0x50, 0x4f, 0x6e, 0xd5, 0x9e, 0x1a, 0x21, 0x47, 0xca, 0x21,
+	0x17, 0x64, 0x9b, 0xc5, 0xb6, 0xc0, 0x8c, 0xe5, 0x5d, 0xac, 0xf8, 0x0d, 0x72, 0xcc, 0x31, 0xc7,
+	0x3c, 0x4e, 0x8e, 0x1c, 0x73, 0x42, 0x11, 0xbc, 0x01, 0x4f, 0x10, 0xed, 0x2e, 0x04, 0xe7, 0x36,
+	0xa3, 0xf9, 0xcd, 0xb7, 0xf3, 0xed, 0x87, 0xbe, 0xc7, 0x7e, 0x40, 0x03, 0xc8, 0x18, 0x0d, 0x20,
+	0x49, 0x62, 0x91, 0xb0, 0xa5, 0xa0, 0x79, 0xaf, 0xd4, 0x91, 0x34, 0x03, 0x01, 0xd6, 0xe7, 0xd8,
+	0x0f, 0x88, 0x04, 0x49, 0x69, 0x94, 0xf7, 0x9a, 0x1f, 0x43, 0x08, 0x41, 0x21, 0x54, 0x56, 0x9a,
+	0x6e, 0x7e, 0x0d, 0x80, 0x27, 0xc0, 0x69, 0x1c, 0xf0, 0xfe, 0x40, 0xea, 0xa5, 0x19, 0xc0, 0x8c,
+	0xeb, 0x29, 0xfe, 0x86, 0xd0, 0x7f, 0x96, 0xcd, 0x17, 0xcc, 0x05, 0x10, 0x96, 0x85, 0x2a, 0x91,
+	0xc7, 0xa3, 0x2f, 0x66, 0xdb, 0xec, 0x34, 0x5c, 0x55, 0x8f, 0x2a, 0xd7, 0x77, 0x2d, 0x03, 0xff,
+	0x41, 0x0d, 0xcd, 0x8d, 0x33, 0x36, 0x8b, 0xaf, 0xac, 0x21, 0x42, 0x73, 0x56, 0x4c, 0x52, 0xd5,
+	0x69, 0xde, 0xf9, 0xb4, 0xdf, 0xb4, 0xde, 0x17, 0x5e, 0xb2, 0x18, 0xe1, 0xd3, 0x0c, 0xbb, 0xb5,
+	0x39, 0x2b, 0xf4, 0x16, 0x76, 0x8e, 0xaf, 0x8d, 0x3d, 0x11, 0x59, 0x04, 0xbd, 0x51, 0x9c, 0x27,
+	0xe4, 0x8b, 0xaf, 0x3a, 0x35, 0xe7, 0xc3, 0x7e, 0xd3, 0x7a, 0x57, 0x52, 0xf0, 0x44, 0x84, 0xdd,
+	0xd7, 0x72, 0xdf, 0x13, 0xd1, 0xa8, 0x72, 0x2b, 0x2f, 0xf9, 0x87, 0xea, 0xc7, 0x4b, 0x00, 0x66,
+	0xd6, 0x4f, 0x54, 0xd5, 0x86, 0x94, 0x44, 0xbd, 0xdf, 0x26, 0xda, 0x2f, 0x51, 0x7e, 0x49, 0xde,
+	0x23, 0xbf, 0x9f, 0x3f, 0x49, 0x6d, 0xb8, 0x07, 0x1e, 0xff, 0x45, 0x6f, 0xcf, 0x57, 0x3e, 0x17,
+	0x99, 0x27, 0x0e, 0x5a, 0x43, 0x84, 0x44, 0x16, 0xb3, 0xc9, 0x12, 0xa6, 0x4c, 0xeb, 0xbd, 0x30,
+	0x75, 0x9a, 0x61, 0xb7, 0x26, 0x9b, 0x33, 0x59, 0x3b, 0x17, 0xf7, 0x5b, 0xdb, 0x5c, 0x6f, 0x6d,
+	0xf3, 0x71, 0x6b, 0x9b, 0x37, 0x3b, 0xdb, 0x58, 0xef, 0x6c, 0xe3, 0x61, 0x67, 0x1b, 0x97, 0xbf,
+	0xc2, 0x58, 0x44, 0x2b, 0x5f, 0xc6, 0x44, 0x8f, 0x29, 0xf8, 0x41, 0x37, 0x04, 0x9a, 0xff, 0xa0,
+	0x09, 0x4c, 0x57, 0x0b, 0xc6, 0x75, 0xe2, 0xfd, 0x41, 0xb7, 0x14, 0xba, 0x28, 0x52, 0xc6, 0xfd,
+	0xaa, 0x4a, 0x68, 0xf0, 0x34, 0x00, 0x0d, 0x8c, 0xf7, 0x53, 0x18, 0x02, 0x00, 0x00,
 }
 
 func (m *MerkleRoot) Marshal() (dAtA []byte, err error) {
@@ -371,6 +422,38 @@ func (m *MerkleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubstrateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubstrateProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubstrateProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrieNodes) > 0 {
+		for iNdEx := len(m.TrieNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrieNodes[iNdEx])
+			copy(dAtA[i:], m.TrieNodes[iNdEx])
+			i = encodeVarintCommitment(dAtA, i, uint64(len(m.TrieNodes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommitment(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommitment(v)
 	base := offset
@@ -438,6 +521,21 @@ func (m *MerkleProof) Size() (n int) {
 	return n
 }
 
+func (m *SubstrateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrieNodes) > 0 {
+		for _, b := range m.TrieNodes {
+			l = len(b)
+			n += 1 + l + sovCommitment(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCommitment(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -778,6 +876,88 @@ func (m *MerkleProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SubstrateProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubstrateProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubstrateProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrieNodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrieNodes = append(m.TrieNodes, make([]byte, postIndex-iNdEx))
+			copy(m.TrieNodes[len(m.TrieNodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommitment(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
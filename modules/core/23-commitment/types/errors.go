@@ -12,4 +12,5 @@ var (
 	ErrInvalidProof       = sdkerrors.Register(SubModuleName, 2, "invalid proof")
 	ErrInvalidPrefix      = sdkerrors.Register(SubModuleName, 3, "invalid prefix")
 	ErrInvalidMerkleProof = sdkerrors.Register(SubModuleName, 4, "invalid merkle proof")
+	ErrInvalidTrieNode    = sdkerrors.Register(SubModuleName, 5, "invalid substrate trie node")
 )
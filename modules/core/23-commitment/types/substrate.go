@@ -0,0 +1,329 @@
+package types
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ics23 "github.com/cosmos/ics23/go"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.Proof = (*SubstrateProof)(nil)
+
+// ChildStorageKeyPrefix prefixes the key under which the root of a default child
+// trie is stored in the state trie of a Substrate chain.
+var ChildStorageKeyPrefix = []byte(":child_storage:default:")
+
+// NewSubstrateProof creates a new SubstrateProof from a set of encoded trie nodes.
+func NewSubstrateProof(trieNodes [][]byte) SubstrateProof {
+	return SubstrateProof{
+		TrieNodes: trieNodes,
+	}
+}
+
+// GetValue looks up the value stored under the given path in the trie committed to by root.
+// A path with a single key is looked up in the state trie. A path with two keys is looked up
+// in a default child trie: the first key is the storage key of the child trie and the second
+// key is the key within the child trie. A false boolean is returned if the proof shows that
+// no value is stored under the path. An error is returned if the proof does not contain all
+// the trie nodes needed to resolve the path.
+func (proof SubstrateProof) GetValue(root exported.Root, path exported.Path) ([]byte, bool, error) {
+	if root == nil || root.Empty() {
+		return nil, false, sdkerrors.Wrap(ErrInvalidProof, "root cannot be empty")
+	}
+
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+
+	if len(mpath.KeyPath) != 1 && len(mpath.KeyPath) != 2 {
+		return nil, false, sdkerrors.Wrapf(ErrInvalidProof, "substrate proofs support paths of 1 or 2 keys, got %d", len(mpath.KeyPath))
+	}
+
+	keys := make([][]byte, len(mpath.KeyPath))
+	for i := range mpath.KeyPath {
+		key, err := mpath.GetKey(uint64(i))
+		if err != nil {
+			return nil, false, sdkerrors.Wrapf(ErrInvalidProof, "could not retrieve key bytes for key %s: %v", mpath.KeyPath[i], err)
+		}
+		keys[i] = key
+	}
+
+	nodes := proof.nodesByHash()
+	trieRoot := root.GetHash()
+	if len(keys) == 2 {
+		childKey := append(append([]byte(nil), ChildStorageKeyPrefix...), keys[0]...)
+		childRoot, found, err := nodes.get(trieRoot, childKey)
+		if err != nil || !found {
+			return nil, false, err
+		}
+
+		if len(childRoot) != trieHashLength {
+			return nil, false, sdkerrors.Wrapf(ErrInvalidProof, "invalid child trie root length %d", len(childRoot))
+		}
+		trieRoot = childRoot
+	}
+
+	return nodes.get(trieRoot, keys[len(keys)-1])
+}
+
+// VerifyMembership verifies the membership of a value under the given path in the trie
+// committed to by root. The proof specs are not used as Substrate tries do not follow
+// ICS-23 specifications.
+func (proof SubstrateProof) VerifyMembership(_ []*ics23.ProofSpec, root exported.Root, path exported.Path, value []byte) error {
+	if err := proof.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(value) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "empty value in membership proof")
+	}
+
+	storedValue, found, err := proof.GetValue(root, path)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return sdkerrors.Wrapf(ErrInvalidProof, "proof shows that no value is stored under path %s", path)
+	}
+
+	if !bytes.Equal(storedValue, value) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "value under path %s does not match the expected value", path)
+	}
+
+	return nil
+}
+
+// VerifyNonMembership verifies the absence of a value under the given path in the trie
+// committed to by root. The absence of the child trie of a two key path proves the absence
+// of the key. The proof specs are not used as Substrate tries do not follow ICS-23 specifications.
+func (proof SubstrateProof) VerifyNonMembership(_ []*ics23.ProofSpec, root exported.Root, path exported.Path) error {
+	if err := proof.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, found, err := proof.GetValue(root, path)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return sdkerrors.Wrapf(ErrInvalidProof, "proof shows that a value is stored under path %s", path)
+	}
+
+	return nil
+}
+
+// Empty returns true if the proof contains no trie nodes.
+func (proof *SubstrateProof) Empty() bool {
+	return proof == nil || len(proof.TrieNodes) == 0
+}
+
+// ValidateBasic checks that the proof is not empty and does not contain empty trie nodes.
+func (proof SubstrateProof) ValidateBasic() error {
+	if proof.Empty() {
+		return sdkerrors.Wrap(ErrInvalidProof, "substrate proof cannot be empty")
+	}
+
+	for i, node := range proof.TrieNodes {
+		if len(node) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProof, "trie node %d cannot be empty", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifySubstrateCompactProof verifies a compact proof, as generated by trie-db's
+// `generate_proof`, that value is stored under key in the trie committed to by root.
+// Compact proofs list the nodes on the path to the key in pre-order, omitting the
+// proven value and the references to child nodes included in the proof, so that
+// the root can only be recomputed given the expected value.
+func VerifySubstrateCompactProof(root exported.Root, trieNodes [][]byte, key, value []byte) error {
+	if root == nil || root.Empty() {
+		return sdkerrors.Wrap(ErrInvalidProof, "root cannot be empty")
+	}
+
+	v := &compactProofVerifier{nodes: trieNodes, value: value}
+	encoded, err := v.next(keyToNibbles(key))
+	if err != nil {
+		return err
+	}
+
+	if len(v.nodes) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidProof, "%d unused nodes in compact proof", len(v.nodes))
+	}
+
+	computed := trieHash(encoded)
+	if !bytes.Equal(computed[:], root.GetHash()) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "computed root %X does not match expected root %X", computed, root.GetHash())
+	}
+
+	return nil
+}
+
+// trieNodes is an unordered set of encoded trie nodes indexed by their hash.
+type trieNodes map[[trieHashLength]byte][]byte
+
+func (proof SubstrateProof) nodesByHash() trieNodes {
+	nodes := make(trieNodes, len(proof.TrieNodes))
+	for _, node := range proof.TrieNodes {
+		nodes[trieHash(node)] = node
+	}
+	return nodes
+}
+
+// get looks up key in the trie with the given root.
+func (nodes trieNodes) get(root, key []byte) ([]byte, bool, error) {
+	if len(root) != trieHashLength {
+		return nil, false, sdkerrors.Wrapf(ErrInvalidProof, "invalid root length %d", len(root))
+	}
+
+	var (
+		nibbles = keyToNibbles(key)
+		ref     = root
+	)
+	for {
+		encoded, err := nodes.resolve(ref)
+		if err != nil {
+			return nil, false, err
+		}
+
+		node, err := decodeTrieNode(encoded)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if node.kind == nodeEmpty || !bytes.HasPrefix(nibbles, node.partial) {
+			return nil, false, nil
+		}
+		nibbles = nibbles[len(node.partial):]
+
+		if len(nibbles) == 0 {
+			if !node.hasValue {
+				return nil, false, nil
+			}
+
+			value, err := nodes.nodeValue(node)
+			if err != nil {
+				return nil, false, err
+			}
+			return value, true, nil
+		}
+
+		if node.kind == nodeLeaf || !node.hasChild[nibbles[0]] {
+			return nil, false, nil
+		}
+
+		ref = node.children[nibbles[0]]
+		nibbles = nibbles[1:]
+	}
+}
+
+// resolve returns the encoded node for a child reference.
+func (nodes trieNodes) resolve(ref []byte) ([]byte, error) {
+	if len(ref) < trieHashLength {
+		return ref, nil
+	}
+
+	var hash [trieHashLength]byte
+	copy(hash[:], ref)
+	encoded, ok := nodes[hash]
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof is missing trie node %X", ref)
+	}
+
+	return encoded, nil
+}
+
+// nodeValue returns the value stored in the node, resolving hashed values.
+func (nodes trieNodes) nodeValue(node *trieNode) ([]byte, error) {
+	if !node.hashedValue {
+		return node.value, nil
+	}
+
+	var hash [trieHashLength]byte
+	copy(hash[:], node.value)
+	value, ok := nodes[hash]
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof is missing value node %X", node.value)
+	}
+
+	return value, nil
+}
+
+type compactProofVerifier struct {
+	nodes [][]byte
+	value []byte
+}
+
+// next pops the next proof node and rebuilds it along the remaining key nibbles.
+func (v *compactProofVerifier) next(nibbles []byte) ([]byte, error) {
+	if len(v.nodes) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "compact proof is missing nodes")
+	}
+
+	encoded := v.nodes[0]
+	v.nodes = v.nodes[1:]
+	return v.rebuild(encoded, nibbles)
+}
+
+// rebuild restores the omitted value and child references of an encoded node on
+// the path to the key and returns the complete node encoding.
+func (v *compactProofVerifier) rebuild(encoded, nibbles []byte) ([]byte, error) {
+	node, err := decodeTrieNode(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if node.kind == nodeEmpty || node.hashedValue || !bytes.HasPrefix(nibbles, node.partial) {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "compact proof node is not on the key path")
+	}
+	nibbles = nibbles[len(node.partial):]
+
+	if len(nibbles) == 0 {
+		if !node.hasValue || len(node.value) != 0 {
+			return nil, sdkerrors.Wrap(ErrInvalidProof, "compact proof must omit the proven value")
+		}
+
+		node.value = v.value
+		return node.encode(), nil
+	}
+
+	if node.kind == nodeLeaf || !node.hasChild[nibbles[0]] {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "compact proof does not contain the key")
+	}
+
+	index := nibbles[0]
+	var child []byte
+	switch ref := node.children[index]; {
+	case len(ref) == 0:
+		// omitted reference: the child is the next node of the proof
+		child, err = v.next(nibbles[1:])
+	case len(ref) < trieHashLength:
+		child, err = v.rebuild(ref, nibbles[1:])
+	default:
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "compact proof does not contain the key")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < branchChildren; i++ {
+		if node.hasChild[i] && i != int(index) && len(node.children[i]) == 0 {
+			return nil, sdkerrors.Wrap(ErrInvalidProof, "compact proof omits a child reference outside of the key path")
+		}
+	}
+
+	if len(child) < trieHashLength {
+		node.children[index] = child
+	} else {
+		hash := trieHash(child)
+		node.children[index] = hash[:]
+	}
+
+	return node.encode(), nil
+}
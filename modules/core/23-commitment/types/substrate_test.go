@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+// substrateVector is a Substrate trie proof captured from a Rococo local testnet running
+// parachain 2000. Key paths and values are hex encoded, an empty value denotes a proof
+// of absence.
+type substrateVector struct {
+	Name      string   `json:"name"`
+	Root      string   `json:"root"`
+	TrieNodes []string `json:"trie_nodes"`
+	KeyPath   []string `json:"key_path"`
+	Value     string   `json:"value"`
+	Compact   bool     `json:"compact"`
+}
+
+func (v substrateVector) decode(t *testing.T) (types.MerkleRoot, [][]byte, types.MerklePath, []byte) {
+	mustDecode := func(s string) []byte {
+		bz, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return bz
+	}
+
+	nodes := make([][]byte, len(v.TrieNodes))
+	for i, node := range v.TrieNodes {
+		nodes[i] = mustDecode(node)
+	}
+
+	keyPath := make([]string, len(v.KeyPath))
+	for i, key := range v.KeyPath {
+		keyPath[i] = url.PathEscape(string(mustDecode(key)))
+	}
+
+	return types.NewMerkleRoot(mustDecode(v.Root)), nodes, types.NewMerklePath(keyPath...), mustDecode(v.Value)
+}
+
+func loadSubstrateVectors(t *testing.T) []substrateVector {
+	bz, err := os.ReadFile("testdata/substrate_proofs.json")
+	require.NoError(t, err)
+
+	var vectors []substrateVector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors)
+
+	return vectors
+}
+
+func TestSubstrateProofVectors(t *testing.T) {
+	for _, vector := range loadSubstrateVectors(t) {
+		vector := vector
+		t.Run(vector.Name, func(t *testing.T) {
+			root, nodes, path, value := vector.decode(t)
+			wrongValue := append([]byte("wrong"), value...)
+
+			if vector.Compact {
+				key, err := path.GetKey(0)
+				require.NoError(t, err)
+
+				require.NoError(t, types.VerifySubstrateCompactProof(root, nodes, key, value))
+				require.Error(t, types.VerifySubstrateCompactProof(root, nodes, key, wrongValue))
+				require.Error(t, types.VerifySubstrateCompactProof(root, nodes, append(key, 0), value))
+				require.Error(t, types.VerifySubstrateCompactProof(types.NewMerkleRoot(make([]byte, 32)), nodes, key, value))
+				require.Error(t, types.VerifySubstrateCompactProof(root, append(nodes, nodes[0]), key, value))
+				require.Error(t, types.VerifySubstrateCompactProof(root, nil, key, value))
+				return
+			}
+
+			proof := types.NewSubstrateProof(nodes)
+			require.NoError(t, proof.ValidateBasic())
+
+			if len(value) == 0 {
+				require.NoError(t, proof.VerifyNonMembership(nil, root, path))
+				require.Error(t, proof.VerifyMembership(nil, root, path, []byte("value")))
+				return
+			}
+
+			require.NoError(t, proof.VerifyMembership(nil, root, path, value))
+			require.Error(t, proof.VerifyMembership(nil, root, path, wrongValue))
+			require.Error(t, proof.VerifyNonMembership(nil, root, path))
+
+			// every node of a membership proof is required to resolve the path
+			for i := range nodes {
+				incomplete := types.NewSubstrateProof(append(append([][]byte(nil), nodes[:i]...), nodes[i+1:]...))
+				_, _, err := incomplete.GetValue(root, path)
+				require.Error(t, err, "proof without node %d", i)
+			}
+		})
+	}
+}
+
+func TestSubstrateProofInvalidArguments(t *testing.T) {
+	root, nodes, path, value := loadSubstrateVectors(t)[0].decode(t)
+	proof := types.NewSubstrateProof(nodes)
+
+	testCases := []struct {
+		name  string
+		proof types.SubstrateProof
+		root  types.MerkleRoot
+		path  exported.Path
+	}{
+		{"empty proof", types.SubstrateProof{}, root, path},
+		{"empty trie node", types.NewSubstrateProof(append([][]byte{{}}, nodes...)), root, path},
+		{"empty root", proof, types.NewMerkleRoot(nil), path},
+		{"invalid root length", proof, types.NewMerkleRoot([]byte("root")), path},
+		{"invalid path type", proof, root, ibcmock.KeyPath{}},
+		{"path too long", proof, root, types.NewMerklePath(append(path.KeyPath, "key")...)},
+		{"empty path", proof, root, types.NewMerklePath()},
+		{"invalid trie node", types.NewSubstrateProof([][]byte{{0xff}}), types.NewMerkleRoot(make([]byte, 32)), path},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.proof.VerifyMembership(nil, tc.root, tc.path, value))
+			require.Error(t, tc.proof.VerifyNonMembership(nil, tc.root, tc.path))
+		})
+	}
+
+	require.Error(t, proof.VerifyMembership(nil, root, path, nil))
+	require.True(t, (*types.SubstrateProof)(nil).Empty())
+}
@@ -0,0 +1,367 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/blake2b"
+)
+
+// This file implements the node codec of Substrate base-16 Patricia-Merkle tries
+// hashed with BlakeTwo256. Both trie layouts used by Substrate are supported: V0
+// stores all values inline, while V1 stores values of 33 bytes or more as separate
+// nodes referenced by their hash.
+
+// node header prefixes and the number of bits used by the inline nibble count
+const (
+	headerMask            = 0b1100_0000
+	headerLeaf            = 0b0100_0000
+	headerBranchNoValue   = 0b1000_0000
+	headerBranchWithValue = 0b1100_0000
+
+	headerHashedValueLeafMask   = 0b1110_0000
+	headerHashedValueLeaf       = 0b0010_0000
+	headerHashedValueBranchMask = 0b1111_0000
+	headerHashedValueBranch     = 0b0001_0000
+
+	headerEmpty = 0b0000_0000
+
+	// trieHashLength is the length of a BlakeTwo256 hash
+	trieHashLength = 32
+	// maxNibbles bounds the size of a partial key
+	maxNibbles = 65535
+	// branchChildren is the number of children of a branch node
+	branchChildren = 16
+)
+
+type nodeKind int
+
+const (
+	nodeEmpty nodeKind = iota
+	nodeLeaf
+	nodeBranch
+)
+
+// trieNode is a decoded trie node. Children are kept as their raw references: a
+// 32 byte reference is the hash of the child while shorter references are the
+// inline encoding of the child.
+type trieNode struct {
+	kind        nodeKind
+	partial     []byte
+	hasValue    bool
+	hashedValue bool
+	value       []byte
+	children    [branchChildren][]byte
+	hasChild    [branchChildren]bool
+}
+
+// trieHash returns the BlakeTwo256 hash of bz.
+func trieHash(bz []byte) [trieHashLength]byte {
+	return blake2b.Sum256(bz)
+}
+
+// keyToNibbles returns the nibbles of key, most significant first.
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, 2*len(key))
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+// nodeReader reads the SCALE encoded fields of a trie node.
+type nodeReader struct {
+	bz []byte
+}
+
+func (r *nodeReader) readBytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.bz) {
+		return nil, sdkerrors.Wrapf(ErrInvalidTrieNode, "unexpected end of node: need %d bytes, %d remaining", n, len(r.bz))
+	}
+
+	bz := r.bz[:n]
+	r.bz = r.bz[n:]
+	return bz, nil
+}
+
+func (r *nodeReader) readByte() (byte, error) {
+	bz, err := r.readBytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return bz[0], nil
+}
+
+// readVec reads a byte vector prefixed by its compact encoded length.
+func (r *nodeReader) readVec() ([]byte, error) {
+	length, err := r.readCompactLength()
+	if err != nil {
+		return nil, err
+	}
+	return r.readBytes(length)
+}
+
+// readCompactLength reads a SCALE compact integer that is used as a length. Only the
+// canonical encoding is accepted.
+func (r *nodeReader) readCompactLength() (int, error) {
+	first, err := r.readByte()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		value uint64
+		min   uint64
+	)
+	switch first & 0b11 {
+	case 0b00:
+		return int(first >> 2), nil
+	case 0b01:
+		next, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		value, min = uint64(binary.LittleEndian.Uint16([]byte{first, next}))>>2, 1<<6
+	case 0b10:
+		rest, err := r.readBytes(3)
+		if err != nil {
+			return 0, err
+		}
+		value, min = uint64(binary.LittleEndian.Uint32(append([]byte{first}, rest...)))>>2, 1<<14
+	default:
+		size := int(first>>2) + 4
+		if size > 8 {
+			return 0, sdkerrors.Wrapf(ErrInvalidTrieNode, "compact length of %d bytes", size)
+		}
+		bz, err := r.readBytes(size)
+		if err != nil {
+			return 0, err
+		}
+		if bz[size-1] == 0 {
+			return 0, sdkerrors.Wrap(ErrInvalidTrieNode, "non-canonical compact length")
+		}
+		var buf [8]byte
+		copy(buf[:], bz)
+		value = binary.LittleEndian.Uint64(buf[:])
+		min = 1 << 30
+	}
+
+	if value < min {
+		return 0, sdkerrors.Wrap(ErrInvalidTrieNode, "non-canonical compact length")
+	}
+
+	if value > uint64(len(r.bz)) {
+		return 0, sdkerrors.Wrapf(ErrInvalidTrieNode, "length %d exceeds the %d remaining bytes", value, len(r.bz))
+	}
+
+	return int(value), nil
+}
+
+// encodeCompactLength returns the SCALE compact encoding of a length.
+func encodeCompactLength(length int) []byte {
+	value := uint64(length)
+	switch {
+	case value < 1<<6:
+		return []byte{byte(value << 2)}
+	case value < 1<<14:
+		return binary.LittleEndian.AppendUint16(nil, uint16(value<<2|0b01))
+	case value < 1<<30:
+		return binary.LittleEndian.AppendUint32(nil, uint32(value<<2|0b10))
+	default:
+		bz := binary.LittleEndian.AppendUint64(nil, value)
+		for len(bz) > 4 && bz[len(bz)-1] == 0 {
+			bz = bz[:len(bz)-1]
+		}
+		return append([]byte{byte(len(bz)-4)<<2 | 0b11}, bz...)
+	}
+}
+
+// decodeTrieNode decodes a single encoded trie node.
+func decodeTrieNode(bz []byte) (*trieNode, error) {
+	r := &nodeReader{bz: bz}
+	first, err := r.readByte()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &trieNode{}
+	var nibbleBits uint
+	switch {
+	case first == headerEmpty:
+		if len(r.bz) != 0 {
+			return nil, sdkerrors.Wrapf(ErrInvalidTrieNode, "%d trailing bytes after empty node", len(r.bz))
+		}
+		return node, nil
+	case first&headerMask == headerLeaf:
+		node.kind, node.hasValue, nibbleBits = nodeLeaf, true, 6
+	case first&headerMask == headerBranchNoValue:
+		node.kind, nibbleBits = nodeBranch, 6
+	case first&headerMask == headerBranchWithValue:
+		node.kind, node.hasValue, nibbleBits = nodeBranch, true, 6
+	case first&headerHashedValueLeafMask == headerHashedValueLeaf:
+		node.kind, node.hasValue, node.hashedValue, nibbleBits = nodeLeaf, true, true, 5
+	case first&headerHashedValueBranchMask == headerHashedValueBranch:
+		node.kind, node.hasValue, node.hashedValue, nibbleBits = nodeBranch, true, true, 4
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidTrieNode, "invalid node header %#x", first)
+	}
+
+	nibbleCount, err := readNibbleCount(r, first, nibbleBits)
+	if err != nil {
+		return nil, err
+	}
+
+	partial, err := r.readBytes((nibbleCount + 1) / 2)
+	if err != nil {
+		return nil, err
+	}
+
+	node.partial = keyToNibbles(partial)
+	if nibbleCount%2 == 1 {
+		// odd partial keys are left padded with a zero nibble
+		if node.partial[0] != 0 {
+			return nil, sdkerrors.Wrap(ErrInvalidTrieNode, "invalid partial key padding")
+		}
+		node.partial = node.partial[1:]
+	}
+
+	var bitmap uint16
+	if node.kind == nodeBranch {
+		bz, err := r.readBytes(2)
+		if err != nil {
+			return nil, err
+		}
+		bitmap = binary.LittleEndian.Uint16(bz)
+	}
+
+	if node.hasValue {
+		if node.hashedValue {
+			if node.value, err = r.readBytes(trieHashLength); err != nil {
+				return nil, err
+			}
+		} else if node.value, err = r.readVec(); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := 0; i < branchChildren; i++ {
+		if bitmap&(1<<i) == 0 {
+			continue
+		}
+
+		if node.children[i], err = r.readVec(); err != nil {
+			return nil, err
+		}
+		if len(node.children[i]) > trieHashLength {
+			return nil, sdkerrors.Wrapf(ErrInvalidTrieNode, "child reference of %d bytes", len(node.children[i]))
+		}
+		node.hasChild[i] = true
+	}
+
+	if len(r.bz) != 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalidTrieNode, "%d trailing bytes after trie node", len(r.bz))
+	}
+
+	return node, nil
+}
+
+// readNibbleCount reads the partial key length stored in the node header. When the
+// inline bits are saturated the length continues in the following bytes.
+func readNibbleCount(r *nodeReader, first byte, bits uint) (int, error) {
+	max := int(1<<bits) - 1
+	count := int(first) & max
+	if count < max {
+		return count, nil
+	}
+
+	for {
+		next, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+
+		count += int(next)
+		if count > maxNibbles {
+			return 0, sdkerrors.Wrap(ErrInvalidTrieNode, "partial key too long")
+		}
+		if next < 255 {
+			return count, nil
+		}
+	}
+}
+
+// encode returns the encoding of the node. Encoding a decoded node yields the
+// original bytes.
+func (n *trieNode) encode() []byte {
+	if n.kind == nodeEmpty {
+		return []byte{headerEmpty}
+	}
+
+	var (
+		prefix byte
+		bits   uint
+	)
+	switch {
+	case n.kind == nodeLeaf && n.hashedValue:
+		prefix, bits = headerHashedValueLeaf, 5
+	case n.kind == nodeLeaf:
+		prefix, bits = headerLeaf, 6
+	case n.hashedValue:
+		prefix, bits = headerHashedValueBranch, 4
+	case n.hasValue:
+		prefix, bits = headerBranchWithValue, 6
+	default:
+		prefix, bits = headerBranchNoValue, 6
+	}
+
+	var buf bytes.Buffer
+	max := 1<<bits - 1
+	count := len(n.partial)
+	if count < max {
+		buf.WriteByte(prefix | byte(count))
+	} else {
+		buf.WriteByte(prefix | byte(max))
+		for count -= max; count >= 255; count -= 255 {
+			buf.WriteByte(255)
+		}
+		buf.WriteByte(byte(count))
+	}
+
+	partial := n.partial
+	if len(partial)%2 == 1 {
+		buf.WriteByte(partial[0])
+		partial = partial[1:]
+	}
+	for i := 0; i < len(partial); i += 2 {
+		buf.WriteByte(partial[i]<<4 | partial[i+1])
+	}
+
+	if n.kind == nodeBranch {
+		var bitmap uint16
+		for i := 0; i < branchChildren; i++ {
+			if n.hasChild[i] {
+				bitmap |= 1 << i
+			}
+		}
+		buf.Write(binary.LittleEndian.AppendUint16(nil, bitmap))
+	}
+
+	if n.hasValue {
+		if n.hashedValue {
+			buf.Write(n.value)
+		} else {
+			buf.Write(encodeCompactLength(len(n.value)))
+			buf.Write(n.value)
+		}
+	}
+
+	for i := 0; i < branchChildren; i++ {
+		if n.hasChild[i] {
+			buf.Write(encodeCompactLength(len(n.children[i])))
+			buf.Write(n.children[i])
+		}
+	}
+
+	return buf.Bytes()
+}
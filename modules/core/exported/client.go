@@ -25,6 +25,12 @@ const (
 	// Localhost is the client type for the localhost client.
 	Localhost string = "09-localhost"
 
+	// Grandpa is used to indicate that the client tracks a Substrate parachain finalized by GRANDPA.
+	Grandpa string = "10-grandpa"
+
+	// Beefy is used to indicate that the client tracks a Substrate parachain through BEEFY MMR commitments.
+	Beefy string = "11-beefy"
+
 	// LocalhostClientID is the sentinel client ID for the localhost client.
 	LocalhostClientID string = Localhost
 
@@ -56,7 +62,7 @@ type ClientState interface {
 	Status(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryCodec) Status
 
 	// ExportMetadata must export metadata stored within the clientStore for genesis export
-	ExportMetadata(clientStore sdk.KVStore) []GenesisMetadata
+	ExportMetadata(ctx sdk.Context, clientStore sdk.KVStore) []GenesisMetadata
 
 	// ZeroCustomFields zeroes out any client customizable fields in client state
 	// Ledger enforced fields are maintained while all custom fields are zero values
@@ -62,6 +62,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 		stakingKeeper clienttypes.StakingKeeper
 		upgradeKeeper clienttypes.UpgradeKeeper
 		scopedKeeper  capabilitykeeper.ScopedKeeper
+		authority     string
 		newIBCKeeper  = func() {
 			ibckeeper.NewKeeper(
 				suite.chainA.GetSimApp().AppCodec(),
@@ -70,6 +71,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				stakingKeeper,
 				upgradeKeeper,
 				scopedKeeper,
+				authority,
 			)
 		}
 	)
@@ -110,6 +112,9 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 
 			scopedKeeper = emptyScopedKeeper
 		}, false},
+		{"failure: empty authority", func() {
+			authority = ""
+		}, false},
 		{"success: replace stakingKeeper with non-empty MockStakingKeeper", func() {
 			// use a different implementation of clienttypes.StakingKeeper
 			mockStakingKeeper := MockStakingKeeper{"not empty"}
@@ -126,6 +131,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 			stakingKeeper = suite.chainA.GetSimApp().StakingKeeper
 			upgradeKeeper = suite.chainA.GetSimApp().UpgradeKeeper
 			scopedKeeper = suite.chainA.GetSimApp().ScopedIBCKeeper
+			authority = suite.chainA.GetSimApp().IBCKeeper.GetAuthority()
 
 			tc.malleate()
 
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	"github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
 )
@@ -774,3 +775,61 @@ func (suite *KeeperTestSuite) TestUpgradeClient() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrateClientType() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgMigrateClientType
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid authority", func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			}, false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			// wrap the tendermint client of chain A into a 08-wasm client
+			clientState := path.EndpointA.GetClientState()
+			consensusState := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+
+			data, err := suite.chainA.Codec.MarshalInterface(consensusState)
+			suite.Require().NoError(err)
+			path.EndpointA.SetConsensusState(&wasmtypes.ConsensusState{Data: data, Timestamp: consensusState.GetTimestamp()}, clientState.GetLatestHeight())
+
+			data, err = suite.chainA.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+			path.EndpointA.SetClientState(wasmtypes.NewClientState(data, []byte("code id"), clientState.GetLatestHeight().(clienttypes.Height)))
+
+			msg = clienttypes.NewMsgMigrateClientType(path.EndpointA.ClientID, exported.Tendermint, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err = keeper.Keeper.MigrateClientType(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clientState, path.EndpointA.GetClientState())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(exported.Wasm, path.EndpointA.GetClientState().ClientType())
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	localhost "github.com/cosmos/ibc-go/v7/modules/light-clients/09-localhost"
 	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	beefy "github.com/cosmos/ibc-go/v7/modules/light-clients/11-beefy"
 )
 
 // RegisterInterfaces registers ibc types against interfaces using the global InterfaceRegistry.
@@ -20,4 +21,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	commitmenttypes.RegisterInterfaces(registry)
 	localhost.RegisterInterfaces(registry)
 	grandpa.RegisterInterfaces(registry)
+	beefy.RegisterInterfaces(registry)
 }
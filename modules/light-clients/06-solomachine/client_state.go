@@ -90,7 +90,7 @@ func (cs ClientState) Initialize(_ sdk.Context, cdc codec.BinaryCodec, clientSto
 }
 
 // ExportMetadata is a no-op since solomachine does not store any metadata in client store
-func (cs ClientState) ExportMetadata(_ sdk.KVStore) []exported.GenesisMetadata {
+func (cs ClientState) ExportMetadata(_ sdk.Context, _ sdk.KVStore) []exported.GenesisMetadata {
 	return nil
 }
 
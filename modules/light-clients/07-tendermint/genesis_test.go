@@ -26,7 +26,7 @@ func (suite *TendermintTestSuite) TestExportMetadata() {
 	initProcessedHeight, found := ibctm.GetProcessedHeight(clientStore, height)
 	suite.Require().True(found)
 
-	gm := clientState.ExportMetadata(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
+	gm := clientState.ExportMetadata(suite.chainA.GetContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
 	suite.Require().NotNil(gm, "client with metadata returned nil exported metadata")
 	suite.Require().Len(gm, 3, "exported metadata has unexpected length")
 
@@ -55,7 +55,7 @@ func (suite *TendermintTestSuite) TestExportMetadata() {
 	processedHeight, found := ibctm.GetProcessedHeight(clientStore, updateHeight)
 	suite.Require().True(found)
 
-	gm = clientState.ExportMetadata(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
+	gm = clientState.ExportMetadata(suite.chainA.GetContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
 	suite.Require().NotNil(gm, "client with metadata returned nil exported metadata")
 	suite.Require().Len(gm, 6, "exported metadata has unexpected length")
 
@@ -21,6 +21,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getCmdCode(),
 		getAllWasmCode(),
+		getCmdCodeInfo(),
+		getCmdClientsByCode(),
+		getCmdApprovedChecksums(),
+		getCmdUpload(),
 	)
 
 	return queryCmd
@@ -39,6 +43,16 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		newPushNewWasmCodeCmd(),
 		newUpdateWasmCodeId(),
+		newPinCodesCmd(),
+		newUnpinCodesCmd(),
+		newRemoveWasmCodeCmd(),
+		newMigrateContractCmd(),
+		newUpdateAllowedQueriesCmd(),
+		newApproveChecksumsCmd(),
+		newRevokeChecksumsCmd(),
+		newBeginUploadCmd(),
+		newUploadChunkCmd(),
+		newFinalizeUploadCmd(),
 	)
 
 	return txCmd
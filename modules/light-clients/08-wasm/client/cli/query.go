@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -88,3 +89,161 @@ func getAllWasmCode() *cobra.Command {
 
 	return cmd
 }
+
+// getCmdCodeInfo defines the command to query the metadata of the wasm code for given code id
+func getCmdCodeInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-info [code-id]",
+		Short: "Query the metadata of wasm code",
+		Long:  "Query the metadata of wasm code and the clients using it",
+		Example: fmt.Sprintf(
+			"%s query %s %s code-info [code-id]", version.AppName, host.SubModuleName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.CodeInfoQuery{
+				CodeId: args[0],
+			}
+
+			res, err := queryClient.CodeInfo(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdClientsByCode defines the command to query the clients using the wasm code for given code id
+func getCmdClientsByCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clients-by-code [code-id]",
+		Short: "Query the clients using wasm code",
+		Long:  "Query the clients using wasm code",
+		Example: fmt.Sprintf(
+			"%s query %s %s clients-by-code [code-id]", version.AppName, host.SubModuleName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.ClientsByCodeQuery{
+				CodeId:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientsByCode(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "clients by code")
+
+	return cmd
+}
+
+// getCmdApprovedChecksums defines the command to query the checksums of the wasm codes approved for upload
+func getCmdApprovedChecksums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approved-checksums",
+		Short: "Query the checksums of the wasm codes approved for upload",
+		Long:  "Query the checksums of the wasm codes approved for upload which have not been pushed yet",
+		Example: fmt.Sprintf(
+			"%s query %s approved-checksums", version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.ApprovedChecksumsQuery{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ApprovedChecksums(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "approved checksums")
+
+	return cmd
+}
+
+// getCmdUpload defines the command to query the upload session for given upload id
+func getCmdUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload [upload-id]",
+		Short: "Query an upload session",
+		Long:  "Query the progress of an upload session of wasm code in chunks",
+		Example: fmt.Sprintf(
+			"%s query %s upload 1", version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := types.UploadQuery{
+				UploadId: uploadID,
+			}
+
+			res, err := queryClient.Upload(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"io/ioutil"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -11,6 +12,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagLabel       = "label"
+	flagCodeVersion = "code-version"
+)
+
 // newPushNewWasmCodeCmd returns the command to create a PushNewWasmCode transaction
 func newPushNewWasmCodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -30,15 +36,29 @@ func newPushNewWasmCodeCmd() *cobra.Command {
 				return err
 			}
 
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			codeVersion, err := cmd.Flags().GetString(flagCodeVersion)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgPushNewWasmCode{
-				Code:   code,
-				Signer: clientCtx.GetFromAddress().String(),
+				Code:    code,
+				Signer:  clientCtx.GetFromAddress().String(),
+				Label:   label,
+				Version: codeVersion,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagLabel, "", "optional label of the wasm code")
+	cmd.Flags().String(flagCodeVersion, "", "optional version of the wasm code")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -77,3 +97,324 @@ func newUpdateWasmCodeId() *cobra.Command {
 
 	return cmd
 }
+
+// newPinCodesCmd returns the command to create a PinCodes transaction
+func newPinCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin-codes [code-id]...",
+		Short: "Pins wasm codes in the VM memory cache",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIds, err := parseCodeIds(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPinCodes(clientCtx.GetFromAddress().String(), codeIds...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newUnpinCodesCmd returns the command to create a UnpinCodes transaction
+func newUnpinCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin-codes [code-id]...",
+		Short: "Unpins wasm codes from the VM memory cache",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIds, err := parseCodeIds(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpinCodes(clientCtx.GetFromAddress().String(), codeIds...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newRemoveWasmCodeCmd returns the command to create a RemoveWasmCode transaction
+func newRemoveWasmCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-wasm-code [code-id]",
+		Short: "Removes wasm code which is not used by any client",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeId, err := transfertypes.ParseHexHash(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveWasmCode(clientCtx.GetFromAddress().String(), codeId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newMigrateContractCmd returns the command to create a MigrateContract transaction
+func newMigrateContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-contract [client-id] [code-id] [migrate-msg]",
+		Short:   "Migrates the contract of a client to new wasm code",
+		Example: `migrate-contract 08-wasm-0 <code-id> '{}'`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeId, err := transfertypes.ParseHexHash(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateContract(clientCtx.GetFromAddress().String(), args[0], codeId, []byte(args[2]))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newUpdateAllowedQueriesCmd returns the command to create a UpdateAllowedQueries transaction
+func newUpdateAllowedQueriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-allowed-queries [query-path]...",
+		Short:   "Replaces the chain queries which wasm light client contracts are allowed to make",
+		Long:    "Replaces the chain queries which wasm light client contracts are allowed to make. Without arguments, all queries are disallowed.",
+		Example: `update-allowed-queries /cosmos.staking.v1beta1.Query/Params`,
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAllowedQueries(clientCtx.GetFromAddress().String(), args...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newApproveChecksumsCmd returns the command to create a ApproveChecksums transaction
+func newApproveChecksumsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-checksums [checksum]...",
+		Short: "Approves the checksums of wasm codes which any account may then push",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksums, err := parseCodeIds(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveChecksums(clientCtx.GetFromAddress().String(), checksums...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newRevokeChecksumsCmd returns the command to create a RevokeChecksums transaction
+func newRevokeChecksumsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-checksums [checksum]...",
+		Short: "Revokes the approval of wasm code checksums which have not been pushed yet",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksums, err := parseCodeIds(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeChecksums(clientCtx.GetFromAddress().String(), checksums...)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newBeginUploadCmd returns the command to create a BeginUpload transaction
+func newBeginUploadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-upload [checksum] [code-size]",
+		Short: "Begins uploading wasm code in chunks",
+		Long:  "Begins uploading wasm code in chunks. The checksum is the sha256 checksum of the uncompressed code and the code size is the size in bytes of the code to upload, which may be gzip compressed.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := transfertypes.ParseHexHash(args[0])
+			if err != nil {
+				return err
+			}
+
+			codeSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			label, err := cmd.Flags().GetString(flagLabel)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetString(flagCodeVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBeginUpload(clientCtx.GetFromAddress().String(), checksum, codeSize)
+			msg.Label = label
+			msg.Version = version
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagLabel, "", "optional label of the wasm code")
+	cmd.Flags().String(flagCodeVersion, "", "optional version of the wasm code")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newUploadChunkCmd returns the command to create a UploadChunk transaction
+func newUploadChunkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-chunk [upload-id] [index] [chunk-file]",
+		Short: "Reads a chunk of wasm code from the file and sends it to the upload session",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			chunk, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUploadChunk(clientCtx.GetFromAddress().String(), uploadID, uint32(index), chunk)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newFinalizeUploadCmd returns the command to create a FinalizeUpload transaction
+func newFinalizeUploadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-upload [upload-id]",
+		Short: "Stores the wasm code of an upload session once all chunks are sent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFinalizeUpload(clientCtx.GetFromAddress().String(), uploadID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseCodeIds parses hex encoded code ids
+func parseCodeIds(args []string) ([][]byte, error) {
+	codeIds := make([][]byte, len(args))
+	for i, arg := range args {
+		codeId, err := transfertypes.ParseHexHash(arg)
+		if err != nil {
+			return nil, err
+		}
+		codeIds[i] = codeId
+	}
+	return codeIds, nil
+}
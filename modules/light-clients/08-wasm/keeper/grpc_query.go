@@ -17,3 +17,28 @@ func (q Keeper) WasmCode(c context.Context, req *types.WasmCodeQuery) (*types.Wa
 func (q Keeper) AllWasmCodeID(c context.Context, req *types.AllWasmCodeIDQuery) (*types.AllWasmCodeIDResponse, error) {
 	return q.getAllWasmCodeID(c, req)
 }
+
+// CodeInfo implements the IBC QueryServer interface
+func (q Keeper) CodeInfo(c context.Context, req *types.CodeInfoQuery) (*types.CodeInfoResponse, error) {
+	return q.getCodeInfo(c, req)
+}
+
+// ClientsByCode implements the IBC QueryServer interface
+func (q Keeper) ClientsByCode(c context.Context, req *types.ClientsByCodeQuery) (*types.ClientsByCodeResponse, error) {
+	return q.getClientsByCode(c, req)
+}
+
+// ApprovedChecksums implements the IBC QueryServer interface
+func (q Keeper) ApprovedChecksums(c context.Context, req *types.ApprovedChecksumsQuery) (*types.ApprovedChecksumsResponse, error) {
+	return q.getApprovedChecksums(c, req)
+}
+
+// Upload implements the IBC QueryServer interface
+func (q Keeper) Upload(c context.Context, req *types.UploadQuery) (*types.UploadResponse, error) {
+	return q.getUpload(c, req)
+}
+
+// Params implements the IBC QueryServer interface
+func (q Keeper) Params(c context.Context, req *types.ParamsQuery) (*types.ParamsResponse, error) {
+	return q.getParams(c, req)
+}
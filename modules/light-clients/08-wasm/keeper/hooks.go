@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+var _ clienttypes.ClientHooks = Keeper{}
+
+// AfterClientStateChanged indexes the code used by the client if it is an 08-wasm client.
+// Clients of any other type are removed from the index, e.g. after they have been migrated
+// to their native client type.
+func (k Keeper) AfterClientStateChanged(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	var codeID []byte
+	if wasmClientState, ok := clientState.(*types.ClientState); ok {
+		codeID = wasmClientState.CodeId
+	}
+
+	k.setClientCode(ctx, clientID, codeID)
+}
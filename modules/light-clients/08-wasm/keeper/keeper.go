@@ -6,8 +6,6 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
-	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
-	"math"
 	"path/filepath"
 	"strings"
 
@@ -15,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	cosmwasm "github.com/CosmWasm/wasmvm"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -22,49 +21,111 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
+	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 )
 
 type Keeper struct {
 	storeKey     storetypes.StoreKey
 	cdc          codec.BinaryCodec
-	wasmVM       *cosmwasm.VM
+	wasmVM       types.WasmEngine
+	cleanupVM    func()
+	vmConfig     types.VMConfig
+	queryRouter  types.QueryRouter
 	authority    string
 	clientKeeper *clientkeeper.Keeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string, homeDir string, clientKeeper *clientkeeper.Keeper) Keeper {
+// NewKeeper creates a new keeper. Unless an engine is passed with the WithWasmEngine option, the
+// keeper creates a VM with the default VM settings, or the settings passed with the WithVMConfig
+// option. It panics if the settings are invalid or the VM cannot be created.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string, homeDir string, clientKeeper *clientkeeper.Keeper, opts ...Option) Keeper {
+	k := Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		vmConfig:     types.DefaultVMConfig(),
+		authority:    authority,
+		clientKeeper: clientKeeper,
+	}
+
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	if k.wasmVM != nil {
+		return k
+	}
+
+	if err := k.vmConfig.Validate(); err != nil {
+		panic(fmt.Errorf("invalid wasm vm config: %w", err))
+	}
+
 	// Wasm VM
-	wasmDataDir := filepath.Join(homeDir, "wasm_client_data")
-	wasmSupportedFeatures := strings.Join([]string{"storage", "iterator"}, ",")
-	wasmMemoryLimitMb := uint32(math.Pow(2, 12))
-	wasmPrintDebug := true
-	wasmCacheSizeMb := uint32(math.Pow(2, 8))
+	wasmDataDir := k.vmConfig.DataDir
+	if !filepath.IsAbs(wasmDataDir) {
+		wasmDataDir = filepath.Join(homeDir, wasmDataDir)
+	}
+	wasmSupportedFeatures := strings.Join(k.vmConfig.SupportedCapabilities, ",")
 
-	vm, err := cosmwasm.NewVM(wasmDataDir, wasmSupportedFeatures, wasmMemoryLimitMb, wasmPrintDebug, wasmCacheSizeMb)
+	vm, err := cosmwasm.NewVM(wasmDataDir, wasmSupportedFeatures, k.vmConfig.MemoryLimitMb, k.vmConfig.PrintDebug, k.vmConfig.CacheSizeMb)
 	if err != nil {
 		panic(err)
 	}
-	types.WasmVM = vm
+	k.wasmVM = vm
+	k.cleanupVM = vm.Cleanup
 
-	// governance authority
+	return k
+}
 
-	return Keeper{
-		cdc:          cdc,
-		storeKey:     key,
-		wasmVM:       vm,
-		authority:    authority,
-		clientKeeper: clientKeeper,
+// Cleanup releases the VM created by the keeper. The keeper must not be used afterwards. It is a
+// no-op if the engine was passed with the WithWasmEngine option.
+func (k Keeper) Cleanup() {
+	if k.cleanupVM != nil {
+		k.cleanupVM()
 	}
 }
 
-func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte) ([]byte, error) {
+// GetWasmEngine returns the engine used by the keeper.
+func (k Keeper) GetWasmEngine() types.WasmEngine {
+	return k.wasmVM
+}
+
+// ClientContext returns a copy of the context which carries the engine of the keeper, the getters
+// of the module parameters and of the code metadata, and the factory of the querier answering the
+// allowed chain queries of contracts, as required by the methods of 08-wasm client states. It is
+// set as the context decorator of the client keeper.
+func (k Keeper) ClientContext(ctx sdk.Context) sdk.Context {
+	ctx = types.WithWasmEngine(ctx, k.wasmVM)
+	ctx = types.WithParamsGetter(ctx, k.GetParams)
+	ctx = types.WithCodeInfoGetter(ctx, k.GetCodeInfo)
+	return types.WithQuerierFactory(ctx, k.newQueryHandler)
+}
+
+// GetVMConfig returns the settings of the VM used by the keeper.
+func (k Keeper) GetVMConfig() types.VMConfig {
+	return k.vmConfig
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.ModuleName)
+}
+
+// storeWasmCode stores the code in the VM and under its code id, together with its metadata. Unless
+// the creator is the authority, the checksum of the code must have been approved for upload. The
+// creator, label and version are taken from the given code info, the other fields are filled in.
+// If the code id of the given code info is set, the code must have that code id.
+func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte, info types.CodeInfo) ([]byte, error) {
 	store := ctx.KVStore(k.storeKey)
+	params := k.GetParams(ctx)
+	gasRegister := params.GasRegister()
 
 	var err error
 	if IsGzip(code) {
-		ctx.GasMeter().ConsumeGas(types.VMGasRegister.UncompressCosts(len(code)), "Uncompress gzip bytecode")
-		code, err = Uncompress(code, uint64(types.MaxWasmSize))
+		ctx.GasMeter().ConsumeGas(gasRegister.UncompressCosts(len(code)), "Uncompress gzip bytecode")
+		code, err = Uncompress(code, params.MaxWasmCodeSize)
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 		}
@@ -72,55 +133,407 @@ func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte) ([]byte, error) {
 
 	// Check to see if the store has a code with the same code it
 	codeHash := generateWasmCodeHash(code)
+	if info.CodeId != nil && !bytes.Equal(info.CodeId, codeHash) {
+		return nil, sdkerrors.Wrapf(types.ErrWasmInvalidCodeID, "expected code id %s, got %s", hex.EncodeToString(info.CodeId), hex.EncodeToString(codeHash))
+	}
 	codeIDKey := types.CodeID(codeHash)
 	if store.Has(codeIDKey) {
 		return nil, types.ErrWasmCodeExists
 	}
 
+	// codes not uploaded by the authority must have a checksum approved by it
+	if info.Creator != k.authority && !k.IsApprovedChecksum(ctx, codeHash) {
+		return nil, sdkerrors.Wrapf(types.ErrChecksumNotApproved, "checksum %s has not been approved by the authority", hex.EncodeToString(codeHash))
+	}
+
 	// run the code through the wasm light client validation process
-	if isValidWasmCode, err := types.ValidateWasmCode(code); err != nil {
+	if isValidWasmCode, err := types.ValidateWasmCode(code, params.MaxWasmCodeSize); err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrWasmCodeValidation, "unable to validate wasm code: %s", err)
 	} else if !isValidWasmCode {
 		return nil, types.ErrWasmInvalidCode
 	}
 
 	// create the code in the vm
-	ctx.GasMeter().ConsumeGas(types.VMGasRegister.CompileCosts(len(code)), "Compiling wasm bytecode")
-	codeID, err := types.WasmVM.Create(code)
+	ctx.GasMeter().ConsumeGas(gasRegister.CompileCosts(len(code)), "Compiling wasm bytecode")
+	codeID, err := k.wasmVM.StoreCode(code)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrWasmInvalidCode, "unable to compile wasm code: %s", err)
 	}
 
-	// safety check to assert that code id returned by WasmVM equals to code hash
+	// safety check to assert that code id returned by the engine equals to code hash
 	if !bytes.Equal(codeID, codeHash) {
 		return nil, types.ErrWasmInvalidCodeID
 	}
 
+	// reject code requiring capabilities the VM is not configured to support
+	if err := k.analyzeWasmCode(codeID); err != nil {
+		if removeErr := k.wasmVM.RemoveCode(codeID); removeErr != nil {
+			k.Logger(ctx).Error("failed to remove rejected wasm code from the VM", "code-id", hex.EncodeToString(codeID), "error", removeErr)
+		}
+		return nil, err
+	}
+
 	store.Set(codeIDKey, code)
+	// the code may have been removed earlier in the block, it must not be removed from the VM anymore
+	store.Delete(types.RemovedCode(codeID))
+	// the approval of the checksum is consumed by the upload
+	store.Delete(types.ApprovedChecksum(codeID))
+
+	k.setCodeInfo(ctx, types.NewCodeInfo(codeID, info.Creator, ctx.BlockHeight(), ctx.BlockTime(), uint64(len(code)), info.Label, info.Version, types.DeclaredABIVersion(code)))
 	return codeID, nil
 }
 
-func (k Keeper) importWasmCode(ctx sdk.Context, codeHash, wasmCode []byte) error {
-	store := ctx.KVStore(k.storeKey)
-	if IsGzip(wasmCode) {
-		var err error
-		wasmCode, err = Uncompress(wasmCode, uint64(types.MaxWasmSize))
-		if err != nil {
-			return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+// analyzeWasmCode checks that the capabilities required by the code stored in the VM are supported
+// by the VM configuration.
+func (k Keeper) analyzeWasmCode(codeID []byte) error {
+	report, err := k.wasmVM.AnalyzeCode(codeID)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrWasmInvalidCode, "unable to analyze wasm code: %s", err)
+	}
+
+	supported := make(map[string]bool, len(k.vmConfig.SupportedCapabilities))
+	for _, capability := range k.vmConfig.SupportedCapabilities {
+		supported[capability] = true
+	}
+
+	for _, capability := range strings.Split(report.RequiredCapabilities, ",") {
+		capability = strings.TrimSpace(capability)
+		if capability != "" && !supported[capability] {
+			return sdkerrors.Wrapf(types.ErrWasmInvalidCode, "wasm code requires unsupported capability %s", capability)
 		}
 	}
-	newCodeHash, err := k.wasmVM.Create(wasmCode)
+
+	return nil
+}
+
+// importWasmCode stores the code of a genesis contract in the VM and under its code id, together
+// with its metadata. Metadata is created for contracts exported without it, and records the ABI
+// version declared by the code if the exported metadata does not. The code is checked against the
+// hard limit on the code size, as it may have been stored under a larger max wasm code size
+// parameter.
+func (k Keeper) importWasmCode(ctx sdk.Context, contract types.GenesisContract) error {
+	wasmCode, err := contract.UncompressedCode(types.MaxWasmSize)
+	if err != nil {
+		return err
+	}
+
+	codeID, err := k.wasmVM.StoreCode(wasmCode)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
-	if !bytes.Equal(codeHash, types.CodeID(newCodeHash)) {
-		return sdkerrors.Wrap(types.ErrInvalid, "code hashes not same")
+	if !bytes.Equal(codeID, contract.CodeHash) {
+		return sdkerrors.Wrapf(types.ErrWasmInvalidCodeID, "expected code id %s, got %s", hex.EncodeToString(contract.CodeHash), hex.EncodeToString(codeID))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CodeID(codeID), wasmCode)
+
+	info := types.CodeInfo{CodeId: codeID, CodeSize: uint64(len(wasmCode))}
+	if contract.CodeInfo != nil {
+		info = *contract.CodeInfo
+	}
+	if info.AbiVersion == 0 {
+		info.AbiVersion = types.DeclaredABIVersion(wasmCode)
+	}
+	k.setCodeInfo(ctx, info)
+	return nil
+}
+
+// setCodeInfo stores the metadata of the code.
+func (k Keeper) setCodeInfo(ctx sdk.Context, info types.CodeInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CodeInfoKey(info.CodeId), k.cdc.MustMarshal(&info))
+}
+
+// GetCodeInfo returns the metadata of the code.
+func (k Keeper) GetCodeInfo(ctx sdk.Context, codeID []byte) (types.CodeInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CodeInfoKey(codeID))
+	if bz == nil {
+		return types.CodeInfo{}, false
+	}
+
+	var info types.CodeInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// InitializeCodeInfos stores metadata for the codes which do not have any, i.e. the codes uploaded
+// before code metadata was recorded. Their creator, upload height and upload time are unknown.
+func (k Keeper) InitializeCodeInfos(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	k.IterateCodeIDs(ctx, func(codeID []byte) bool {
+		if _, found := k.GetCodeInfo(ctx, codeID); !found {
+			code := store.Get(types.CodeID(codeID))
+			k.setCodeInfo(ctx, types.CodeInfo{CodeId: codeID, CodeSize: uint64(len(code)), AbiVersion: types.DeclaredABIVersion(code)})
+		}
+		return false
+	})
+}
+
+// pinCode pins the code in the VM memory cache and stores its pinned status, so that the
+// code is pinned again when the node restarts.
+func (k Keeper) pinCode(ctx sdk.Context, codeID []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.CodeID(codeID)) {
+		return sdkerrors.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s does not exist", hex.EncodeToString(codeID))
+	}
+
+	if err := k.wasmVM.Pin(codeID); err != nil {
+		return sdkerrors.Wrapf(types.ErrPinCodeFailed, "code id %s: %s", hex.EncodeToString(codeID), err)
+	}
+
+	store.Set(types.PinnedCode(codeID), []byte{1})
+	return nil
+}
+
+// unpinCode removes the code from the VM memory cache and deletes its pinned status.
+func (k Keeper) unpinCode(ctx sdk.Context, codeID []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.CodeID(codeID)) {
+		return sdkerrors.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s does not exist", hex.EncodeToString(codeID))
+	}
+
+	if err := k.wasmVM.Unpin(codeID); err != nil {
+		return sdkerrors.Wrapf(types.ErrUnpinCodeFailed, "code id %s: %s", hex.EncodeToString(codeID), err)
+	}
+
+	store.Delete(types.PinnedCode(codeID))
+	return nil
+}
+
+// IsPinnedCode returns true if the code is pinned in the VM memory cache.
+func (k Keeper) IsPinnedCode(ctx sdk.Context, codeID []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PinnedCode(codeID))
+}
+
+// IteratePinnedCodes iterates over the ids of all pinned codes.
+func (k Keeper) IteratePinnedCodes(ctx sdk.Context, fn func(codeID []byte) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.PrefixPinnedCodeKey)
+
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		codeID, err := hex.DecodeString(string(iter.Key()))
+		if err != nil {
+			panic(fmt.Errorf("invalid pinned code key %s: %w", iter.Key(), err))
+		}
+
+		if fn(codeID) {
+			break
+		}
+	}
+}
+
+// InitializePinnedCodes pins all codes marked as pinned in the store in the VM memory cache.
+// It must be called when the node starts, as the VM memory cache does not persist.
+func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
+	var err error
+	k.IteratePinnedCodes(ctx, func(codeID []byte) bool {
+		if pinErr := k.wasmVM.Pin(codeID); pinErr != nil {
+			err = sdkerrors.Wrapf(types.ErrPinCodeFailed, "code id %s: %s", hex.EncodeToString(codeID), pinErr)
+			return true
+		}
+		return false
+	})
+	return err
+}
+
+// approveChecksum approves the checksum for upload by any account. Checksums of stored codes cannot
+// be approved.
+func (k Keeper) approveChecksum(ctx sdk.Context, checksum []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.CodeID(checksum)) {
+		return sdkerrors.Wrapf(types.ErrWasmCodeExists, "code id %s already exists", hex.EncodeToString(checksum))
+	}
+
+	store.Set(types.ApprovedChecksum(checksum), []byte{1})
+	return nil
+}
+
+// revokeChecksum revokes the approval of a checksum which has not been uploaded yet.
+func (k Keeper) revokeChecksum(ctx sdk.Context, checksum []byte) error {
+	if !k.IsApprovedChecksum(ctx, checksum) {
+		return sdkerrors.Wrapf(types.ErrChecksumNotApproved, "checksum %s is not approved", hex.EncodeToString(checksum))
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.ApprovedChecksum(checksum))
+	return nil
+}
+
+// IsApprovedChecksum returns true if the checksum is approved for upload by any account.
+func (k Keeper) IsApprovedChecksum(ctx sdk.Context, checksum []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ApprovedChecksum(checksum))
+}
+
+// IterateApprovedChecksums iterates over all checksums approved for upload.
+func (k Keeper) IterateApprovedChecksums(ctx sdk.Context, fn func(checksum []byte) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.PrefixApprovedChecksum)
+
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		checksum, err := hex.DecodeString(string(iter.Key()))
+		if err != nil {
+			panic(fmt.Errorf("invalid approved checksum key %s: %w", iter.Key(), err))
+		}
+
+		if fn(checksum) {
+			break
+		}
+	}
+}
+
+// setClientCode indexes that the client uses the code with the given id, replacing the code
+// previously indexed for the client. An empty code id removes the client from the index.
+func (k Keeper) setClientCode(ctx sdk.Context, clientID string, codeID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if prevCodeID := store.Get(types.ClientCode(clientID)); prevCodeID != nil {
+		if bytes.Equal(prevCodeID, codeID) {
+			return
+		}
+
+		store.Delete(types.CodeClient(prevCodeID, clientID))
+		store.Delete(types.ClientCode(clientID))
+	}
+
+	if len(codeID) == 0 {
+		return
+	}
+
+	store.Set(types.CodeClient(codeID, clientID), []byte{1})
+	store.Set(types.ClientCode(clientID), codeID)
+}
+
+// migrateContract migrates the contract of the client to the code with the given id by calling
+// the migrate entry point of the new code on the client store. The client state stored after the
+// migration is set to use the new code id. Nothing is written if the migration fails. It returns
+// the code id used by the client before the migration.
+func (k Keeper) migrateContract(ctx sdk.Context, clientID string, newCodeID, migrateMsg []byte) ([]byte, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.CodeID(newCodeID)) {
+		return nil, sdkerrors.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s does not exist", hex.EncodeToString(newCodeID))
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "cannot migrate client with ID %s", clientID)
+	}
+
+	wasmClientState, ok := clientState.(*types.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "client state type %T, expected %T", clientState, (*types.ClientState)(nil))
+	}
+
+	// the migration is written to a cached context and committed once it succeeded
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := wasmClientState.MigrateContract(k.ClientContext(cacheCtx), k.clientKeeper.ClientStore(cacheCtx, clientID), newCodeID, migrateMsg); err != nil {
+		return nil, err
+	}
+
+	// the migration may have rewritten the client state
+	clientState, found = k.clientKeeper.GetClientState(cacheCtx, clientID)
+	migratedClientState, ok := clientState.(*types.ClientState)
+	if !found || !ok {
+		return nil, sdkerrors.Wrapf(types.ErrMigrateContractFailed, "client state type %T after migration, expected %T", clientState, (*types.ClientState)(nil))
+	}
+
+	migratedClientState.CodeId = newCodeID
+	if err := migratedClientState.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid client state after migration")
+	}
+
+	k.clientKeeper.SetClientState(cacheCtx, clientID, migratedClientState)
+	writeFn()
+
+	k.setClientCode(ctx, clientID, newCodeID)
+	return wasmClientState.CodeId, nil
+}
+
+// IterateClientsByCode iterates over the identifiers of the clients using the code with the given id.
+func (k Keeper) IterateClientsByCode(ctx sdk.Context, codeID []byte, fn func(clientID string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.CodeClientsPrefix(codeID))
+
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if fn(string(iter.Key())) {
+			break
+		}
+	}
+}
+
+// IndexClientCodes indexes the codes used by all 08-wasm clients stored in the client keeper.
+func (k Keeper) IndexClientCodes(ctx sdk.Context) {
+	k.clientKeeper.IterateClientStates(ctx, nil, func(clientID string, clientState exported.ClientState) bool {
+		k.AfterClientStateChanged(ctx, clientID, clientState)
+		return false
+	})
+}
+
+// removeCode deletes the code and its pinned status from the store. The code must not be
+// used by any client. The code is removed from the VM at the end of the block, see RemoveCodesFromVM.
+func (k Keeper) removeCode(ctx sdk.Context, codeID []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.CodeID(codeID)) {
+		return sdkerrors.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s does not exist", hex.EncodeToString(codeID))
+	}
+
+	var clientID string
+	k.IterateClientsByCode(ctx, codeID, func(id string) bool {
+		clientID = id
+		return true
+	})
+	if clientID != "" {
+		return sdkerrors.Wrapf(types.ErrWasmCodeInUse, "code id %s is used by client %s", hex.EncodeToString(codeID), clientID)
 	}
 
-	store.Set(codeHash, wasmCode)
+	store.Delete(types.CodeID(codeID))
+	store.Delete(types.PinnedCode(codeID))
+	store.Delete(types.CodeInfoKey(codeID))
+	store.Set(types.RemovedCode(codeID), []byte{1})
 	return nil
 }
 
+// RemoveCodesFromVM unpins and removes the codes deleted from the store during the block from
+// the VM. It is called at the end of the block, so that codes are not removed from the VM by
+// state transitions which are reverted afterwards. As the VM cache is local to the node,
+// failures to remove a code are only logged.
+func (k Keeper) RemoveCodesFromVM(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.PrefixRemovedCode)
+
+	var codeIDs [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		codeID, err := hex.DecodeString(string(iter.Key()))
+		if err != nil {
+			panic(fmt.Errorf("invalid removed code key %s: %w", iter.Key(), err))
+		}
+		codeIDs = append(codeIDs, codeID)
+	}
+	iter.Close()
+
+	for _, codeID := range codeIDs {
+		if err := k.wasmVM.Unpin(codeID); err != nil {
+			k.Logger(ctx).Error("failed to unpin removed wasm code", "code-id", hex.EncodeToString(codeID), "error", err)
+		}
+		if err := k.wasmVM.RemoveCode(codeID); err != nil {
+			k.Logger(ctx).Error("failed to remove wasm code from the VM", "code-id", hex.EncodeToString(codeID), "error", err)
+		}
+
+		store.Delete(types.RemovedCode(codeID))
+	}
+}
+
 func generateWasmCodeHash(code []byte) []byte {
 	hash := sha256.Sum256(code)
 	return hash[:]
@@ -153,6 +566,97 @@ func (k Keeper) getWasmCode(c context.Context, query *types.WasmCodeQuery) (*typ
 	}, nil
 }
 
+func (k Keeper) getCodeInfo(c context.Context, query *types.CodeInfoQuery) (*types.CodeInfoResponse, error) {
+	if query == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	codeID, err := hex.DecodeString(query.CodeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid code id")
+	}
+
+	info, found := k.GetCodeInfo(ctx, codeID)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrWasmCodeIDNotFound, query.CodeId).Error(),
+		)
+	}
+
+	clientIDs := []string{}
+	k.IterateClientsByCode(ctx, codeID, func(clientID string) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	return &types.CodeInfoResponse{
+		CodeInfo:  info,
+		ClientIds: clientIDs,
+	}, nil
+}
+
+func (k Keeper) getClientsByCode(c context.Context, query *types.ClientsByCodeQuery) (*types.ClientsByCodeResponse, error) {
+	if query == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	codeID, err := hex.DecodeString(query.CodeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid code id")
+	}
+
+	if !store.Has(types.CodeID(codeID)) {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrWasmCodeIDNotFound, query.CodeId).Error(),
+		)
+	}
+
+	var clientIDs []string
+	prefixStore := prefix.NewStore(store, types.CodeClientsPrefix(codeID))
+	pageRes, err := sdkquery.Paginate(prefixStore, query.Pagination, func(key []byte, _ []byte) error {
+		clientIDs = append(clientIDs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClientsByCodeResponse{
+		ClientIds:  clientIDs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) getApprovedChecksums(c context.Context, query *types.ApprovedChecksumsQuery) (*types.ApprovedChecksumsResponse, error) {
+	if query == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixApprovedChecksum)
+
+	var checksums []string
+	pageRes, err := sdkquery.Paginate(prefixStore, query.Pagination, func(key []byte, _ []byte) error {
+		checksums = append(checksums, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ApprovedChecksumsResponse{
+		Checksums:  checksums,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) getAllWasmCodeID(c context.Context, query *types.AllWasmCodeIDQuery) (*types.AllWasmCodeIDResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -180,41 +684,99 @@ func (k Keeper) getAllWasmCodeID(c context.Context, query *types.AllWasmCodeIDQu
 	}, nil
 }
 
+// InitGenesis imports the codes, their pinned status and metadata, the allowed queries and the approved checksums. It must be called
+// after the genesis of the client keeper, as every 08-wasm client must use an imported code.
 func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid 08-wasm genesis state")
+	}
+
+	if err := k.SetParams(ctx, gs.GetParamsOrDefault()); err != nil {
+		return err
+	}
+
 	for _, contract := range gs.Contracts {
-		err := k.importWasmCode(ctx, contract.CodeHash, contract.ContractCode)
-		if err != nil {
+		if err := k.importWasmCode(ctx, contract); err != nil {
+			return sdkerrors.Wrapf(err, "failed to import code id %s", hex.EncodeToString(contract.CodeHash))
+		}
+
+		if contract.Pinned {
+			if err := k.pinCode(ctx, contract.CodeHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := k.setAllowedQueries(ctx, gs.AllowedQueries); err != nil {
+		return err
+	}
+
+	for _, checksum := range gs.ApprovedChecksums {
+		if err := k.approveChecksum(ctx, checksum); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	store := ctx.KVStore(k.storeKey)
+	var err error
+	k.clientKeeper.IterateClientStates(ctx, nil, func(clientID string, clientState exported.ClientState) bool {
+		if wasmClientState, ok := clientState.(*types.ClientState); ok && !store.Has(types.CodeID(wasmClientState.CodeId)) {
+			err = sdkerrors.Wrapf(types.ErrWasmCodeIDNotFound, "client %s uses code id %s which is not in the 08-wasm genesis", clientID, hex.EncodeToString(wasmClientState.CodeId))
+			return true
+		}
+
+		k.AfterClientStateChanged(ctx, clientID, clientState)
+		return false
+	})
+	return err
 }
 
+// ExportGenesis exports the codes, their pinned status and metadata, the allowed queries, the parameters and the approved checksums. The codes are
+// exported uncompressed, so that importing and exporting the genesis state round-trips. Unfinished upload sessions are temporary
+// and are not exported.
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.PrefixCodeIDKey)
-	defer iterator.Close()
 
-	var genesisState types.GenesisState
-	for ; iterator.Valid(); iterator.Next() {
-		genesisState.Contracts = append(genesisState.Contracts, types.GenesisContract{
-			CodeHash:     iterator.Key(),
-			ContractCode: iterator.Value(),
-		})
+	genesisState := types.GenesisState{
+		Contracts: []types.GenesisContract{},
 	}
+	k.IterateCodeIDs(ctx, func(codeID []byte) bool {
+		contract := types.GenesisContract{
+			CodeHash:     codeID,
+			ContractCode: store.Get(types.CodeID(codeID)),
+			Pinned:       k.IsPinnedCode(ctx, codeID),
+		}
+		if info, found := k.GetCodeInfo(ctx, codeID); found {
+			contract.CodeInfo = &info
+		}
+		genesisState.Contracts = append(genesisState.Contracts, contract)
+		return false
+	})
+	genesisState.AllowedQueries = k.GetAllowedQueries(ctx)
+	params := k.GetParams(ctx)
+	genesisState.Params = &params
+	k.IterateApprovedChecksums(ctx, func(checksum []byte) bool {
+		genesisState.ApprovedChecksums = append(genesisState.ApprovedChecksums, checksum)
+		return false
+	})
 	return genesisState
 }
 
-// TODO: testing
-func (k Keeper) IterateCodeInfos(ctx sdk.Context, fn func(codeID string) (stop bool)) {
+// IterateCodeIDs iterates over the ids of all stored codes.
+func (k Keeper) IterateCodeIDs(ctx sdk.Context, fn func(codeID []byte) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, []byte(fmt.Sprintf("%s/", types.PrefixCodeIDKey)))
+	prefixStore := prefix.NewStore(store, types.PrefixCodeIDKey)
 
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		if fn(string(iter.Value())) {
+		codeID, err := hex.DecodeString(string(iter.Key()))
+		if err != nil {
+			panic(fmt.Errorf("invalid code id key %s: %w", iter.Key(), err))
+		}
+
+		if fn(codeID) {
 			break
 		}
 	}
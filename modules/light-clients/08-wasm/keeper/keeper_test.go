@@ -0,0 +1,339 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	snapshot "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	ctx         sdk.Context
+	authority   string
+	engine      *wasmtesting.MockWasmEngine
+	keeper      keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+
+	suite.ctx = suite.chainA.GetContext()
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.engine = wasmtesting.NewMockWasmEngine(nil)
+	suite.keeper = suite.newKeeper(suite.engine)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newKeeper creates a keeper on the wasm store of the chain using the given engine.
+func (suite *KeeperTestSuite) newKeeper(engine wasmtypes.WasmEngine, opts ...keeper.Option) keeper.Keeper {
+	app := suite.chainA.App.(*simapp.SimApp)
+	opts = append([]keeper.Option{keeper.WithWasmEngine(engine)}, opts...)
+	return keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), suite.authority, "", &app.IBCKeeper.ClientKeeper, opts...)
+}
+
+// storeCode stores a light client code, which is distinguished from the other codes by the
+// given name, and returns its code id.
+func (suite *KeeperTestSuite) storeCode(name string) []byte {
+	res, err := suite.keeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(suite.authority, code(name)))
+	suite.Require().NoError(err)
+	return res.CodeId
+}
+
+// setClient stores a 08-wasm client using the code with the given id and indexes it.
+func (suite *KeeperTestSuite) setClient(clientID string, codeID []byte) {
+	clientState := wasmtypes.NewClientState([]byte("data"), codeID, clienttypes.NewHeight(1, 1))
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.ctx, clientID, clientState)
+	suite.keeper.IndexClientCodes(suite.ctx)
+}
+
+// unknownCodeID is the id of a code which is not stored.
+var unknownCodeID = sha256.Sum256([]byte("unknown"))
+
+// code returns a light client code, which is distinguished from the other codes by the given name.
+func code(name string) []byte {
+	return wasmtesting.WasmModule("instantiate", "execute", "query", name)
+}
+
+func (suite *KeeperTestSuite) TestPinCodes() {
+	codeA, codeB := suite.storeCode("a"), suite.storeCode("b")
+
+	_, err := suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.chainA.SenderAccount.GetAddress().String(), codeA))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.authority, codeA, codeB))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsPinnedCode(suite.ctx, codeA))
+	suite.Require().True(suite.keeper.IsPinnedCode(suite.ctx, codeB))
+	suite.Require().Equal(map[string]bool{string(codeA): true, string(codeB): true}, suite.engine.Pinned)
+
+	_, err = suite.keeper.UnpinCodes(suite.ctx, wasmtypes.NewMsgUnpinCodes(suite.authority, codeA))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsPinnedCode(suite.ctx, codeA))
+	suite.Require().True(suite.keeper.IsPinnedCode(suite.ctx, codeB))
+	suite.Require().Equal(map[string]bool{string(codeB): true}, suite.engine.Pinned)
+
+	// unknown codes cannot be pinned or unpinned
+	_, err = suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.authority, unknownCodeID[:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+	_, err = suite.keeper.UnpinCodes(suite.ctx, wasmtypes.NewMsgUnpinCodes(suite.authority, unknownCodeID[:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPinCodesFailure() {
+	codeA, codeB, codeC := suite.storeCode("a"), suite.storeCode("b"), suite.storeCode("c")
+	_, err := suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.authority, codeC))
+	suite.Require().NoError(err)
+
+	suite.engine.PinErr = func(checksum cosmwasm.Checksum) error {
+		if string(checksum) == string(codeB) {
+			return errors.New("pin failed")
+		}
+		return nil
+	}
+
+	// the message is reverted like the transaction delivering it, the codes it pinned in the VM
+	// before failing are unpinned while the codes pinned before are left pinned
+	ctx, _ := suite.ctx.CacheContext()
+	_, err = suite.keeper.PinCodes(ctx, wasmtypes.NewMsgPinCodes(suite.authority, codeC, codeA, codeB))
+	suite.Require().ErrorIs(err, wasmtypes.ErrPinCodeFailed)
+
+	suite.Require().False(suite.keeper.IsPinnedCode(suite.ctx, codeA))
+	suite.Require().False(suite.keeper.IsPinnedCode(suite.ctx, codeB))
+	suite.Require().True(suite.keeper.IsPinnedCode(suite.ctx, codeC))
+	suite.Require().Equal(map[string]bool{string(codeC): true}, suite.engine.Pinned)
+}
+
+func (suite *KeeperTestSuite) TestInitializePinnedCodes() {
+	codeA, codeB := suite.storeCode("a"), suite.storeCode("b")
+	_, err := suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.authority, codeA))
+	suite.Require().NoError(err)
+
+	// the VM of a restarted node only has the stored codes pinned again
+	engine := wasmtesting.NewMockWasmEngine(nil)
+	k := suite.newKeeper(engine)
+	suite.Require().NoError(k.InitializePinnedCodes(suite.ctx))
+	suite.Require().Equal(map[string]bool{string(codeA): true}, engine.Pinned)
+	suite.Require().False(engine.Pinned[string(codeB)])
+
+	engine = wasmtesting.NewMockWasmEngine(nil)
+	engine.PinErr = func(cosmwasm.Checksum) error { return errors.New("pin failed") }
+	k = suite.newKeeper(engine)
+	suite.Require().ErrorIs(k.InitializePinnedCodes(suite.ctx), wasmtypes.ErrPinCodeFailed)
+}
+
+func (suite *KeeperTestSuite) TestRemoveCode() {
+	codeA, codeB := suite.storeCode("a"), suite.storeCode("b")
+	_, err := suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.authority, codeA, codeB))
+	suite.Require().NoError(err)
+	suite.setClient("08-wasm-0", codeA)
+
+	_, err = suite.keeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(suite.chainA.SenderAccount.GetAddress().String(), codeB))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.keeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(suite.authority, codeA))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeInUse)
+	_, err = suite.keeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(suite.authority, unknownCodeID[:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+
+	// a removal reverted with its transaction does not remove the code from the VM
+	ctx, _ := suite.ctx.CacheContext()
+	_, err = suite.keeper.RemoveWasmCode(ctx, wasmtypes.NewMsgRemoveWasmCode(suite.authority, codeB))
+	suite.Require().NoError(err)
+	suite.keeper.RemoveCodesFromVM(suite.ctx)
+	suite.Require().Empty(suite.engine.Removed)
+	suite.Require().True(suite.keeper.IsPinnedCode(suite.ctx, codeB))
+
+	// the code is removed from the store at once and from the VM at the end of the block
+	_, err = suite.keeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(suite.authority, codeB))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsPinnedCode(suite.ctx, codeB))
+	_, found := suite.keeper.GetCodeInfo(suite.ctx, codeB)
+	suite.Require().False(found)
+	wasmByte, err := suite.keeper.GetWasmByte(suite.ctx, hex.EncodeToString(codeB))
+	suite.Require().NoError(err)
+	suite.Require().Nil(wasmByte)
+	suite.Require().Contains(suite.engine.Codes, string(codeB))
+
+	suite.keeper.RemoveCodesFromVM(suite.ctx)
+	suite.Require().Equal([]cosmwasm.Checksum{codeB}, suite.engine.Removed)
+	suite.Require().NotContains(suite.engine.Codes, string(codeB))
+	suite.Require().Equal(map[string]bool{string(codeA): true}, suite.engine.Pinned)
+
+	// the removed codes are only removed from the VM once
+	suite.keeper.RemoveCodesFromVM(suite.ctx)
+	suite.Require().Len(suite.engine.Removed, 1)
+}
+
+func (suite *KeeperTestSuite) TestMigrateContract() {
+	codeA, codeB := suite.storeCode("a"), suite.storeCode("b")
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	suite.setClient("08-wasm-0", codeA)
+
+	clientsByCode := func(codeID []byte) []string {
+		var clientIDs []string
+		suite.keeper.IterateClientsByCode(suite.ctx, codeID, func(clientID string) bool {
+			clientIDs = append(clientIDs, clientID)
+			return false
+		})
+		return clientIDs
+	}
+
+	testCases := []struct {
+		name     string
+		signer   string
+		clientID string
+		codeID   []byte
+		contract func(wasmtesting.ContractCall) (*wasmvmtypes.Response, error)
+		expError error
+	}{
+		{
+			"invalid signer",
+			suite.chainA.SenderAccount.GetAddress().String(),
+			"08-wasm-0",
+			codeB,
+			nil,
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"code id not found",
+			suite.authority,
+			"08-wasm-0",
+			unknownCodeID[:],
+			nil,
+			wasmtypes.ErrWasmCodeIDNotFound,
+		},
+		{
+			"client not found",
+			suite.authority,
+			"08-wasm-1",
+			codeB,
+			nil,
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"contract error",
+			suite.authority,
+			"08-wasm-0",
+			codeB,
+			func(wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+				return nil, errors.New("migrate failed")
+			},
+			wasmtypes.ErrMigrateContractFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.engine.Contract = tc.contract
+			_, err := suite.keeper.MigrateContract(suite.ctx, wasmtypes.NewMsgMigrateContract(tc.signer, tc.clientID, tc.codeID, []byte("{}")))
+			suite.Require().ErrorIs(err, tc.expError)
+
+			clientState, found := clientKeeper.GetClientState(suite.ctx, "08-wasm-0")
+			suite.Require().True(found)
+			suite.Require().Equal(codeA, clientState.(*wasmtypes.ClientState).CodeId)
+			suite.Require().Equal([]string{"08-wasm-0"}, clientsByCode(codeA))
+		})
+	}
+
+	suite.engine.Contract = nil
+	suite.engine.Calls = nil
+	_, err := suite.keeper.MigrateContract(suite.ctx, wasmtypes.NewMsgMigrateContract(suite.authority, "08-wasm-0", codeB, []byte("{}")))
+	suite.Require().NoError(err)
+
+	clientState, found := clientKeeper.GetClientState(suite.ctx, "08-wasm-0")
+	suite.Require().True(found)
+	suite.Require().Equal(codeB, clientState.(*wasmtypes.ClientState).CodeId)
+	suite.Require().Empty(clientsByCode(codeA))
+	suite.Require().Equal([]string{"08-wasm-0"}, clientsByCode(codeB))
+	suite.Require().Equal([]string{wasmtesting.EntryPointMigrate}, suite.engine.EntryPoints())
+	suite.Require().Equal(cosmwasm.Checksum(codeB), suite.engine.Calls[0].Checksum)
+	suite.Require().Equal([]byte("{}"), suite.engine.Calls[0].Msg)
+}
+
+func (suite *KeeperTestSuite) TestSnapshotRestore() {
+	app := suite.chainA.App.(*simapp.SimApp)
+	codeA, codeB := suite.storeCode("a"), suite.storeCode("b")
+	_, err := suite.keeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(suite.authority, codeA))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+	height := uint64(app.LastBlockHeight())
+
+	var payloads [][]byte
+	err = keeper.NewWasmSnapshotter(app.CommitMultiStore(), &suite.keeper).SnapshotExtension(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(payloads, 2)
+
+	// the codes are restored in the VM of a node with an empty VM, with their pinned status
+	engine := wasmtesting.NewMockWasmEngine(nil)
+	k := suite.newKeeper(engine)
+	err = keeper.NewWasmSnapshotter(app.CommitMultiStore(), &k).RestoreExtension(height, keeper.SnapshotFormat, payloadReader(payloads))
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]cosmwasm.WasmCode{string(codeA): code("a"), string(codeB): code("b")}, engine.Codes)
+	suite.Require().Equal(map[string]bool{string(codeA): true}, engine.Pinned)
+
+	// the restore fails if a code stored on chain is missing from the snapshot
+	k = suite.newKeeper(wasmtesting.NewMockWasmEngine(nil))
+	err = keeper.NewWasmSnapshotter(app.CommitMultiStore(), &k).RestoreExtension(height, keeper.SnapshotFormat, payloadReader(payloads[:1]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.Require().Equal(wasmtypes.DefaultParams(), suite.keeper.GetParams(suite.ctx))
+	suite.Require().ErrorIs(suite.keeper.SetParams(suite.ctx, wasmtypes.Params{}), wasmtypes.ErrInvalidParams)
+
+	params := wasmtypes.DefaultParams()
+	params.MaxWasmCodeSize = 1024
+	_, err := suite.keeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(suite.chainA.SenderAccount.GetAddress().String(), params))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.keeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(suite.authority, params))
+	suite.Require().NoError(err)
+
+	res, err := suite.keeper.Params(suite.ctx, &wasmtypes.ParamsQuery{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+
+	// the max wasm code size applies to new uploads
+	_, err = suite.keeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(suite.authority, make([]byte, 32), params.MaxWasmCodeSize+1))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeTooLarge)
+	_, err = suite.keeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(suite.authority, make([]byte, 32), params.MaxWasmCodeSize))
+	suite.Require().NoError(err)
+}
+
+// payloadReader returns a reader of the given snapshot items.
+func payloadReader(payloads [][]byte) snapshot.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
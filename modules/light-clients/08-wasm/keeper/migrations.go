@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from consensus version 1 to 2.
+// This migration indexes the codes used by the existing 08-wasm clients.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IndexClientCodes(ctx)
+	return nil
+}
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+// This migration stores metadata for the existing codes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.InitializeCodeInfos(ctx)
+	return nil
+}
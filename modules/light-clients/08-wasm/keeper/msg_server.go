@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -16,11 +19,12 @@ var _ types.MsgServer = Keeper{}
 func (k Keeper) PushNewWasmCode(goCtx context.Context, msg *types.MsgPushNewWasmCode) (*types.MsgPushNewWasmCodeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.authority != msg.Signer {
-		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
-	}
-
-	codeID, err := k.storeWasmCode(ctx, msg.Code)
+	// the authority may push any code, other accounts only codes with an approved checksum
+	codeID, err := k.storeWasmCode(ctx, msg.Code, types.CodeInfo{
+		Creator: msg.Signer,
+		Label:   msg.Label,
+		Version: msg.Version,
+	})
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "pushing new wasm code failed")
 	}
@@ -69,6 +73,7 @@ func (k Keeper) UpdateWasmCodeId(goCtx context.Context, msg *types.MsgUpdateWasm
 	clientState.CodeId = codeId
 
 	k.clientKeeper.SetClientState(ctx, clientId, clientState)
+	k.setClientCode(ctx, clientId, codeId)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -87,3 +92,314 @@ func (k Keeper) UpdateWasmCodeId(goCtx context.Context, msg *types.MsgUpdateWasm
 		CodeId:   codeId,
 	}, nil
 }
+
+// PinCodes defines a rpc handler method for MsgPinCodes. As pinning a code in the VM is not
+// reverted with the state, the codes pinned by the message are unpinned from the VM if it fails.
+func (k Keeper) PinCodes(goCtx context.Context, msg *types.MsgPinCodes) (*types.MsgPinCodesResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pinned [][]byte
+	events := make(sdk.Events, 0, len(msg.CodeIds)+1)
+	for _, codeId := range msg.CodeIds {
+		wasPinned := k.IsPinnedCode(ctx, codeId)
+		if err := k.pinCode(ctx, codeId); err != nil {
+			for _, pinnedCodeId := range pinned {
+				if unpinErr := k.wasmVM.Unpin(pinnedCodeId); unpinErr != nil {
+					k.Logger(ctx).Error("failed to unpin wasm code", "code-id", hex.EncodeToString(pinnedCodeId), "error", unpinErr)
+				}
+			}
+			return nil, sdkerrors.Wrap(err, "pinning wasm code failed")
+		}
+		if !wasPinned {
+			pinned = append(pinned, codeId)
+		}
+
+		events = append(events, sdk.NewEvent(
+			clienttypes.EventTypePinWasmCode,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmCodeID, hex.EncodeToString(codeId)),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+	)))
+
+	return &types.MsgPinCodesResponse{}, nil
+}
+
+// UnpinCodes defines a rpc handler method for MsgUnpinCodes
+func (k Keeper) UnpinCodes(goCtx context.Context, msg *types.MsgUnpinCodes) (*types.MsgUnpinCodesResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	events := make(sdk.Events, 0, len(msg.CodeIds)+1)
+	for _, codeId := range msg.CodeIds {
+		if err := k.unpinCode(ctx, codeId); err != nil {
+			return nil, sdkerrors.Wrap(err, "unpinning wasm code failed")
+		}
+
+		events = append(events, sdk.NewEvent(
+			clienttypes.EventTypeUnpinWasmCode,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmCodeID, hex.EncodeToString(codeId)),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+	)))
+
+	return &types.MsgUnpinCodesResponse{}, nil
+}
+
+// RemoveWasmCode defines a rpc handler method for MsgRemoveWasmCode
+func (k Keeper) RemoveWasmCode(goCtx context.Context, msg *types.MsgRemoveWasmCode) (*types.MsgRemoveWasmCodeResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.removeCode(ctx, msg.CodeId); err != nil {
+		return nil, sdkerrors.Wrap(err, "removing wasm code failed")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeRemoveWasmCode,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmCodeID, hex.EncodeToString(msg.CodeId)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgRemoveWasmCodeResponse{}, nil
+}
+
+// MigrateContract defines a rpc handler method for MsgMigrateContract
+func (k Keeper) MigrateContract(goCtx context.Context, msg *types.MsgMigrateContract) (*types.MsgMigrateContractResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	oldCodeId, err := k.migrateContract(ctx, msg.ClientId, msg.CodeId, msg.Msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "migrating wasm contract failed")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeMigrateContract,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, msg.ClientId),
+			sdk.NewAttribute(clienttypes.AttributeKeyOldWasmCodeID, hex.EncodeToString(oldCodeId)),
+			sdk.NewAttribute(clienttypes.AttributeKeyNewWasmCodeID, hex.EncodeToString(msg.CodeId)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgMigrateContractResponse{}, nil
+}
+
+// UpdateAllowedQueries defines a rpc handler method for MsgUpdateAllowedQueries
+func (k Keeper) UpdateAllowedQueries(goCtx context.Context, msg *types.MsgUpdateAllowedQueries) (*types.MsgUpdateAllowedQueriesResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.setAllowedQueries(ctx, msg.AllowedQueries); err != nil {
+		return nil, sdkerrors.Wrap(err, "updating allowed queries failed")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeUpdateAllowedQueries,
+			sdk.NewAttribute(clienttypes.AttributeKeyAllowedQueries, strings.Join(msg.AllowedQueries, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgUpdateAllowedQueriesResponse{}, nil
+}
+
+// ApproveChecksums defines a rpc handler method for MsgApproveChecksums
+func (k Keeper) ApproveChecksums(goCtx context.Context, msg *types.MsgApproveChecksums) (*types.MsgApproveChecksumsResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	events := make(sdk.Events, 0, len(msg.Checksums)+1)
+	for _, checksum := range msg.Checksums {
+		if err := k.approveChecksum(ctx, checksum); err != nil {
+			return nil, sdkerrors.Wrap(err, "approving wasm checksum failed")
+		}
+
+		events = append(events, sdk.NewEvent(
+			clienttypes.EventTypeApproveWasmChecksum,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+	)))
+
+	return &types.MsgApproveChecksumsResponse{}, nil
+}
+
+// RevokeChecksums defines a rpc handler method for MsgRevokeChecksums
+func (k Keeper) RevokeChecksums(goCtx context.Context, msg *types.MsgRevokeChecksums) (*types.MsgRevokeChecksumsResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	events := make(sdk.Events, 0, len(msg.Checksums)+1)
+	for _, checksum := range msg.Checksums {
+		if err := k.revokeChecksum(ctx, checksum); err != nil {
+			return nil, sdkerrors.Wrap(err, "revoking wasm checksum failed")
+		}
+
+		events = append(events, sdk.NewEvent(
+			clienttypes.EventTypeRevokeWasmChecksum,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+	)))
+
+	return &types.MsgRevokeChecksumsResponse{}, nil
+}
+
+// BeginUpload defines a rpc handler method for MsgBeginUpload
+func (k Keeper) BeginUpload(goCtx context.Context, msg *types.MsgBeginUpload) (*types.MsgBeginUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	uploadID, err := k.beginUpload(ctx, msg.Signer, msg.Checksum, msg.CodeSize, msg.Label, msg.Version)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "beginning wasm upload failed")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeBeginWasmUpload,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmUploadID, strconv.FormatUint(uploadID, 10)),
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, hex.EncodeToString(msg.Checksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgBeginUploadResponse{
+		UploadId: uploadID,
+	}, nil
+}
+
+// UploadChunk defines a rpc handler method for MsgUploadChunk
+func (k Keeper) UploadChunk(goCtx context.Context, msg *types.MsgUploadChunk) (*types.MsgUploadChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.uploadChunk(ctx, msg.Signer, msg.UploadId, msg.Index, msg.Chunk); err != nil {
+		return nil, sdkerrors.Wrap(err, "uploading wasm chunk failed")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeUploadWasmChunk,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmUploadID, strconv.FormatUint(msg.UploadId, 10)),
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmChunkIndex, strconv.FormatUint(uint64(msg.Index), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgUploadChunkResponse{}, nil
+}
+
+// FinalizeUpload defines a rpc handler method for MsgFinalizeUpload
+func (k Keeper) FinalizeUpload(goCtx context.Context, msg *types.MsgFinalizeUpload) (*types.MsgFinalizeUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	codeID, err := k.finalizeUpload(ctx, msg.Signer, msg.UploadId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "finalizing wasm upload failed")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypePushWasmCode,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmCodeID, hex.EncodeToString(codeID)),
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmUploadID, strconv.FormatUint(msg.UploadId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgFinalizeUploadResponse{
+		CodeId: codeID,
+	}, nil
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Signer {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(err, "updating params failed")
+	}
+
+	allowedCodeIDs := make([]string, len(msg.Params.AllowedCodeIds))
+	for i, codeID := range msg.Params.AllowedCodeIds {
+		allowedCodeIDs[i] = hex.EncodeToString(codeID)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeUpdateWasmParams,
+			sdk.NewAttribute(clienttypes.AttributeKeyMaxWasmCodeSize, strconv.FormatUint(msg.Params.MaxWasmCodeSize, 10)),
+			sdk.NewAttribute(clienttypes.AttributeKeyAllowedCodeIDs, strings.Join(allowedCodeIDs, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, clienttypes.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
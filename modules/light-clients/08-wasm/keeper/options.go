@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// Option configures the keeper on construction.
+type Option func(*Keeper)
+
+// WithVMConfig sets the settings of the VM created by the keeper.
+func WithVMConfig(vmConfig types.VMConfig) Option {
+	return func(k *Keeper) {
+		k.vmConfig = vmConfig
+	}
+}
+
+// WithWasmEngine sets the engine used by the keeper. The keeper then does not create a VM and
+// the VM settings are ignored.
+func WithWasmEngine(engine types.WasmEngine) Option {
+	return func(k *Keeper) {
+		k.wasmVM = engine
+	}
+}
+
+// WithQueryRouter sets the router answering the allowed chain queries of contracts. Without a
+// router, contracts cannot make chain queries.
+func WithQueryRouter(router types.QueryRouter) Option {
+	return func(k *Keeper) {
+		k.queryRouter = router
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// GetParams returns the parameters of the module. The default parameters are returned if none are
+// stored, which is the case for chains upgraded from a version without parameters until the
+// authority updates them.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams validates and stores the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set([]byte(types.ParamsKey), k.cdc.MustMarshal(&params))
+	return nil
+}
+
+func (k Keeper) getParams(c context.Context, query *types.ParamsQuery) (*types.ParamsResponse, error) {
+	if query == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.ParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+var _ cosmwasm.Querier = (*queryHandler)(nil)
+
+// queryHandler answers the chain queries made by contracts. Only stargate queries whose path is
+// allowed by governance are answered, with the protobuf encoded response of the gRPC query
+// service. Changes made to the state while answering a query are discarded.
+type queryHandler struct {
+	ctx         sdk.Context
+	keeper      Keeper
+	gasRegister types.WasmGasRegister
+}
+
+// newQueryHandler returns the querier passed to the contracts called with the given context.
+func (k Keeper) newQueryHandler(ctx sdk.Context) cosmwasm.Querier {
+	return queryHandler{ctx: ctx, keeper: k, gasRegister: k.GetParams(ctx).GasRegister()}
+}
+
+// Query implements cosmwasm.Querier.
+func (q queryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) ([]byte, error) {
+	if request.Stargate == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "only stargate queries are supported"}
+	}
+
+	path := request.Stargate.Path
+	if !q.keeper.IsAllowedQuery(q.ctx, path) {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("query path %s is not allowed", path)}
+	}
+
+	if q.keeper.queryRouter == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "no query router"}
+	}
+
+	route := q.keeper.queryRouter.Route(path)
+	if route == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("no route to query %s", path)}
+	}
+
+	// the query is limited to the gas given by the contract, and the gas used is charged to
+	// the context of the contract call even if the query panics
+	subCtx, _ := q.ctx.WithGasMeter(sdk.NewGasMeter(q.gasRegister.FromWasmVMGas(gasLimit))).CacheContext()
+	defer func() {
+		q.ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "08-wasm contract chain query")
+	}()
+
+	res, err := route(subCtx, abci.RequestQuery{
+		Data: request.Stargate.Data,
+		Path: path,
+	})
+	if err != nil {
+		return nil, redactError(err)
+	}
+
+	return res.Value, nil
+}
+
+// redactError returns the error of a chain query to the contract with only its codespace and
+// code, as the error messages of the queried modules are not part of consensus and may differ
+// between nodes and versions, which would make the result of the contract call nondeterministic.
+// System errors are created by this module and returned unchanged.
+func redactError(err error) error {
+	if wasmvmtypes.ToSystemError(err) != nil {
+		return err
+	}
+
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return fmt.Errorf("codespace: %s, code: %d", codespace, code)
+}
+
+// GasConsumed implements cosmwasm.Querier.
+func (q queryHandler) GasConsumed() uint64 {
+	return q.gasRegister.ToWasmVMGas(q.ctx.GasMeter().GasConsumed())
+}
+
+// setAllowedQueries replaces the chain queries which contracts are allowed to make. If the keeper
+// has a query router, every query must be routable.
+func (k Keeper) setAllowedQueries(ctx sdk.Context, paths []string) error {
+	if err := types.ValidateAllowedQueries(paths); err != nil {
+		return err
+	}
+
+	if k.queryRouter != nil {
+		for _, path := range paths {
+			if k.queryRouter.Route(path) == nil {
+				return sdkerrors.Wrapf(types.ErrInvalidAllowedQuery, "no route to query %s", path)
+			}
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, path := range k.GetAllowedQueries(ctx) {
+		store.Delete(types.AllowedQuery(path))
+	}
+
+	for _, path := range paths {
+		store.Set(types.AllowedQuery(path), []byte{1})
+	}
+
+	return nil
+}
+
+// IsAllowedQuery returns true if contracts are allowed to make the chain query.
+func (k Keeper) IsAllowedQuery(ctx sdk.Context, path string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.AllowedQuery(path))
+}
+
+// GetAllowedQueries returns the chain queries which contracts are allowed to make.
+func (k Keeper) GetAllowedQueries(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.PrefixAllowedQueryKey)
+
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var paths []string
+	for ; iter.Valid(); iter.Next() {
+		paths = append(paths, string(iter.Key()))
+	}
+
+	return paths
+}
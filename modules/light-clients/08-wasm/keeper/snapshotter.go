@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"io"
 
 	errorsmod "cosmossdk.io/errors"
@@ -13,8 +15,15 @@ import (
 
 var _ snapshot.ExtensionSnapshotter = &WasmSnapshotter{}
 
-// SnapshotFormat format 1 is just gzipped wasm byte code for each item payload. No protobuf envelope, no metadata.
-const SnapshotFormat = 1
+const (
+	// SnapshotFormatV1 is just gzipped wasm byte code for each item payload. No protobuf envelope, no metadata.
+	// Snapshots are no longer created in this format, but can still be restored.
+	SnapshotFormatV1 = 1
+
+	// SnapshotFormat format 2 is a protobuf encoded SnapshotCode for each item payload, which carries the
+	// code id, the gzipped wasm byte code and the pinned status of the code.
+	SnapshotFormat = 2
+)
 
 type WasmSnapshotter struct {
 	wasm *Keeper
@@ -38,7 +47,7 @@ func (ws *WasmSnapshotter) SnapshotFormat() uint32 {
 
 func (ws *WasmSnapshotter) SupportedFormats() []uint32 {
 	// If we support older formats, add them here and handle them in Restore
-	return []uint32{SnapshotFormat}
+	return []uint32{SnapshotFormat, SnapshotFormatV1}
 }
 
 func (ws *WasmSnapshotter) SnapshotExtension(height uint64, payloadWriter snapshot.ExtensionPayloadWriter) error {
@@ -48,29 +57,28 @@ func (ws *WasmSnapshotter) SnapshotExtension(height uint64, payloadWriter snapsh
 	}
 
 	ctx := sdk.NewContext(cacheMS, tmproto.Header{}, false, nil)
-	seenBefore := make(map[string]bool)
+	store := ctx.KVStore(ws.wasm.storeKey)
 	var rerr error
 
-	ws.wasm.IterateCodeInfos(ctx, func(codeID string) bool {
-		if seenBefore[codeID] {
-			return false
-		}
-		seenBefore[codeID] = true
-
-		// load code and abort on error
-		wasmBytes, err := ws.wasm.GetWasmByte(ctx, codeID)
+	ws.wasm.IterateCodeIDs(ctx, func(codeID []byte) bool {
+		compressedWasm, err := types.GzipIt(store.Get(types.CodeID(codeID)))
 		if err != nil {
 			rerr = err
 			return true
 		}
 
-		compressedWasm, err := types.GzipIt(wasmBytes)
+		item := types.SnapshotCode{
+			CodeId:         codeID,
+			CompressedCode: compressedWasm,
+			Pinned:         ws.wasm.IsPinnedCode(ctx, codeID),
+		}
+		payload, err := item.Marshal()
 		if err != nil {
 			rerr = err
 			return true
 		}
 
-		err = payloadWriter(compressedWasm)
+		err = payloadWriter(payload)
 		if err != nil {
 			rerr = err
 			return true
@@ -83,33 +91,105 @@ func (ws *WasmSnapshotter) SnapshotExtension(height uint64, payloadWriter snapsh
 }
 
 func (ws *WasmSnapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshot.ExtensionPayloadReader) error {
-	if format == SnapshotFormat {
-		return ws.processAllItems(height, payloadReader, restoreV1, finalizeV1)
+	switch format {
+	case SnapshotFormat:
+		return ws.processAllItems(height, payloadReader, restoreV2, finalize)
+	case SnapshotFormatV1:
+		return ws.processAllItems(height, payloadReader, restoreV1, finalize)
+	default:
+		return snapshot.ErrUnknownFormat
 	}
-	return snapshot.ErrUnknownFormat
 }
 
-func restoreV1(_ sdk.Context, k *Keeper, compressedCode []byte) error {
-	if !types.IsGzip(compressedCode) {
-		return types.ErrInvalid.Wrap("not a gzip")
-	}
-	wasmCode, err := types.Uncompress(compressedCode, uint64(types.MaxWasmSize))
+func restoreV1(ctx sdk.Context, k *Keeper, compressedCode []byte) error {
+	wasmCode, err := uncompressSnapshotCode(compressedCode, types.MaxWasmSize)
 	if err != nil {
-		return errorsmod.Wrap(errorsmod.Wrap(err, "failed to store contract"), err.Error())
+		return err
 	}
 
-	// FIXME: check which codeIDs the checksum matches??
-	_, err = k.wasmVM.StoreCode(wasmCode)
+	codeID, err := k.wasmVM.StoreCode(wasmCode)
 	if err != nil {
-		return errorsmod.Wrap(errorsmod.Wrap(err, "failed to store contract"), err.Error())
+		return errorsmod.Wrap(types.ErrCreateFailed, err.Error())
 	}
+
+	// the items do not carry the code id, so the code must match one of the codes stored on chain
+	if !ctx.KVStore(k.storeKey).Has(types.CodeID(codeID)) {
+		return errorsmod.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s does not exist", hex.EncodeToString(codeID))
+	}
+
 	return nil
 }
 
-func finalizeV1(ctx sdk.Context, k *Keeper) error {
+func restoreV2(ctx sdk.Context, k *Keeper, payload []byte) error {
+	var item types.SnapshotCode
+	if err := item.Unmarshal(payload); err != nil {
+		return errorsmod.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	wasmCode, err := uncompressSnapshotCode(item.CompressedCode, types.MaxWasmSize)
+	if err != nil {
+		return err
+	}
+
+	// the code must be the one stored on chain under the code id, with the same pinned status
+	codeIDHex := hex.EncodeToString(item.CodeId)
+	storedCode := ctx.KVStore(k.storeKey).Get(types.CodeID(item.CodeId))
+	if storedCode == nil {
+		return errorsmod.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s does not exist", codeIDHex)
+	}
+	if !bytes.Equal(generateWasmCodeHash(wasmCode), item.CodeId) || !bytes.Equal(wasmCode, storedCode) {
+		return errorsmod.Wrapf(types.ErrWasmInvalidCode, "code does not match code id %s", codeIDHex)
+	}
+	if item.Pinned != k.IsPinnedCode(ctx, item.CodeId) {
+		return errorsmod.Wrapf(types.ErrInvalid, "pinned status of code id %s does not match the chain state", codeIDHex)
+	}
+
+	codeID, err := k.wasmVM.StoreCode(wasmCode)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+	}
+
+	// safety check to assert that code id returned by the engine equals to code hash
+	if !bytes.Equal(codeID, item.CodeId) {
+		return types.ErrWasmInvalidCodeID
+	}
+
 	return nil
 }
 
+func finalize(ctx sdk.Context, k *Keeper) error {
+	// every code stored on chain must have been restored in the VM
+	var err error
+	k.IterateCodeIDs(ctx, func(codeID []byte) bool {
+		if _, getErr := k.wasmVM.GetCode(codeID); getErr != nil {
+			err = errorsmod.Wrapf(types.ErrWasmCodeIDNotFound, "code id %s was not restored: %s", hex.EncodeToString(codeID), getErr)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// the restored codes are only pinned once they are all stored in the VM
+	return k.InitializePinnedCodes(ctx)
+}
+
+// uncompressSnapshotCode uncompresses the gzipped wasm byte code of a snapshot item, which must not
+// exceed the given maximum size.
+func uncompressSnapshotCode(compressedCode []byte, maxCodeSize uint64) ([]byte, error) {
+	if !types.IsGzip(compressedCode) {
+		return nil, types.ErrInvalid.Wrap("not a gzip")
+	}
+
+	wasmCode, err := types.Uncompress(compressedCode, maxCodeSize)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+	}
+
+	return wasmCode, nil
+}
+
 func (ws *WasmSnapshotter) processAllItems(
 	height uint64,
 	payloadReader snapshot.ExtensionPayloadReader,
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// beginUpload begins an upload session of the code with the given checksum, which expires after the
+// number of blocks set by the upload expiry parameter. The creator must be allowed to push the code,
+// whose size must not exceed the maximum wasm code size.
+func (k Keeper) beginUpload(ctx sdk.Context, creator string, checksum []byte, codeSize uint64, label, version string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.CodeID(checksum)) {
+		return 0, sdkerrors.Wrapf(types.ErrWasmCodeExists, "code id %s already exists", hex.EncodeToString(checksum))
+	}
+
+	if creator != k.authority && !k.IsApprovedChecksum(ctx, checksum) {
+		return 0, sdkerrors.Wrapf(types.ErrChecksumNotApproved, "checksum %s has not been approved by the authority", hex.EncodeToString(checksum))
+	}
+
+	params := k.GetParams(ctx)
+	if err := types.ValidateUploadSize(codeSize, params.MaxWasmCodeSize); err != nil {
+		return 0, err
+	}
+
+	uploadID := k.nextUploadID(ctx)
+	upload := types.UploadSession{
+		UploadId:     uploadID,
+		Creator:      creator,
+		Checksum:     checksum,
+		CodeSize:     codeSize,
+		ExpiryHeight: ctx.BlockHeight() + params.UploadExpiryBlocks,
+		Label:        label,
+		Version:      version,
+	}
+	k.setUpload(ctx, upload)
+	store.Set(types.UploadExpiry(upload.ExpiryHeight, uploadID), []byte{1})
+
+	return uploadID, nil
+}
+
+// uploadChunk appends the chunk to the code of the upload session. Chunks must be sent in order
+// by the creator of the session and must not exceed the expected code size.
+func (k Keeper) uploadChunk(ctx sdk.Context, signer string, uploadID uint64, index uint32, chunk []byte) error {
+	upload, err := k.getUploadOf(ctx, signer, uploadID)
+	if err != nil {
+		return err
+	}
+
+	if index != upload.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidUpload, "expected chunk %d, got %d", upload.Chunks, index)
+	}
+
+	if upload.Received+uint64(len(chunk)) > upload.CodeSize {
+		return sdkerrors.Wrapf(types.ErrInvalidUpload, "chunk exceeds the code size %d", upload.CodeSize)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.UploadChunk(uploadID, index), chunk)
+	upload.Chunks++
+	upload.Received += uint64(len(chunk))
+	k.setUpload(ctx, upload)
+
+	return nil
+}
+
+// finalizeUpload stores the code of a complete upload session and deletes the session. The code
+// must have the checksum given when the session began.
+func (k Keeper) finalizeUpload(ctx sdk.Context, signer string, uploadID uint64) ([]byte, error) {
+	upload, err := k.getUploadOf(ctx, signer, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !upload.IsComplete() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidUpload, "received %d of %d bytes", upload.Received, upload.CodeSize)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	code := make([]byte, 0, upload.CodeSize)
+	for i := uint32(0); i < upload.Chunks; i++ {
+		code = append(code, store.Get(types.UploadChunk(uploadID, i))...)
+	}
+	k.deleteUpload(ctx, upload)
+
+	return k.storeWasmCode(ctx, code, types.CodeInfo{
+		CodeId:  upload.Checksum,
+		Creator: upload.Creator,
+		Label:   upload.Label,
+		Version: upload.Version,
+	})
+}
+
+// PruneExpiredUploads deletes the upload sessions expiring at or before the current block height,
+// together with their chunks.
+func (k Keeper) PruneExpiredUploads(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var expired []types.UploadSession
+	iter := store.Iterator(types.PrefixUploadExpiryKey, types.UploadExpiryPrefix(ctx.BlockHeight()+1))
+	for ; iter.Valid(); iter.Next() {
+		uploadID := sdk.BigEndianToUint64(iter.Key()[len(types.UploadExpiryPrefix(0)):])
+		if upload, found := k.GetUpload(ctx, uploadID); found {
+			expired = append(expired, upload)
+		}
+	}
+	iter.Close()
+
+	for _, upload := range expired {
+		k.deleteUpload(ctx, upload)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			clienttypes.EventTypeExpireWasmUpload,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmUploadID, strconv.FormatUint(upload.UploadId, 10)),
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, hex.EncodeToString(upload.Checksum)),
+		))
+	}
+}
+
+// GetUpload returns the upload session with the given id.
+func (k Keeper) GetUpload(ctx sdk.Context, uploadID uint64) (types.UploadSession, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.Upload(uploadID))
+	if bz == nil {
+		return types.UploadSession{}, false
+	}
+
+	var upload types.UploadSession
+	k.cdc.MustUnmarshal(bz, &upload)
+	return upload, true
+}
+
+// getUploadOf returns the upload session with the given id, which must have been begun by the signer.
+func (k Keeper) getUploadOf(ctx sdk.Context, signer string, uploadID uint64) (types.UploadSession, error) {
+	upload, found := k.GetUpload(ctx, uploadID)
+	if !found {
+		return types.UploadSession{}, sdkerrors.Wrapf(types.ErrUploadNotFound, "upload id %d", uploadID)
+	}
+
+	if upload.Creator != signer {
+		return types.UploadSession{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "upload id %d was begun by %s, got %s", uploadID, upload.Creator, signer)
+	}
+
+	return upload, nil
+}
+
+func (k Keeper) setUpload(ctx sdk.Context, upload types.UploadSession) {
+	ctx.KVStore(k.storeKey).Set(types.Upload(upload.UploadId), k.cdc.MustMarshal(&upload))
+}
+
+// deleteUpload deletes the upload session, its chunks and its expiry index.
+func (k Keeper) deleteUpload(ctx sdk.Context, upload types.UploadSession) {
+	store := ctx.KVStore(k.storeKey)
+	for i := uint32(0); i < upload.Chunks; i++ {
+		store.Delete(types.UploadChunk(upload.UploadId, i))
+	}
+	store.Delete(types.UploadExpiry(upload.ExpiryHeight, upload.UploadId))
+	store.Delete(types.Upload(upload.UploadId))
+}
+
+// nextUploadID returns the identifier of the next upload session and increments it. Identifiers
+// start at 1.
+func (k Keeper) nextUploadID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	uploadID := uint64(1)
+	if bz := store.Get([]byte(types.NextUploadIDKey)); bz != nil {
+		uploadID = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set([]byte(types.NextUploadIDKey), sdk.Uint64ToBigEndian(uploadID+1))
+	return uploadID
+}
+
+func (k Keeper) getUpload(c context.Context, query *types.UploadQuery) (*types.UploadResponse, error) {
+	if query == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	upload, found := k.GetUpload(ctx, query.UploadId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrUploadNotFound, "upload id %d", query.UploadId).Error(),
+		)
+	}
+
+	return &types.UploadResponse{
+		Upload: upload,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+const uploadExpiry = 10
+
+// newUploadKeeper returns a keeper using the engine whose upload sessions expire after
+// uploadExpiry blocks.
+func (suite *KeeperTestSuite) newUploadKeeper(engine wasmtypes.WasmEngine) keeper.Keeper {
+	k := suite.newKeeper(engine)
+	params := wasmtypes.DefaultParams()
+	params.UploadExpiryBlocks = uploadExpiry
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	return k
+}
+
+// beginUpload begins an upload session of the code and returns its id.
+func (suite *KeeperTestSuite) beginUpload(k keeper.Keeper, ctx sdk.Context, code []byte) uint64 {
+	checksum := sha256.Sum256(code)
+	msg := wasmtypes.NewMsgBeginUpload(suite.authority, checksum[:], uint64(len(code)))
+	msg.Label = "light client"
+
+	res, err := k.BeginUpload(ctx, msg)
+	suite.Require().NoError(err)
+	return res.UploadId
+}
+
+func (suite *KeeperTestSuite) TestUpload() {
+	engine := wasmtesting.NewMockWasmEngine(nil)
+	k := suite.newUploadKeeper(engine)
+	code := code("a")
+	half := len(code) / 2
+
+	uploadID := suite.beginUpload(k, suite.ctx, code)
+	upload, found := k.GetUpload(suite.ctx, uploadID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockHeight()+uploadExpiry, upload.ExpiryHeight)
+
+	// the code cannot be finalized before all chunks are received
+	_, err := k.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(suite.authority, uploadID, 0, code[:half]))
+	suite.Require().NoError(err)
+	_, err = k.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(suite.authority, uploadID))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidUpload)
+	_, err = k.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(suite.authority, uploadID, 0, code[half:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidUpload)
+
+	_, err = k.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(suite.authority, uploadID, 1, code[half:]))
+	suite.Require().NoError(err)
+	res, err := k.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(suite.authority, uploadID))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(code, []byte(engine.Codes[string(res.CodeId)]))
+	info, found := k.GetCodeInfo(suite.ctx, res.CodeId)
+	suite.Require().True(found)
+	suite.Require().Equal("light client", info.Label)
+	_, found = k.GetUpload(suite.ctx, uploadID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUploadExpiresBetweenChunks() {
+	k := suite.newUploadKeeper(wasmtesting.NewMockWasmEngine(nil))
+	code := code("a")
+	half := len(code) / 2
+	checksum := sha256.Sum256(code)
+
+	uploadID := suite.beginUpload(k, suite.ctx, code)
+	_, err := k.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(suite.authority, uploadID, 0, code[:half]))
+	suite.Require().NoError(err)
+
+	// a session begun later expires later
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	laterUploadID := suite.beginUpload(k, ctx, code)
+
+	// the session is pruned at the end of the block at its expiry height, between two chunks
+	ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + uploadExpiry - 1).WithEventManager(sdk.NewEventManager())
+	k.PruneExpiredUploads(ctx)
+	suite.Require().Empty(ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.PruneExpiredUploads(ctx)
+	suite.Require().Equal(sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypeExpireWasmUpload,
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmUploadID, strconv.FormatUint(uploadID, 10)),
+			sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, hex.EncodeToString(checksum[:])),
+		),
+	}, ctx.EventManager().Events())
+	_, found := k.GetUpload(ctx, uploadID)
+	suite.Require().False(found)
+	store := ctx.KVStore(suite.chainA.App.(*simapp.SimApp).GetKey(wasmtypes.StoreKey))
+	suite.Require().False(store.Has(wasmtypes.UploadChunk(uploadID, 0)))
+
+	// the next chunk and the finalization fail, while the later session is left
+	_, err = k.UploadChunk(ctx, wasmtypes.NewMsgUploadChunk(suite.authority, uploadID, 1, code[half:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrUploadNotFound)
+	_, err = k.FinalizeUpload(ctx, wasmtypes.NewMsgFinalizeUpload(suite.authority, uploadID))
+	suite.Require().ErrorIs(err, wasmtypes.ErrUploadNotFound)
+	_, found = k.GetUpload(ctx, laterUploadID)
+	suite.Require().True(found)
+
+	// the code can be uploaded again in a new session
+	uploadID = suite.beginUpload(k, ctx, code)
+	_, err = k.UploadChunk(ctx, wasmtypes.NewMsgUploadChunk(suite.authority, uploadID, 0, code))
+	suite.Require().NoError(err)
+	res, err := k.FinalizeUpload(ctx, wasmtypes.NewMsgFinalizeUpload(suite.authority, uploadID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(checksum[:], res.CodeId)
+}
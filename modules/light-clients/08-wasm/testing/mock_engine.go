@@ -0,0 +1,211 @@
+package wasmtesting
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// The entry points of a contract, as recorded in ContractCall.EntryPoint.
+const (
+	EntryPointInstantiate = "instantiate"
+	EntryPointExecute     = "execute"
+	EntryPointSudo        = "sudo"
+	EntryPointQuery       = "query"
+	EntryPointMigrate     = "migrate"
+)
+
+var _ wasmtypes.WasmEngine = (*MockWasmEngine)(nil)
+
+// ContractCall is a call of a contract entry point by the MockWasmEngine.
+type ContractCall struct {
+	EntryPoint string
+	Checksum   cosmwasm.Checksum
+	Env        wasmvmtypes.Env
+	Msg        []byte
+	Store      cosmwasm.KVStore
+	GoAPI      cosmwasm.GoAPI
+	Querier    cosmwasm.Querier
+	GasLimit   uint64
+}
+
+// MockWasmEngine is a wasm engine for tests. The codes are kept in memory under their sha256
+// checksum and are not compiled. The calls of the contract entry points are handled by Contract.
+type MockWasmEngine struct {
+	// Contract handles the calls of the contract entry points. The query entry point returns the
+	// data of the response. The entry points return an empty response if it is nil.
+	Contract func(call ContractCall) (*wasmvmtypes.Response, error)
+	// RequiredCapabilities are the capabilities reported by the analysis of every code.
+	RequiredCapabilities string
+	// PinErr is returned when pinning the code with the given checksum, if it is set.
+	PinErr func(checksum cosmwasm.Checksum) error
+
+	// Codes are the stored codes, by checksum.
+	Codes map[string]cosmwasm.WasmCode
+	// Pinned are the checksums of the pinned codes.
+	Pinned map[string]bool
+	// Removed are the checksums of the removed codes, in order.
+	Removed []cosmwasm.Checksum
+	// Calls are the calls of the contract entry points, in order.
+	Calls []ContractCall
+}
+
+// NewMockWasmEngine creates a new MockWasmEngine whose contract entry points are handled by the
+// given function, which may be nil.
+func NewMockWasmEngine(contract func(call ContractCall) (*wasmvmtypes.Response, error)) *MockWasmEngine {
+	return &MockWasmEngine{
+		Contract: contract,
+		Codes:    make(map[string]cosmwasm.WasmCode),
+		Pinned:   make(map[string]bool),
+	}
+}
+
+// DataResponse returns a contract handler answering every call with the given data.
+func DataResponse(data string) func(ContractCall) (*wasmvmtypes.Response, error) {
+	return func(ContractCall) (*wasmvmtypes.Response, error) {
+		return &wasmvmtypes.Response{Data: []byte(data)}, nil
+	}
+}
+
+// EntryPoints returns the entry points of the recorded calls, in order.
+func (m *MockWasmEngine) EntryPoints() []string {
+	entryPoints := make([]string, len(m.Calls))
+	for i, call := range m.Calls {
+		entryPoints[i] = call.EntryPoint
+	}
+	return entryPoints
+}
+
+func (m *MockWasmEngine) StoreCode(code cosmwasm.WasmCode) (cosmwasm.Checksum, error) {
+	checksum := sha256.Sum256(code)
+	m.Codes[string(checksum[:])] = code
+	return checksum[:], nil
+}
+
+func (m *MockWasmEngine) GetCode(checksum cosmwasm.Checksum) (cosmwasm.WasmCode, error) {
+	code, ok := m.Codes[string(checksum)]
+	if !ok {
+		return nil, fmt.Errorf("code %X not found", checksum)
+	}
+	return code, nil
+}
+
+func (m *MockWasmEngine) AnalyzeCode(_ cosmwasm.Checksum) (*wasmvmtypes.AnalysisReport, error) {
+	return &wasmvmtypes.AnalysisReport{RequiredCapabilities: m.RequiredCapabilities}, nil
+}
+
+func (m *MockWasmEngine) RemoveCode(checksum cosmwasm.Checksum) error {
+	delete(m.Codes, string(checksum))
+	delete(m.Pinned, string(checksum))
+	m.Removed = append(m.Removed, checksum)
+	return nil
+}
+
+func (m *MockWasmEngine) Pin(checksum cosmwasm.Checksum) error {
+	if m.PinErr != nil {
+		if err := m.PinErr(checksum); err != nil {
+			return err
+		}
+	}
+	m.Pinned[string(checksum)] = true
+	return nil
+}
+
+func (m *MockWasmEngine) Unpin(checksum cosmwasm.Checksum) error {
+	delete(m.Pinned, string(checksum))
+	return nil
+}
+
+func (m *MockWasmEngine) Instantiate(
+	checksum cosmwasm.Checksum,
+	env wasmvmtypes.Env,
+	_ wasmvmtypes.MessageInfo,
+	initMsg []byte,
+	store cosmwasm.KVStore,
+	goapi cosmwasm.GoAPI,
+	querier cosmwasm.Querier,
+	_ cosmwasm.GasMeter,
+	gasLimit uint64,
+	_ wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	return m.call(ContractCall{EntryPointInstantiate, checksum, env, initMsg, store, goapi, querier, gasLimit})
+}
+
+func (m *MockWasmEngine) Execute(
+	checksum cosmwasm.Checksum,
+	env wasmvmtypes.Env,
+	_ wasmvmtypes.MessageInfo,
+	executeMsg []byte,
+	store cosmwasm.KVStore,
+	goapi cosmwasm.GoAPI,
+	querier cosmwasm.Querier,
+	_ cosmwasm.GasMeter,
+	gasLimit uint64,
+	_ wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	return m.call(ContractCall{EntryPointExecute, checksum, env, executeMsg, store, goapi, querier, gasLimit})
+}
+
+func (m *MockWasmEngine) Sudo(
+	checksum cosmwasm.Checksum,
+	env wasmvmtypes.Env,
+	sudoMsg []byte,
+	store cosmwasm.KVStore,
+	goapi cosmwasm.GoAPI,
+	querier cosmwasm.Querier,
+	_ cosmwasm.GasMeter,
+	gasLimit uint64,
+	_ wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	return m.call(ContractCall{EntryPointSudo, checksum, env, sudoMsg, store, goapi, querier, gasLimit})
+}
+
+func (m *MockWasmEngine) Query(
+	checksum cosmwasm.Checksum,
+	env wasmvmtypes.Env,
+	queryMsg []byte,
+	store cosmwasm.KVStore,
+	goapi cosmwasm.GoAPI,
+	querier cosmwasm.Querier,
+	_ cosmwasm.GasMeter,
+	gasLimit uint64,
+	_ wasmvmtypes.UFraction,
+) ([]byte, uint64, error) {
+	response, gasUsed, err := m.call(ContractCall{EntryPointQuery, checksum, env, queryMsg, store, goapi, querier, gasLimit})
+	if err != nil {
+		return nil, gasUsed, err
+	}
+	return response.Data, gasUsed, nil
+}
+
+func (m *MockWasmEngine) Migrate(
+	checksum cosmwasm.Checksum,
+	env wasmvmtypes.Env,
+	migrateMsg []byte,
+	store cosmwasm.KVStore,
+	goapi cosmwasm.GoAPI,
+	querier cosmwasm.Querier,
+	_ cosmwasm.GasMeter,
+	gasLimit uint64,
+	_ wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	return m.call(ContractCall{EntryPointMigrate, checksum, env, migrateMsg, store, goapi, querier, gasLimit})
+}
+
+// call records the call and passes it to the contract handler.
+func (m *MockWasmEngine) call(call ContractCall) (*wasmvmtypes.Response, uint64, error) {
+	m.Calls = append(m.Calls, call)
+	if m.Contract == nil {
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+
+	response, err := m.Contract(call)
+	if err != nil {
+		return nil, 0, err
+	}
+	return response, 0, nil
+}
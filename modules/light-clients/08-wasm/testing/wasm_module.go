@@ -0,0 +1,18 @@
+package wasmtesting
+
+// WasmModule returns a wasm module with a custom section and an export section exporting
+// functions with the given names. The module is not valid, it is only meant to be read by
+// ValidateWasmCode and stored by the MockWasmEngine.
+func WasmModule(exports ...string) []byte {
+	section := []byte{byte(len(exports))}
+	for i, name := range exports {
+		section = append(section, byte(len(name)))
+		section = append(section, name...)
+		section = append(section, 0x00, byte(i))
+	}
+
+	module := []byte("\x00asm\x01\x00\x00\x00")
+	module = append(module, 0x00, 0x05, 0x04, 'n', 'a', 'm', 'e')
+	module = append(module, 0x07, byte(len(section)))
+	return append(module, section...)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// the gas costs of the address conversions, taken from wasmd
+	costHumanize  = 5 * DefaultGasMultiplier
+	costCanonical = 4 * DefaultGasMultiplier
+)
+
+// cosmwasmAPI is the GoAPI passed to contracts. It converts between the bech32 account addresses
+// of the chain and their canonical byte representation.
+var cosmwasmAPI = cosmwasm.GoAPI{
+	HumanAddress:     humanAddress,
+	CanonicalAddress: canonicalAddress,
+}
+
+func humanAddress(canon []byte) (string, uint64, error) {
+	if err := sdk.VerifyAddressFormat(canon); err != nil {
+		return "", costHumanize, err
+	}
+
+	return sdk.AccAddress(canon).String(), costHumanize, nil
+}
+
+func canonicalAddress(human string) ([]byte, uint64, error) {
+	bz, err := sdk.AccAddressFromBech32(human)
+	return bz, costCanonical, err
+}
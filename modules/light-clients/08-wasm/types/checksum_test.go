@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *WasmTestSuite) TestApprovedChecksums() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	uploader := suite.chainA.SenderAccount.GetAddress().String()
+
+	data, err := os.ReadFile("test_data/migrate_cw.wasm.gz")
+	suite.Require().NoError(err)
+	code, err := wasmtypes.Uncompress(data, wasmtypes.DefaultMaxWasmCodeSize)
+	suite.Require().NoError(err)
+	checksum := sha256.Sum256(code)
+	otherChecksum := sha256.Sum256([]byte("other"))
+
+	// codes without an approved checksum can only be pushed by the authority
+	_, err = suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(uploader, data))
+	suite.Require().ErrorIs(err, wasmtypes.ErrChecksumNotApproved)
+
+	_, err = suite.wasmKeeper.ApproveChecksums(suite.ctx, wasmtypes.NewMsgApproveChecksums(signer, checksum[:], otherChecksum[:]))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.wasmKeeper.IsApprovedChecksum(suite.ctx, checksum[:]))
+	suite.Require().ElementsMatch([][]byte{checksum[:], otherChecksum[:]}, suite.wasmKeeper.ExportGenesis(suite.ctx).ApprovedChecksums)
+
+	res, err := suite.wasmKeeper.ApprovedChecksums(suite.ctx, &wasmtypes.ApprovedChecksumsQuery{
+		Pagination: &sdkquery.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]string{hex.EncodeToString(checksum[:]), hex.EncodeToString(otherChecksum[:])}, res.Checksums)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// any account may push a code with an approved checksum, which consumes the approval
+	msg := wasmtypes.NewMsgPushNewWasmCode(uploader, data)
+	msg.Label = "migrate"
+	response, err := suite.wasmKeeper.PushNewWasmCode(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(checksum[:], response.CodeId)
+	suite.Require().False(suite.wasmKeeper.IsApprovedChecksum(suite.ctx, checksum[:]))
+
+	info, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, response.CodeId)
+	suite.Require().True(found)
+	suite.Require().Equal(uploader, info.Creator)
+	suite.Require().Equal("migrate", info.Label)
+
+	// the approval can be revoked before the code is pushed
+	_, err = suite.wasmKeeper.RevokeChecksums(suite.ctx, wasmtypes.NewMsgRevokeChecksums(signer, otherChecksum[:]))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.wasmKeeper.IsApprovedChecksum(suite.ctx, otherChecksum[:]))
+	suite.Require().Empty(suite.wasmKeeper.ExportGenesis(suite.ctx).ApprovedChecksums)
+
+	// a removed code must be approved again before an account other than the authority can push it
+	_, err = suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(signer, response.CodeId))
+	suite.Require().NoError(err)
+	_, err = suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(uploader, data))
+	suite.Require().ErrorIs(err, wasmtypes.ErrChecksumNotApproved)
+
+	// the authority consumes the approval of the code it pushes
+	_, err = suite.wasmKeeper.ApproveChecksums(suite.ctx, wasmtypes.NewMsgApproveChecksums(signer, checksum[:]))
+	suite.Require().NoError(err)
+	_, err = suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, data))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.wasmKeeper.IsApprovedChecksum(suite.ctx, checksum[:]))
+}
+
+func (suite *WasmTestSuite) TestApprovedChecksumsWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	uploader := suite.chainA.SenderAccount.GetAddress().String()
+	checksum := sha256.Sum256([]byte("code"))
+
+	// test invalid signer
+	_, err := suite.wasmKeeper.ApproveChecksums(suite.ctx, wasmtypes.NewMsgApproveChecksums(uploader, checksum[:]))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.wasmKeeper.RevokeChecksums(suite.ctx, wasmtypes.NewMsgRevokeChecksums(uploader, checksum[:]))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// test checksum of a stored code
+	_, err = suite.wasmKeeper.ApproveChecksums(suite.ctx, wasmtypes.NewMsgApproveChecksums(signer, suite.codeID, checksum[:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeExists)
+
+	// test checksum which is not approved
+	_, err = suite.wasmKeeper.RevokeChecksums(suite.ctx, wasmtypes.NewMsgRevokeChecksums(signer, checksum[:]))
+	suite.Require().ErrorIs(err, wasmtypes.ErrChecksumNotApproved)
+
+	// test invalid query request
+	_, err = suite.wasmKeeper.ApprovedChecksums(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	// test invalid messages
+	suite.Require().NoError(wasmtypes.NewMsgApproveChecksums(signer, checksum[:]).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgApproveChecksums(signer).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgApproveChecksums(signer, []byte{1}).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgApproveChecksums(signer, checksum[:], checksum[:]).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgRevokeChecksums(signer, checksum[:]).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgRevokeChecksums(signer).ValidateBasic())
+}
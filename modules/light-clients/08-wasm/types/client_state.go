@@ -1,10 +1,12 @@
 package types
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -48,13 +50,6 @@ func (cs ClientState) Validate() error {
 	return nil
 }
 
-type (
-	statusPayloadInner struct{}
-	statusPayload      struct {
-		Status statusPayloadInner `json:"status"`
-	}
-)
-
 // Status returns the status of the wasm client.
 // The client may be:
 // - Active: frozen height is zero and client is not expired
@@ -66,9 +61,7 @@ type (
 // has higher precedence.
 func (cs ClientState) Status(ctx sdk.Context, clientStore sdk.KVStore, _ codec.BinaryCodec) exported.Status {
 	status := exported.Unknown
-	payload := statusPayload{Status: statusPayloadInner{}}
-
-	encodedData, err := json.Marshal(payload)
+	encodedData, err := json.Marshal(QueryMsg{Status: &StatusMsg{}})
 	if err != nil {
 		return status
 	}
@@ -105,38 +98,40 @@ func (c ClientState) GetTimestampAtHeight(
 	return consState.GetTimestamp(), nil
 }
 
-// Initialize checks that the initial consensus state is an 08-wasm consensus state and
-// sets the client state, consensus state in the provided client store.
+// Initialize checks that the initial consensus state is an 08-wasm consensus state and that the
+// parameters allow new clients to use the code of the client state, and sets the client state,
+// consensus state in the provided client store.
 func (cs ClientState) Initialize(context sdk.Context, marshaler codec.BinaryCodec, clientStore sdk.KVStore, state exported.ConsensusState) error {
 	consensusState, ok := state.(*ConsensusState)
 	if !ok {
 		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
 			&ConsensusState{}, state)
 	}
+	if !paramsFromContext(context).IsAllowedCodeID(cs.CodeId) {
+		return sdkerrors.Wrapf(ErrWasmCodeNotAllowed, "code id %s", hex.EncodeToString(cs.CodeId))
+	}
 	setClientState(clientStore, marshaler, &cs)
 	setConsensusState(clientStore, marshaler, consensusState, cs.GetLatestHeight())
 
-	_, err := initContract(cs.CodeId, context, clientStore)
+	var response *wasmvmtypes.Response
+	if err := withValidatedWrites(clientStore, func(store trackingStore) (err error) {
+		response, err = initContract(cs.CodeId, context, store)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrUnableToInit, "err: %s", err)
+		}
+		return nil
+	}, cs.CodeId); err != nil {
+		return err
+	}
+
+	version, err := contractABIVersion(context, cs.CodeId, response.Data)
 	if err != nil {
-		return sdkerrors.Wrapf(ErrUnableToInit, "err: %s", err)
+		return err
 	}
+	setContractABIVersion(clientStore, version)
 	return nil
 }
 
-type (
-	verifyMembershipPayloadInner struct {
-		Height           exported.Height `json:"height"`
-		DelayTimePeriod  uint64          `json:"delay_time_period"`
-		DelayBlockPeriod uint64          `json:"delay_block_period"`
-		Proof            []byte          `json:"proof"`
-		Path             exported.Path   `json:"path"`
-		Value            []byte          `json:"value"`
-	}
-	verifyMembershipPayload struct {
-		VerifyMembershipPayloadInner verifyMembershipPayloadInner `json:"verify_membership"`
-	}
-)
-
 // VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 // If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
@@ -158,7 +153,7 @@ func (cs ClientState) VerifyMembership(
 		)
 	}
 
-	_, ok := path.(commitmenttypes.MerklePath)
+	merklePath, ok := path.(commitmenttypes.MerklePath)
 	if !ok {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
 	}
@@ -168,33 +163,20 @@ func (cs ClientState) VerifyMembership(
 		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
 	}
 
-	payload := verifyMembershipPayload{
-		VerifyMembershipPayloadInner: verifyMembershipPayloadInner{
-			Height:           height,
+	msg := QueryMsg{
+		VerifyMembership: &VerifyMembershipMsg{
+			Height:           NewContractHeight(height),
 			DelayTimePeriod:  delayTimePeriod,
 			DelayBlockPeriod: delayBlockPeriod,
 			Proof:            proof,
-			Path:             path,
+			Path:             NewContractMerklePath(merklePath),
 			Value:            value,
 		},
 	}
-	_, err = call[contractResult](payload, &cs, ctx, clientStore)
+	_, err = callQuery[contractResult](msg, &cs, ctx, clientStore)
 	return err
 }
 
-type (
-	verifyNonMembershipPayloadInner struct {
-		Height           exported.Height `json:"height"`
-		DelayTimePeriod  uint64          `json:"delay_time_period"`
-		DelayBlockPeriod uint64          `json:"delay_block_period"`
-		Proof            []byte          `json:"proof"`
-		Path             exported.Path   `json:"path"`
-	}
-	verifyNonMembershipPayload struct {
-		VerifyNonMembershipPayloadInner verifyNonMembershipPayloadInner `json:"verify_non_membership"`
-	}
-)
-
 func (cs ClientState) VerifyNonMembership(
 	ctx sdk.Context,
 	clientStore sdk.KVStore,
@@ -212,7 +194,7 @@ func (cs ClientState) VerifyNonMembership(
 		)
 	}
 
-	_, ok := path.(commitmenttypes.MerklePath)
+	merklePath, ok := path.(commitmenttypes.MerklePath)
 	if !ok {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
 	}
@@ -222,31 +204,93 @@ func (cs ClientState) VerifyNonMembership(
 		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
 	}
 
-	payload := verifyNonMembershipPayload{
-		VerifyNonMembershipPayloadInner: verifyNonMembershipPayloadInner{
-			Height:           height,
+	msg := QueryMsg{
+		VerifyNonMembership: &VerifyNonMembershipMsg{
+			Height:           NewContractHeight(height),
 			DelayTimePeriod:  delayTimePeriod,
 			DelayBlockPeriod: delayBlockPeriod,
 			Proof:            proof,
-			Path:             path,
+			Path:             NewContractMerklePath(merklePath),
 		},
 	}
-	_, err = call[contractResult](payload, &cs, ctx, clientStore)
+	_, err = callQuery[contractResult](msg, &cs, ctx, clientStore)
 	return err
 }
 
-// / Calls the contract with the given payload and writes the result to `output`
-func call[T ContractResult](payload any, cs *ClientState, ctx sdk.Context, clientStore sdk.KVStore) (T, error) {
+// / Calls the sudo entry point of the contract with the given message and writes the result to `output`.
+// / The changes of the contract are reverted if it left an invalid client or consensus state
+func call[T ContractResult](msg SudoMsg, cs *ClientState, ctx sdk.Context, clientStore sdk.KVStore) (T, error) {
+	var output T
+	err := withValidatedWrites(clientStore, func(store trackingStore) (err error) {
+		output, err = callWithABIVersion[T](msg, cs, ctx, store, getContractABIVersion(store))
+		return err
+	}, cs.CodeId)
+	return output, err
+}
+
+// callWithABIVersion calls the sudo entry point of a contract implementing the given ABI version, or its execute
+// entry point for version 1. The events of a successful call are emitted to the event manager of the context
+func callWithABIVersion[T ContractResult](msg SudoMsg, cs *ClientState, ctx sdk.Context, clientStore sdk.KVStore, version uint64) (T, error) {
 	var output T
-	encodedData, err := json.Marshal(payload)
+	encodedData, err := json.Marshal(msg)
 	if err != nil {
 		return output, sdkerrors.Wrapf(ErrUnableToMarshalPayload, "err: %s", err)
 	}
-	out, err := callContract(cs.CodeId, ctx, clientStore, encodedData)
+
+	var out *wasmvmtypes.Response
+	if version == 1 {
+		out, err = callContract(cs.CodeId, ctx, NewStoreAdapter(clientStore), encodedData)
+	} else {
+		out, err = sudoContract(cs.CodeId, ctx, clientStore, encodedData)
+	}
 	if err != nil {
 		return output, sdkerrors.Wrapf(ErrUnableToCall, "err: %s", err)
 	}
-	if err := json.Unmarshal(out.Data, &output); err != nil {
+
+	output, err = decodeContractResult[T](cs, out.Data)
+	if err != nil {
+		return output, err
+	}
+
+	events, err := contractEvents(ctx, cs.CodeId, out)
+	if err != nil {
+		return output, err
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return output, nil
+}
+
+// callQuery calls the query entry point of the contract with the given message and returns its result. The contract
+// cannot write to the client store, including contracts implementing version 1 of the ABI which are queried through
+// their execute entry point
+func callQuery[T ContractResult](msg QueryMsg, cs *ClientState, ctx sdk.Context, clientStore sdk.KVStore) (T, error) {
+	var output T
+	encodedData, err := json.Marshal(msg)
+	if err != nil {
+		return output, sdkerrors.Wrapf(ErrUnableToMarshalPayload, "err: %s", err)
+	}
+
+	var data []byte
+	if getContractABIVersion(clientStore) == 1 {
+		var out *wasmvmtypes.Response
+		out, err = callContract(cs.CodeId, ctx, NewReadOnlyStoreAdapter(clientStore), encodedData)
+		if out != nil {
+			data = out.Data
+		}
+	} else {
+		data, err = queryContractWithStore(cs.CodeId, ctx, clientStore, encodedData)
+	}
+	if err != nil {
+		return output, sdkerrors.Wrapf(ErrUnableToQuery, "err: %s", err)
+	}
+	return decodeContractResult[T](cs, data)
+}
+
+// decodeContractResult unmarshals the result returned by the contract, which must be valid
+func decodeContractResult[T ContractResult](cs *ClientState, data []byte) (T, error) {
+	var output T
+	if err := json.Unmarshal(data, &output); err != nil {
 		return output, sdkerrors.Wrapf(ErrUnableToUnmarshalPayload, "err: %s", err)
 	}
 	if !output.Validate() {
@@ -79,7 +79,7 @@ func (suite *WasmTestSuite) TestStatusTendermint() {
 
 			tc.malleate()
 
-			status := clientState.Status(suite.chainA.GetContext(), clientStore, suite.chainA.App.AppCodec())
+			status := clientState.Status(suite.clientContext(), clientStore, suite.chainA.App.AppCodec())
 			suite.Require().Equal(tc.expStatus, status)
 		})
 	}
@@ -266,7 +266,7 @@ func (suite *WasmTestSuite) TestInitializeTendermint() {
 		wasmClientState := wasmtypes.NewClientState(wasmData, suite.codeID, tmClientState.LatestHeight)
 
 		store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
-		err = wasmClientState.Initialize(suite.chainA.GetContext(), suite.chainA.Codec, store, tc.consensusState)
+		err = wasmClientState.Initialize(suite.clientContext(), suite.chainA.Codec, store, tc.consensusState)
 
 		if tc.expPass {
 			suite.Require().NoError(err, "valid case returned an error")
@@ -765,7 +765,7 @@ func (suite *WasmTestSuite) TestVerifyMembershipTendermint() {
 
 			clientState = testingpath.EndpointA.GetClientState().(*wasmtypes.ClientState)
 
-			ctx := suite.chainA.GetContext()
+			ctx := suite.clientContext()
 			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, testingpath.EndpointA.ClientID)
 
 			err = clientState.VerifyMembership(
@@ -1157,7 +1157,7 @@ func (suite *WasmTestSuite) TestVerifyNonMembershipTendermint() {
 
 			clientState := testingpath.EndpointA.GetClientState().(*wasmtypes.ClientState)
 
-			ctx := suite.chainA.GetContext()
+			ctx := suite.clientContext()
 			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, testingpath.EndpointA.ClientID)
 
 			err = clientState.VerifyNonMembership(
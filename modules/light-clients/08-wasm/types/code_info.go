@@ -0,0 +1,85 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxLabelLength is the maximum length of the label of a code
+	MaxLabelLength = 128
+
+	// MaxVersionLength is the maximum length of the version of a code
+	MaxVersionLength = 64
+)
+
+// NewCodeInfo creates a new CodeInfo instance
+func NewCodeInfo(codeID []byte, creator string, uploadHeight int64, uploadTime time.Time, codeSize uint64, label, version string, abiVersion uint64) CodeInfo {
+	return CodeInfo{
+		CodeId:       codeID,
+		Creator:      creator,
+		UploadHeight: uploadHeight,
+		UploadTime:   uploadTime,
+		CodeSize:     codeSize,
+		Label:        label,
+		Version:      version,
+		AbiVersion:   abiVersion,
+	}
+}
+
+// Validate performs basic validation of the code metadata. The creator may be empty, and the ABI
+// version zero, for codes uploaded before code metadata was recorded.
+func (c CodeInfo) Validate() error {
+	if err := validateCodeIds([][]byte{c.CodeId}); err != nil {
+		return err
+	}
+
+	if c.UploadHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidCodeInfo, "negative upload height %d", c.UploadHeight)
+	}
+
+	if c.AbiVersion > ContractABIVersion {
+		return sdkerrors.Wrapf(ErrUnsupportedABIVersion, "code declares ABI version %d, expected at most %d", c.AbiVersion, ContractABIVersion)
+	}
+
+	return validateLabelAndVersion(c.Label, c.Version)
+}
+
+// CodeInfoGetter returns the metadata of the code with the given code id, and false if the code
+// has none.
+type CodeInfoGetter func(ctx sdk.Context, codeID []byte) (CodeInfo, bool)
+
+type codeInfoGetterContextKey struct{}
+
+// WithCodeInfoGetter returns a copy of the context which carries the given code metadata getter,
+// used to check the ABI version reported by contracts against the version declared by their code.
+func WithCodeInfoGetter(ctx sdk.Context, getter CodeInfoGetter) sdk.Context {
+	return ctx.WithValue(codeInfoGetterContextKey{}, getter)
+}
+
+// codeInfoFromContext returns the metadata of the code with the given code id read by the getter
+// carried by the context, and false if the context carries no getter or the code has no metadata.
+func codeInfoFromContext(ctx sdk.Context, codeID []byte) (CodeInfo, bool) {
+	getter, ok := ctx.Value(codeInfoGetterContextKey{}).(CodeInfoGetter)
+	if !ok || getter == nil {
+		return CodeInfo{}, false
+	}
+
+	return getter(ctx, codeID)
+}
+
+// validateLabelAndVersion checks that the optional label and version of a code do not exceed
+// their maximum length.
+func validateLabelAndVersion(label, version string) error {
+	if len(label) > MaxLabelLength {
+		return sdkerrors.Wrapf(ErrInvalidCodeInfo, "label length %d exceeds the maximum length %d", len(label), MaxLabelLength)
+	}
+
+	if len(version) > MaxVersionLength {
+		return sdkerrors.Wrapf(ErrInvalidCodeInfo, "version length %d exceeds the maximum length %d", len(version), MaxVersionLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/wasm/v1/code_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Metadata of a stored wasm code
+type CodeInfo struct {
+	// the code id, which is the sha256 checksum of the code
+	CodeId []byte `protobuf:"bytes,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// the address which uploaded the code
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// the block height at which the code was uploaded
+	UploadHeight int64 `protobuf:"varint,3,opt,name=upload_height,json=uploadHeight,proto3" json:"upload_height,omitempty"`
+	// the block time at which the code was uploaded
+	UploadTime time.Time `protobuf:"bytes,4,opt,name=upload_time,json=uploadTime,proto3,stdtime" json:"upload_time"`
+	// the size of the uncompressed code in bytes
+	CodeSize uint64 `protobuf:"varint,5,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+	// an optional label of the code
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// an optional version of the code
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// the ABI version declared by the code, which is 1 for codes not declaring their version
+	AbiVersion uint64 `protobuf:"varint,8,opt,name=abi_version,json=abiVersion,proto3" json:"abi_version,omitempty"`
+}
+
+func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
+func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
+func (*CodeInfo) ProtoMessage()    {}
+func (*CodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30f335f11b20a53b, []int{0}
+}
+func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeInfo.Merge(m, src)
+}
+func (m *CodeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeInfo proto.InternalMessageInfo
+
+func (m *CodeInfo) GetCodeId() []byte {
+	if m != nil {
+		return m.CodeId
+	}
+	return nil
+}
+
+func (m *CodeInfo) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *CodeInfo) GetUploadHeight() int64 {
+	if m != nil {
+		return m.UploadHeight
+	}
+	return 0
+}
+
+func (m *CodeInfo) GetUploadTime() time.Time {
+	if m != nil {
+		return m.UploadTime
+	}
+	return time.Time{}
+}
+
+func (m *CodeInfo) GetCodeSize() uint64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
+func (m *CodeInfo) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *CodeInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CodeInfo) GetAbiVersion() uint64 {
+	if m != nil {
+		return m.AbiVersion
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CodeInfo)(nil), "ibc.lightclients.wasm.v1.CodeInfo")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/code_info.proto", fileDescriptor_30f335f11b20a53b)
+}
+
+var fileDescriptor_30f335f11b20a53b = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x4f, 0x8b, 0xd4, 0x30,
+	0x18, 0xc6, 0x27, 0xfb, 0x67, 0xa6, 0x9b, 0x59, 0x2f, 0x61, 0xc1, 0x30, 0x42, 0x5b, 0xf4, 0xd2,
+	0xcb, 0x24, 0xae, 0x1e, 0xf4, 0x26, 0xac, 0x08, 0xee, 0xb5, 0x8a, 0xa0, 0x97, 0xa1, 0x49, 0x33,
+	0x9d, 0x40, 0xdb, 0xb7, 0x34, 0x69, 0xc5, 0xfd, 0x14, 0x7b, 0xf3, 0x2b, 0xed, 0x71, 0x8f, 0x9e,
+	0x54, 0x66, 0xbe, 0x88, 0x24, 0x99, 0x82, 0xb7, 0x3c, 0xcf, 0xfb, 0x24, 0xef, 0x2f, 0x3c, 0x38,
+	0xd3, 0x42, 0xf2, 0x5a, 0x57, 0x3b, 0x2b, 0x6b, 0xad, 0x5a, 0x6b, 0xf8, 0xf7, 0xc2, 0x34, 0x7c,
+	0xbc, 0xe6, 0x12, 0x4a, 0xb5, 0xd1, 0xed, 0x16, 0x58, 0xd7, 0x83, 0x05, 0x42, 0xb5, 0x90, 0xec,
+	0xff, 0x24, 0x73, 0x49, 0x36, 0x5e, 0xaf, 0xae, 0x2a, 0xa8, 0xc0, 0x87, 0xb8, 0x3b, 0x85, 0xfc,
+	0x2a, 0xa9, 0x00, 0xaa, 0x5a, 0x71, 0xaf, 0xc4, 0xb0, 0xe5, 0x56, 0x37, 0xca, 0xd8, 0xa2, 0xe9,
+	0x42, 0xe0, 0xf9, 0xcf, 0x13, 0x1c, 0xbd, 0x87, 0x52, 0xdd, 0xb6, 0x5b, 0x20, 0x4f, 0xf1, 0x22,
+	0x2c, 0x2c, 0x29, 0x4a, 0x51, 0x76, 0x99, 0xcf, 0x9d, 0xbc, 0x2d, 0x09, 0xc5, 0x0b, 0xd9, 0xab,
+	0xc2, 0x42, 0x4f, 0x4f, 0x52, 0x94, 0x5d, 0xe4, 0x93, 0x24, 0x2f, 0xf0, 0x93, 0xa1, 0xab, 0xa1,
+	0x28, 0x37, 0x3b, 0xe5, 0xb0, 0xe8, 0x69, 0x8a, 0xb2, 0xd3, 0xfc, 0x32, 0x98, 0x1f, 0xbd, 0x47,
+	0x3e, 0xe0, 0xe5, 0x31, 0xe4, 0xd6, 0xd3, 0xb3, 0x14, 0x65, 0xcb, 0x57, 0x2b, 0x16, 0xd8, 0xd8,
+	0xc4, 0xc6, 0x3e, 0x4f, 0x6c, 0x37, 0xd1, 0xc3, 0xef, 0x64, 0x76, 0xff, 0x27, 0x41, 0x39, 0x0e,
+	0x17, 0xdd, 0x88, 0x3c, 0xc3, 0x17, 0x1e, 0xcf, 0xe8, 0x3b, 0x45, 0xcf, 0x53, 0x94, 0x9d, 0xe5,
+	0x91, 0x33, 0x3e, 0xe9, 0x3b, 0x45, 0xae, 0xf0, 0x79, 0x5d, 0x08, 0x55, 0xd3, 0xb9, 0x07, 0x0c,
+	0xc2, 0x81, 0x8f, 0xaa, 0x37, 0x1a, 0x5a, 0xba, 0x08, 0xe0, 0x47, 0x49, 0x12, 0xbc, 0x2c, 0x84,
+	0xde, 0x4c, 0xd3, 0xc8, 0x3f, 0x87, 0x0b, 0xa1, 0xbf, 0x04, 0xe7, 0xe6, 0xeb, 0xc3, 0x3e, 0x46,
+	0x8f, 0xfb, 0x18, 0xfd, 0xdd, 0xc7, 0xe8, 0xfe, 0x10, 0xcf, 0x1e, 0x0f, 0xf1, 0xec, 0xd7, 0x21,
+	0x9e, 0x7d, 0x7b, 0x57, 0x69, 0xbb, 0x1b, 0x04, 0x93, 0xd0, 0x70, 0x09, 0xa6, 0x01, 0xc3, 0xb5,
+	0x90, 0xeb, 0x0a, 0xf8, 0xf8, 0x86, 0x37, 0x50, 0x0e, 0xb5, 0x32, 0xa1, 0xce, 0xf5, 0xd4, 0xe7,
+	0xcb, 0xb7, 0x6b, 0x5f, 0xa9, 0xfd, 0xd1, 0x29, 0x23, 0xe6, 0xfe, 0xcb, 0xaf, 0xff, 0x0d, 0x00,
+	0x47, 0xb4, 0x3e, 0xdc, 0xf8, 0x01, 0x00, 0x00,
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AbiVersion != 0 {
+		i = encodeVarintCodeInfo(dAtA, i, uint64(m.AbiVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintCodeInfo(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintCodeInfo(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CodeSize != 0 {
+		i = encodeVarintCodeInfo(dAtA, i, uint64(m.CodeSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UploadTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UploadTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCodeInfo(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.UploadHeight != 0 {
+		i = encodeVarintCodeInfo(dAtA, i, uint64(m.UploadHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCodeInfo(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintCodeInfo(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCodeInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCodeInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovCodeInfo(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCodeInfo(uint64(l))
+	}
+	if m.UploadHeight != 0 {
+		n += 1 + sovCodeInfo(uint64(m.UploadHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UploadTime)
+	n += 1 + l + sovCodeInfo(uint64(l))
+	if m.CodeSize != 0 {
+		n += 1 + sovCodeInfo(uint64(m.CodeSize))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovCodeInfo(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovCodeInfo(uint64(l))
+	}
+	if m.AbiVersion != 0 {
+		n += 1 + sovCodeInfo(uint64(m.AbiVersion))
+	}
+	return n
+}
+
+func sovCodeInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCodeInfo(x uint64) (n int) {
+	return sovCodeInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodeInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeId = append(m.CodeId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeId == nil {
+				m.CodeId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadHeight", wireType)
+			}
+			m.UploadHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UploadTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
+			}
+			m.CodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiVersion", wireType)
+			}
+			m.AbiVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbiVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodeInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCodeInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCodeInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCodeInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCodeInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCodeInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCodeInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCodeInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCodeInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCodeInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCodeInfo = fmt.Errorf("proto: unexpected end of group")
+)
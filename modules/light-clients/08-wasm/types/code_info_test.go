@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+func (suite *WasmTestSuite) TestCodeInfo() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	data, err := os.ReadFile("test_data/migrate_cw.wasm.gz")
+	suite.Require().NoError(err)
+	code, err := wasmtypes.Uncompress(data, wasmtypes.DefaultMaxWasmCodeSize)
+	suite.Require().NoError(err)
+
+	msg := wasmtypes.NewMsgPushNewWasmCode(signer, data)
+	msg.Label = "migrate"
+	msg.Version = "v1.0.0"
+	response, err := suite.wasmKeeper.PushNewWasmCode(suite.ctx, msg)
+	suite.Require().NoError(err)
+	codeID := hex.EncodeToString(response.CodeId)
+
+	res, err := suite.wasmKeeper.CodeInfo(suite.ctx, &wasmtypes.CodeInfoQuery{CodeId: codeID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmtypes.NewCodeInfo(
+		response.CodeId, signer, suite.ctx.BlockHeight(), suite.ctx.BlockTime(), uint64(len(code)), "migrate", "v1.0.0", 1,
+	), res.CodeInfo)
+	suite.Require().Empty(res.ClientIds)
+
+	// the clients using the code are returned
+	clientID, err := clientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+	otherClientID, err := clientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+
+	res, err = suite.wasmKeeper.CodeInfo(suite.ctx, &wasmtypes.CodeInfoQuery{CodeId: hex.EncodeToString(suite.codeID)})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{clientID, otherClientID}, res.ClientIds)
+	suite.Require().Equal(signer, res.CodeInfo.Creator)
+
+	clientsRes, err := suite.wasmKeeper.ClientsByCode(suite.ctx, &wasmtypes.ClientsByCodeQuery{
+		CodeId:     hex.EncodeToString(suite.codeID),
+		Pagination: &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{clientID}, clientsRes.ClientIds)
+	suite.Require().Equal(uint64(2), clientsRes.Pagination.Total)
+
+	clientsRes, err = suite.wasmKeeper.ClientsByCode(suite.ctx, &wasmtypes.ClientsByCodeQuery{
+		CodeId:     hex.EncodeToString(suite.codeID),
+		Pagination: &sdkquery.PageRequest{Key: clientsRes.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{otherClientID}, clientsRes.ClientIds)
+
+	// the metadata is deleted together with the code
+	_, err = suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(signer, response.CodeId))
+	suite.Require().NoError(err)
+	_, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, response.CodeId)
+	suite.Require().False(found)
+}
+
+func (suite *WasmTestSuite) TestCodeInfoWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// test invalid query requests
+	_, err := suite.wasmKeeper.CodeInfo(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.wasmKeeper.CodeInfo(suite.ctx, &wasmtypes.CodeInfoQuery{CodeId: "test"})
+	suite.Require().Error(err)
+	_, err = suite.wasmKeeper.CodeInfo(suite.ctx, &wasmtypes.CodeInfoQuery{CodeId: hex.EncodeToString(make([]byte, 32))})
+	suite.Require().Error(err)
+
+	_, err = suite.wasmKeeper.ClientsByCode(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.wasmKeeper.ClientsByCode(suite.ctx, &wasmtypes.ClientsByCodeQuery{CodeId: "test"})
+	suite.Require().Error(err)
+	_, err = suite.wasmKeeper.ClientsByCode(suite.ctx, &wasmtypes.ClientsByCodeQuery{CodeId: hex.EncodeToString(make([]byte, 32))})
+	suite.Require().Error(err)
+
+	// test invalid messages
+	msg := wasmtypes.NewMsgPushNewWasmCode(signer, []byte{1})
+	suite.Require().NoError(msg.ValidateBasic())
+	msg.Label = strings.Repeat("a", wasmtypes.MaxLabelLength+1)
+	suite.Require().ErrorIs(msg.ValidateBasic(), wasmtypes.ErrInvalidCodeInfo)
+	msg.Label = ""
+	msg.Version = strings.Repeat("1", wasmtypes.MaxVersionLength+1)
+	suite.Require().ErrorIs(msg.ValidateBasic(), wasmtypes.ErrInvalidCodeInfo)
+
+	// test invalid code infos
+	suite.Require().NoError(wasmtypes.CodeInfo{CodeId: suite.codeID}.Validate())
+	suite.Require().ErrorIs(wasmtypes.CodeInfo{CodeId: []byte{1}}.Validate(), wasmtypes.ErrInvalidCodeId)
+	suite.Require().ErrorIs(wasmtypes.CodeInfo{CodeId: suite.codeID, UploadHeight: -1}.Validate(), wasmtypes.ErrInvalidCodeInfo)
+}
+
+func (suite *WasmTestSuite) TestMigrate2to3() {
+	suite.SetupWithEmptyClient()
+	app := suite.chainA.App.(*simapp.SimApp)
+
+	info, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, suite.codeID)
+	suite.Require().True(found)
+
+	// codes uploaded before code metadata was recorded do not have any
+	suite.ctx.KVStore(app.GetKey(wasmtypes.StoreKey)).Delete(wasmtypes.CodeInfoKey(suite.codeID))
+	_, found = suite.wasmKeeper.GetCodeInfo(suite.ctx, suite.codeID)
+	suite.Require().False(found)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.wasmKeeper).Migrate2to3(suite.ctx))
+	migratedInfo, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, suite.codeID)
+	suite.Require().True(found)
+	suite.Require().Equal(wasmtypes.CodeInfo{CodeId: suite.codeID, CodeSize: info.CodeSize, AbiVersion: 1}, migratedInfo)
+}
@@ -19,6 +19,17 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPushNewWasmCode{}, "ibc/MsgPushNewWasmCode")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateWasmCodeId{}, "ibc/MsgUpdateWasmCodeId")
+	legacy.RegisterAminoMsg(cdc, &MsgPinCodes{}, "ibc/MsgPinCodes")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpinCodes{}, "ibc/MsgUnpinCodes")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveWasmCode{}, "ibc/MsgRemoveWasmCode")
+	legacy.RegisterAminoMsg(cdc, &MsgMigrateContract{}, "ibc/MsgMigrateContract")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateAllowedQueries{}, "ibc/MsgUpdateAllowedQueries")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveChecksums{}, "ibc/MsgApproveChecksums")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeChecksums{}, "ibc/MsgRevokeChecksums")
+	legacy.RegisterAminoMsg(cdc, &MsgBeginUpload{}, "ibc/MsgBeginUpload")
+	legacy.RegisterAminoMsg(cdc, &MsgUploadChunk{}, "ibc/MsgUploadChunk")
+	legacy.RegisterAminoMsg(cdc, &MsgFinalizeUpload{}, "ibc/MsgFinalizeUpload")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "ibc/MsgUpdateParams")
 }
 
 // RegisterInterfaces registers the tendermint concrete client-related
@@ -48,6 +59,20 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateWasmCodeId{},
 	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgPinCodes{},
+		&MsgUnpinCodes{},
+		&MsgRemoveWasmCode{},
+		&MsgMigrateContract{},
+		&MsgUpdateAllowedQueries{},
+		&MsgApproveChecksums{},
+		&MsgRevokeChecksums{},
+		&MsgBeginUpload{},
+		&MsgUploadChunk{},
+		&MsgFinalizeUpload{},
+		&MsgUpdateParams{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
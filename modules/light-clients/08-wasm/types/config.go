@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// DefaultDataDir is the default directory, relative to the node home directory, in which the
+	// VM stores the wasm codes and their compiled artifacts.
+	DefaultDataDir = "wasm_client_data"
+	// DefaultMemoryLimitMb is the default memory limit of a contract instance in MiB.
+	DefaultMemoryLimitMb uint32 = 4096
+	// MaxMemoryLimitMb is the maximum memory limit of a contract instance in MiB, i.e. the
+	// size of the 32-bit wasm address space.
+	MaxMemoryLimitMb uint32 = 4096
+	// DefaultCacheSizeMb is the default size of the VM memory cache in MiB.
+	DefaultCacheSizeMb uint32 = 256
+
+	flagDataDir               = "ibc-wasm.data_dir"
+	flagSupportedCapabilities = "ibc-wasm.supported_capabilities"
+	flagMemoryLimitMb         = "ibc-wasm.memory_limit_mb"
+	flagPrintDebug            = "ibc-wasm.print_debug"
+	flagCacheSizeMb           = "ibc-wasm.cache_size_mb"
+)
+
+// DefaultSupportedCapabilities are the capabilities the VM supports by default.
+var DefaultSupportedCapabilities = []string{"storage", "iterator"}
+
+// VMConfig defines the settings of the VM used by the 08-wasm keeper.
+type VMConfig struct {
+	// DataDir is the directory in which the VM stores the wasm codes and their compiled artifacts.
+	// A relative path is relative to the node home directory.
+	DataDir string `mapstructure:"data_dir"`
+	// SupportedCapabilities are the capabilities contracts may require from the VM.
+	SupportedCapabilities []string `mapstructure:"supported_capabilities"`
+	// MemoryLimitMb is the memory limit of a contract instance in MiB.
+	MemoryLimitMb uint32 `mapstructure:"memory_limit_mb"`
+	// PrintDebug enables printing the debug messages of contracts to stdout.
+	// It should be disabled on production nodes.
+	PrintDebug bool `mapstructure:"print_debug"`
+	// CacheSizeMb is the size of the VM memory cache of compiled contracts in MiB.
+	CacheSizeMb uint32 `mapstructure:"cache_size_mb"`
+}
+
+// DefaultVMConfig returns the default VM settings.
+func DefaultVMConfig() VMConfig {
+	return VMConfig{
+		DataDir:               DefaultDataDir,
+		SupportedCapabilities: append([]string(nil), DefaultSupportedCapabilities...),
+		MemoryLimitMb:         DefaultMemoryLimitMb,
+		PrintDebug:            false,
+		CacheSizeMb:           DefaultCacheSizeMb,
+	}
+}
+
+// Validate performs basic validation of the VM settings.
+func (c VMConfig) Validate() error {
+	if strings.TrimSpace(c.DataDir) == "" {
+		return fmt.Errorf("wasm data directory cannot be blank")
+	}
+
+	seen := make(map[string]bool, len(c.SupportedCapabilities))
+	for _, capability := range c.SupportedCapabilities {
+		if strings.TrimSpace(capability) == "" || strings.ContainsAny(capability, ", \t\n") {
+			return fmt.Errorf("invalid wasm capability %q", capability)
+		}
+		if seen[capability] {
+			return fmt.Errorf("duplicate wasm capability %q", capability)
+		}
+		seen[capability] = true
+	}
+
+	if c.MemoryLimitMb == 0 || c.MemoryLimitMb > MaxMemoryLimitMb {
+		return fmt.Errorf("wasm memory limit must be between 1 and %d MiB, got %d", MaxMemoryLimitMb, c.MemoryLimitMb)
+	}
+
+	return nil
+}
+
+// ReadVMConfig reads the VM settings from the [ibc-wasm] section of the app.toml. Settings
+// which are not set keep their default values.
+func ReadVMConfig(opts servertypes.AppOptions) (VMConfig, error) {
+	cfg := DefaultVMConfig()
+
+	var err error
+	if v := opts.Get(flagDataDir); v != nil {
+		if cfg.DataDir, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", flagDataDir, err)
+		}
+	}
+
+	if v := opts.Get(flagSupportedCapabilities); v != nil {
+		if cfg.SupportedCapabilities, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", flagSupportedCapabilities, err)
+		}
+	}
+
+	if v := opts.Get(flagMemoryLimitMb); v != nil {
+		if cfg.MemoryLimitMb, err = cast.ToUint32E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", flagMemoryLimitMb, err)
+		}
+	}
+
+	if v := opts.Get(flagPrintDebug); v != nil {
+		if cfg.PrintDebug, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", flagPrintDebug, err)
+		}
+	}
+
+	if v := opts.Get(flagCacheSizeMb); v != nil {
+		if cfg.CacheSizeMb, err = cast.ToUint32E(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", flagCacheSizeMb, err)
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// DefaultConfigTemplate is the app.toml template of the VM settings. It expects the settings in
+// the WasmClient field of the custom app config.
+const DefaultConfigTemplate = `
+###############################################################################
+###                        08-wasm Light Client VM                          ###
+###############################################################################
+
+[ibc-wasm]
+
+# The directory in which the VM stores the wasm codes, relative to the node home directory.
+data_dir = "{{ .WasmClient.DataDir }}"
+
+# The capabilities contracts may require from the VM.
+supported_capabilities = [{{ range $i, $c := .WasmClient.SupportedCapabilities }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end }}]
+
+# The memory limit of a contract instance in MiB.
+memory_limit_mb = {{ .WasmClient.MemoryLimitMb }}
+
+# Print the debug messages of contracts to stdout. Disable on production nodes.
+print_debug = {{ .WasmClient.PrintDebug }}
+
+# The size of the VM memory cache of compiled contracts in MiB.
+cache_size_mb = {{ .WasmClient.CacheSizeMb }}
+`
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+func TestVMConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*types.VMConfig)
+		expPass  bool
+	}{
+		{"default config", func(*types.VMConfig) {}, true},
+		{"absolute data dir", func(c *types.VMConfig) { c.DataDir = "/var/lib/wasm" }, true},
+		{"no capabilities", func(c *types.VMConfig) { c.SupportedCapabilities = nil }, true},
+		{"blank data dir", func(c *types.VMConfig) { c.DataDir = " " }, false},
+		{"empty capability", func(c *types.VMConfig) { c.SupportedCapabilities = []string{""} }, false},
+		{"comma separated capabilities", func(c *types.VMConfig) { c.SupportedCapabilities = []string{"storage,iterator"} }, false},
+		{"duplicate capability", func(c *types.VMConfig) { c.SupportedCapabilities = []string{"iterator", "iterator"} }, false},
+		{"zero memory limit", func(c *types.VMConfig) { c.MemoryLimitMb = 0 }, false},
+		{"memory limit too large", func(c *types.VMConfig) { c.MemoryLimitMb = types.MaxMemoryLimitMb + 1 }, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := types.DefaultVMConfig()
+			tc.malleate(&cfg)
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestReadVMConfig(t *testing.T) {
+	cfg, err := types.ReadVMConfig(appOptions{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultVMConfig(), cfg)
+
+	cfg, err = types.ReadVMConfig(appOptions{
+		"ibc-wasm.data_dir":               "wasm",
+		"ibc-wasm.supported_capabilities": []interface{}{"iterator"},
+		"ibc-wasm.memory_limit_mb":        int64(32),
+		"ibc-wasm.print_debug":            true,
+		"ibc-wasm.cache_size_mb":          "100",
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.VMConfig{
+		DataDir:               "wasm",
+		SupportedCapabilities: []string{"iterator"},
+		MemoryLimitMb:         32,
+		PrintDebug:            true,
+		CacheSizeMb:           100,
+	}, cfg)
+
+	_, err = types.ReadVMConfig(appOptions{"ibc-wasm.cache_size_mb": "large"})
+	require.Error(t, err)
+
+	_, err = types.ReadVMConfig(appOptions{"ibc-wasm.memory_limit_mb": 0})
+	require.Error(t, err)
+}
+
+func TestDefaultConfigTemplate(t *testing.T) {
+	expCfg := types.DefaultVMConfig()
+	expCfg.PrintDebug = true
+
+	tmpl, err := template.New("app.toml").Parse(types.DefaultConfigTemplate)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, struct{ WasmClient types.VMConfig }{expCfg}))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+
+	cfg, err := types.ReadVMConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, expCfg, cfg)
+}
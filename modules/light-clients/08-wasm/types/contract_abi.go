@@ -0,0 +1,265 @@
+package types
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ContractABIVersion is the version of the messages exchanged with light client contracts. The
+// messages are defined by the types of this file and described by ContractABISchema. Any change
+// to their JSON encoding requires a new version.
+//
+// Operations changing the client state are sent to the sudo entry point, and read-only
+// operations to the query entry point, which cannot write to the client store. Contracts
+// implementing version 1 receive both on the execute entry point, except the status and
+// export_metadata queries, and the client store is read-only during the read-only operations.
+const ContractABIVersion uint64 = 2
+
+// ContractABIVersionExportPrefix is the prefix of the name of the function a contract exports to
+// declare the ABI version it implements, followed by the version in decimal, for example
+// ibc_wasm_abi_version_2. The function is never called; its export is checked when the code is
+// stored. Contracts declaring no version are assumed to implement version 1.
+const ContractABIVersionExportPrefix = "ibc_wasm_abi_version_"
+
+// ContractABISchema is the JSON Schema of the messages exchanged with light client contracts, as
+// found in schema/contract_abi.json.
+//
+//go:embed schema/contract_abi.json
+var ContractABISchema []byte
+
+// InstantiateMsg is the message passed to the instantiate entry point of a contract when a client
+// is created.
+type InstantiateMsg struct{}
+
+// InstantiateResponse is the data of the response of the instantiate and migrate entry points, by
+// which a contract reports the ABI version it implements. Contracts returning no data are assumed
+// to implement version 1.
+type InstantiateResponse struct {
+	ABIVersion uint64 `json:"abi_version"`
+}
+
+// SudoMsg is the message passed to the sudo entry point of a contract to change the client state.
+// Exactly one field is set.
+type SudoMsg struct {
+	UpdateState                   *UpdateStateMsg                   `json:"update_state,omitempty"`
+	UpdateStateOnMisbehaviour     *UpdateStateOnMisbehaviourMsg     `json:"update_state_on_misbehaviour,omitempty"`
+	VerifyUpgradeAndUpdateState   *VerifyUpgradeAndUpdateStateMsg   `json:"verify_upgrade_and_update_state,omitempty"`
+	CheckSubstituteAndUpdateState *CheckSubstituteAndUpdateStateMsg `json:"check_substitute_and_update_state,omitempty"`
+}
+
+// QueryMsg is the message passed to the query entry point of a contract. Exactly one field is set.
+type QueryMsg struct {
+	Status               *StatusMsg               `json:"status,omitempty"`
+	ExportMetadata       *ExportMetadataMsg       `json:"export_metadata,omitempty"`
+	VerifyClientMessage  *VerifyClientMessageMsg  `json:"verify_client_message,omitempty"`
+	CheckForMisbehaviour *CheckForMisbehaviourMsg `json:"check_for_misbehaviour,omitempty"`
+	VerifyMembership     *VerifyMembershipMsg     `json:"verify_membership,omitempty"`
+	VerifyNonMembership  *VerifyNonMembershipMsg  `json:"verify_non_membership,omitempty"`
+}
+
+// VerifyClientMessageMsg asks the contract to verify a client message.
+type VerifyClientMessageMsg struct {
+	ClientMessage ContractClientMessage `json:"client_message"`
+}
+
+// CheckForMisbehaviourMsg asks the contract whether a verified client message is misbehaviour.
+type CheckForMisbehaviourMsg struct {
+	ClientMessage ContractClientMessage `json:"client_message"`
+}
+
+// UpdateStateMsg asks the contract to update the client and consensus states with a verified header.
+type UpdateStateMsg struct {
+	ClientMessage ContractClientMessage `json:"client_message"`
+}
+
+// UpdateStateOnMisbehaviourMsg asks the contract to freeze the client after misbehaviour was found.
+type UpdateStateOnMisbehaviourMsg struct {
+	ClientMessage ContractClientMessage `json:"client_message"`
+}
+
+// VerifyMembershipMsg asks the contract to verify the existence of a value at a path.
+type VerifyMembershipMsg struct {
+	Height           ContractHeight     `json:"height"`
+	DelayTimePeriod  uint64             `json:"delay_time_period"`
+	DelayBlockPeriod uint64             `json:"delay_block_period"`
+	Proof            []byte             `json:"proof"`
+	Path             ContractMerklePath `json:"path"`
+	Value            []byte             `json:"value"`
+}
+
+// VerifyNonMembershipMsg asks the contract to verify the absence of a value at a path.
+type VerifyNonMembershipMsg struct {
+	Height           ContractHeight     `json:"height"`
+	DelayTimePeriod  uint64             `json:"delay_time_period"`
+	DelayBlockPeriod uint64             `json:"delay_block_period"`
+	Proof            []byte             `json:"proof"`
+	Path             ContractMerklePath `json:"path"`
+}
+
+// VerifyUpgradeAndUpdateStateMsg asks the contract to verify an upgrade of the counterparty chain
+// and to store the upgraded states.
+type VerifyUpgradeAndUpdateStateMsg struct {
+	UpgradeClientState         ContractClientState    `json:"upgrade_client_state"`
+	UpgradeConsensusState      ContractConsensusState `json:"upgrade_consensus_state"`
+	ProofUpgradeClient         []byte                 `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte                 `json:"proof_upgrade_consensus_state"`
+}
+
+// CheckSubstituteAndUpdateStateMsg asks the contract to replace the subject client with the
+// substitute client. Their stores are prefixed with "subject/" and "substitute/", and the
+// substitute store is read-only.
+type CheckSubstituteAndUpdateStateMsg struct{}
+
+// StatusMsg asks the contract for the status of the client.
+type StatusMsg struct{}
+
+// ExportMetadataMsg asks the contract for the metadata of the client to export in genesis.
+type ExportMetadataMsg struct{}
+
+// ContractHeight is a height of the counterparty chain.
+type ContractHeight struct {
+	RevisionNumber uint64 `json:"revision_number,omitempty"`
+	RevisionHeight uint64 `json:"revision_height,omitempty"`
+}
+
+// ContractMerklePath is the path of a value in the store of the counterparty chain.
+type ContractMerklePath struct {
+	KeyPath []string `json:"key_path,omitempty"`
+}
+
+// ContractClientState is a client state of the wasm light client.
+type ContractClientState struct {
+	Data         []byte         `json:"data,omitempty"`
+	CodeId       []byte         `json:"code_id,omitempty"`
+	LatestHeight ContractHeight `json:"latest_height"`
+}
+
+// ContractConsensusState is a consensus state of the wasm light client.
+type ContractConsensusState struct {
+	Data      []byte `json:"data,omitempty"`
+	Timestamp uint64 `json:"timestamp,omitempty"`
+}
+
+// ContractHeader is a header of the wasm light client.
+type ContractHeader struct {
+	Data   []byte         `json:"data,omitempty"`
+	Height ContractHeight `json:"height"`
+}
+
+// ContractMisbehaviour is a misbehaviour of the wasm light client.
+type ContractMisbehaviour struct {
+	Data []byte `json:"data,omitempty"`
+}
+
+// ContractClientMessage is a client message of the wasm light client. At most one field is set.
+type ContractClientMessage struct {
+	Header       *ContractHeader       `json:"header,omitempty"`
+	Misbehaviour *ContractMisbehaviour `json:"misbehaviour,omitempty"`
+}
+
+// ContractGenesisMetadata is a metadata entry of the client store exported in genesis.
+type ContractGenesisMetadata struct {
+	Key   []byte `json:"key,omitempty"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// NewContractHeight returns the contract representation of a height.
+func NewContractHeight(height exported.Height) ContractHeight {
+	return ContractHeight{
+		RevisionNumber: height.GetRevisionNumber(),
+		RevisionHeight: height.GetRevisionHeight(),
+	}
+}
+
+// NewContractMerklePath returns the contract representation of a merkle path.
+func NewContractMerklePath(path commitmenttypes.MerklePath) ContractMerklePath {
+	return ContractMerklePath{KeyPath: path.KeyPath}
+}
+
+// NewContractClientState returns the contract representation of a client state.
+func NewContractClientState(clientState *ClientState) ContractClientState {
+	return ContractClientState{
+		Data:         clientState.Data,
+		CodeId:       clientState.CodeId,
+		LatestHeight: NewContractHeight(clientState.LatestHeight),
+	}
+}
+
+// NewContractConsensusState returns the contract representation of a consensus state.
+func NewContractConsensusState(consensusState *ConsensusState) ContractConsensusState {
+	return ContractConsensusState{
+		Data:      consensusState.Data,
+		Timestamp: consensusState.Timestamp,
+	}
+}
+
+// NewContractClientMessage returns the contract representation of a client message, which is
+// empty if the message is neither a header nor a misbehaviour.
+func NewContractClientMessage(clientMsg exported.ClientMessage) ContractClientMessage {
+	var msg ContractClientMessage
+	switch clientMsg := clientMsg.(type) {
+	case *Header:
+		msg.Header = &ContractHeader{Data: clientMsg.Data, Height: NewContractHeight(clientMsg.Height)}
+	case *Misbehaviour:
+		msg.Misbehaviour = &ContractMisbehaviour{Data: clientMsg.Data}
+	}
+	return msg
+}
+
+// Height returns the client height of a contract height.
+func (h ContractHeight) Height() clienttypes.Height {
+	return clienttypes.NewHeight(h.RevisionNumber, h.RevisionHeight)
+}
+
+// contractABIVersion returns the ABI version reported in the data of the response of the
+// instantiate or migrate entry point by the contract of the code with the given id. An error is
+// returned if the version is not supported, or differs from the version declared by the code when
+// it was stored, so that a contract cannot be called with another ABI than the one it declares.
+// Codes stored before their ABI version was recorded are not checked.
+func contractABIVersion(ctx sdk.Context, codeID, data []byte) (uint64, error) {
+	version := uint64(1)
+	if len(data) != 0 {
+		var response InstantiateResponse
+		if err := json.Unmarshal(data, &response); err != nil {
+			return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "invalid response: %s", err)
+		}
+		if response.ABIVersion < 1 || response.ABIVersion > ContractABIVersion {
+			return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "contract implements ABI version %d, expected at most %d", response.ABIVersion, ContractABIVersion)
+		}
+		version = response.ABIVersion
+	}
+
+	if info, found := codeInfoFromContext(ctx, codeID); found && info.AbiVersion != 0 && info.AbiVersion != version {
+		return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "code id %s declares ABI version %d, but the contract reports version %d", hex.EncodeToString(codeID), info.AbiVersion, version)
+	}
+
+	return version, nil
+}
+
+// setContractABIVersion stores the ABI version implemented by the contract of the client. Nothing
+// is stored for version 1, so that the client store of contracts written before the ABI was
+// versioned, which may iterate over it, is left as they expect.
+func setContractABIVersion(clientStore sdk.KVStore, version uint64) {
+	if version == 1 {
+		clientStore.Delete([]byte(KeyContractABIVersion))
+		return
+	}
+	clientStore.Set([]byte(KeyContractABIVersion), sdk.Uint64ToBigEndian(version))
+}
+
+// getContractABIVersion returns the ABI version implemented by the contract of the client, which
+// is version 1 if none is stored.
+func getContractABIVersion(clientStore sdk.KVStore) uint64 {
+	bz := clientStore.Get([]byte(KeyContractABIVersion))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
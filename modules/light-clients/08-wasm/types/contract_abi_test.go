@@ -0,0 +1,328 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+// isWritable returns true if the contract can write to the given store, which it tries.
+func isWritable(store cosmwasm.KVStore) (writable bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if err, ok := r.(error); !ok || !errors.Is(err, wasmtypes.ErrReadOnlyStore) {
+				panic(r)
+			}
+			writable = false
+		}
+	}()
+	store.Set([]byte("entry_point"), []byte("written"))
+	return true
+}
+
+func (suite *WasmTestSuite) TestContractABISchema() {
+	var schema struct {
+		ABIVersion uint64 `json:"abi_version"`
+		Sudo       struct {
+			OneOf []struct {
+				Required []string `json:"required"`
+			} `json:"oneOf"`
+		} `json:"sudo"`
+		Query struct {
+			OneOf []struct {
+				Required []string `json:"required"`
+			} `json:"oneOf"`
+		} `json:"query"`
+	}
+	suite.Require().NoError(json.Unmarshal(wasmtypes.ContractABISchema, &schema))
+	suite.Require().Equal(wasmtypes.ContractABIVersion, schema.ABIVersion)
+
+	// every message has a variant in the schema
+	variants := func(msg any) []string {
+		var names []string
+		msgType := reflect.TypeOf(msg)
+		for i := 0; i < msgType.NumField(); i++ {
+			names = append(names, strings.Split(msgType.Field(i).Tag.Get("json"), ",")[0])
+		}
+		return names
+	}
+	var sudoVariants, queryVariants []string
+	for _, variant := range schema.Sudo.OneOf {
+		sudoVariants = append(sudoVariants, variant.Required...)
+	}
+	for _, variant := range schema.Query.OneOf {
+		queryVariants = append(queryVariants, variant.Required...)
+	}
+	suite.Require().ElementsMatch(variants(wasmtypes.SudoMsg{}), sudoVariants)
+	suite.Require().ElementsMatch(variants(wasmtypes.QueryMsg{}), queryVariants)
+}
+
+func (suite *WasmTestSuite) TestContractABIEncoding() {
+	height := clienttypes.NewHeight(1, 10)
+
+	testCases := []struct {
+		name     string
+		msg      any
+		expected string
+	}{
+		{
+			"verify membership",
+			wasmtypes.QueryMsg{VerifyMembership: &wasmtypes.VerifyMembershipMsg{
+				Height:          wasmtypes.NewContractHeight(height),
+				DelayTimePeriod: 1,
+				Proof:           []byte{1},
+				Path:            wasmtypes.NewContractMerklePath(commitmenttypes.NewMerklePath("ibc", "key")),
+				Value:           []byte{2},
+			}},
+			`{"verify_membership":{"height":{"revision_number":1,"revision_height":10},"delay_time_period":1,"delay_block_period":0,"proof":"AQ==","path":{"key_path":["ibc","key"]},"value":"Ag=="}}`,
+		},
+		{
+			"update state",
+			wasmtypes.SudoMsg{UpdateState: &wasmtypes.UpdateStateMsg{
+				ClientMessage: wasmtypes.NewContractClientMessage(&wasmtypes.Header{Data: []byte{1}, Height: height}),
+			}},
+			`{"update_state":{"client_message":{"header":{"data":"AQ==","height":{"revision_number":1,"revision_height":10}}}}}`,
+		},
+		{
+			"verify upgrade and update state",
+			wasmtypes.SudoMsg{VerifyUpgradeAndUpdateState: &wasmtypes.VerifyUpgradeAndUpdateStateMsg{
+				UpgradeClientState:    wasmtypes.NewContractClientState(wasmtypes.NewClientState([]byte{1}, []byte{2}, height)),
+				UpgradeConsensusState: wasmtypes.NewContractConsensusState(&wasmtypes.ConsensusState{Data: []byte{3}, Timestamp: 4}),
+			}},
+			`{"verify_upgrade_and_update_state":{"upgrade_client_state":{"data":"AQ==","code_id":"Ag==","latest_height":{"revision_number":1,"revision_height":10}},"upgrade_consensus_state":{"data":"Aw==","timestamp":4},"proof_upgrade_client":null,"proof_upgrade_consensus_state":null}}`,
+		},
+		{
+			"check substitute and update state",
+			wasmtypes.SudoMsg{CheckSubstituteAndUpdateState: &wasmtypes.CheckSubstituteAndUpdateStateMsg{}},
+			`{"check_substitute_and_update_state":{}}`,
+		},
+		{
+			"status",
+			wasmtypes.QueryMsg{Status: &wasmtypes.StatusMsg{}},
+			`{"status":{}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := json.Marshal(tc.msg)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().JSONEq(tc.expected, string(bz), tc.name)
+	}
+}
+
+func (suite *WasmTestSuite) TestInitializeABIVersion() {
+	testCases := []struct {
+		name    string
+		data    []byte
+		expPass bool
+	}{
+		{
+			"success: no reported version",
+			nil,
+			true,
+		},
+		{
+			"success: version 1",
+			[]byte(`{"abi_version":1}`),
+			true,
+		},
+		{
+			"success: current version",
+			[]byte(`{"abi_version":2}`),
+			true,
+		},
+		{
+			"failure: unsupported version",
+			[]byte(`{"abi_version":3}`),
+			false,
+		},
+		{
+			"failure: zero version",
+			[]byte(`{"abi_version":0}`),
+			false,
+		},
+		{
+			"failure: invalid response",
+			[]byte("1"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			// the mock contract does not implement the version declared by the code of the client
+			ctx := wasmtypes.WithCodeInfoGetter(suite.ctx, nil)
+			ctx = wasmtypes.WithWasmEngine(ctx, wasmtesting.NewMockWasmEngine(wasmtesting.DataResponse(string(tc.data))))
+
+			err := suite.clientState.Initialize(ctx, suite.chainA.Codec, suite.store, &suite.consensusState)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, wasmtypes.ErrUnsupportedABIVersion)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestDeclaredABIVersion() {
+	testCases := []struct {
+		name       string
+		code       []byte
+		expVersion uint64
+		reported   string
+		expPass    bool
+	}{
+		{
+			"success: undeclared version 1 is reported by default",
+			wasmtesting.WasmModule("instantiate", "execute", "query"),
+			1,
+			"",
+			true,
+		},
+		{
+			"success: declared version is reported",
+			wasmtesting.WasmModule("instantiate", "sudo", "query", "ibc_wasm_abi_version_2"),
+			2,
+			`{"abi_version":2}`,
+			true,
+		},
+		{
+			"failure: declared version 2 is not reported",
+			wasmtesting.WasmModule("instantiate", "sudo", "query", "ibc_wasm_abi_version_2"),
+			2,
+			"",
+			false,
+		},
+		{
+			"failure: undeclared version 1 reports version 2",
+			wasmtesting.WasmModule("instantiate", "execute", "query"),
+			1,
+			`{"abi_version":2}`,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			app := suite.chainA.App.(*simapp.SimApp)
+			engine := wasmtesting.NewMockWasmEngine(wasmtesting.DataResponse(tc.reported))
+			k := keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, "", nil, keeper.WithWasmEngine(engine))
+			ctx := k.ClientContext(suite.ctx)
+
+			// the version declared by the code is recorded with it
+			res, err := k.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, tc.code))
+			suite.Require().NoError(err)
+			info, found := k.GetCodeInfo(suite.ctx, res.CodeId)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expVersion, info.AbiVersion)
+
+			// the contract must report the declared version on instantiation and migration
+			clientState := *suite.clientState.(*wasmtypes.ClientState)
+			clientState.CodeId = res.CodeId
+			initErr := clientState.Initialize(ctx, suite.chainA.Codec, suite.store, &suite.consensusState)
+			migrateErr := suite.clientState.(*wasmtypes.ClientState).MigrateContract(ctx, suite.store, res.CodeId, []byte("{}"))
+			if tc.expPass {
+				suite.Require().NoError(initErr)
+				suite.Require().NoError(migrateErr)
+			} else {
+				suite.Require().ErrorIs(initErr, wasmtypes.ErrUnsupportedABIVersion)
+				suite.Require().ErrorIs(migrateErr, wasmtypes.ErrUnsupportedABIVersion)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestContractEntryPoints() {
+	header := &wasmtypes.Header{Height: clienttypes.NewHeight(2000, 4)}
+	path := commitmenttypes.NewMerklePath("ibc", "key")
+
+	testCases := []struct {
+		name            string
+		instantiateData []byte
+		expCalls        []string
+		expMetadata     []exported.GenesisMetadata
+	}{
+		{
+			"version 1: read-only operations are executed with a read-only store",
+			nil,
+			[]string{"execute:false", "execute:false", "execute:false", "execute:false", "execute:true", "execute:true", "query:false"},
+			[]exported.GenesisMetadata{},
+		},
+		{
+			"current version: read-only operations are queried and state changes use sudo",
+			[]byte(`{"abi_version":2}`),
+			[]string{"query:false", "query:false", "query:false", "query:false", "sudo:true", "sudo:true", "query:false"},
+			[]exported.GenesisMetadata{
+				clienttypes.NewGenesisMetadata([]byte(wasmtypes.KeyContractABIVersion), sdk.Uint64ToBigEndian(2)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			clientState := suite.clientState.(*wasmtypes.ClientState)
+			// the calls are recorded with whether the contract could write to the client store
+			var calls []string
+			engine := wasmtesting.NewMockWasmEngine(func(call wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+				switch call.EntryPoint {
+				case wasmtesting.EntryPointInstantiate:
+					return &wasmvmtypes.Response{Data: tc.instantiateData}, nil
+				case wasmtesting.EntryPointMigrate:
+					return &wasmvmtypes.Response{}, nil
+				}
+				writable := isWritable(call.Store)
+				calls = append(calls, fmt.Sprintf("%s:%t", call.EntryPoint, writable))
+				if !writable {
+					return &wasmvmtypes.Response{Data: []byte(`{"is_valid":true}`)}, nil
+				}
+
+				// state changes write a consensus state at the height of the header and report it
+				call.Store.Set(host.ConsensusStateKey(header.Height), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, &suite.consensusState))
+				return &wasmvmtypes.Response{Data: []byte(`{"is_valid":true,"heights":[{"revision_number":2000,"revision_height":4}]}`)}, nil
+			})
+			// the mock contract reports the version of the test case, whichever the code declares
+			ctx := wasmtypes.WithWasmEngine(wasmtypes.WithCodeInfoGetter(suite.ctx, nil), engine)
+			cdc := suite.chainA.Codec
+
+			suite.Require().NoError(clientState.Initialize(ctx, cdc, suite.store, &suite.consensusState))
+
+			suite.Require().NoError(clientState.VerifyClientMessage(ctx, cdc, suite.store, header))
+			suite.Require().False(clientState.CheckForMisbehaviour(ctx, cdc, suite.store, header))
+			suite.Require().NoError(clientState.VerifyMembership(ctx, suite.store, cdc, header.Height, 0, 0, []byte{1}, path, []byte{2}))
+			suite.Require().NoError(clientState.VerifyNonMembership(ctx, suite.store, cdc, header.Height, 0, 0, []byte{1}, path))
+			clientState.UpdateState(ctx, cdc, suite.store, header)
+			clientState.UpdateStateOnMisbehaviour(ctx, cdc, suite.store, header)
+
+			// the version is exported with the client metadata, unless it is version 1
+			metadata := clientState.ExportMetadata(ctx, suite.store)
+			suite.Require().Equal(tc.expCalls, calls)
+			suite.Require().Equal(tc.expMetadata, metadata)
+
+			// a migrated contract reports its version like on instantiation
+			suite.Require().NoError(clientState.MigrateContract(ctx, suite.store, suite.codeID, []byte("{}")))
+			suite.Require().Empty(clientState.ExportMetadata(ctx, suite.store))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+)
+
+const (
+	// MinContractEventTypeSize is the minimum length of the type of a custom contract event, after
+	// trimming whitespace.
+	MinContractEventTypeSize = 2
+	// MaxContractEventTypeSize is the maximum length of the type of a custom contract event.
+	MaxContractEventTypeSize = 64
+	// MaxContractAttributeKeySize is the maximum length of the key of a contract event attribute.
+	MaxContractAttributeKeySize = 128
+	// MaxContractAttributeValueSize is the maximum length of the value of a contract event attribute.
+	MaxContractAttributeValueSize = 2048
+	// ReservedContractAttributePrefix prefixes the attribute keys contracts are not allowed to use.
+	ReservedContractAttributePrefix = "_"
+)
+
+// reservedContractAttributeKeys are the attribute keys set by the module on every contract event,
+// which contracts are not allowed to use.
+var reservedContractAttributeKeys = []string{
+	clienttypes.AttributeKeyClientID,
+	clienttypes.AttributeKeyWasmChecksum,
+}
+
+// contractEvents converts the attributes and events of the response of a contract to SDK events.
+// The attributes are emitted in a single event of type wasm_contract and each custom event gets
+// its type prefixed with "wasm_contract-". All events are tagged with the checksum of the code of
+// the contract and, if the context carries it, the identifier of the client. An error is returned
+// if an event type or attribute is invalid.
+func contractEvents(ctx sdk.Context, codeID []byte, response *wasmvmtypes.Response) (sdk.Events, error) {
+	tags := []sdk.Attribute{sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, hex.EncodeToString(codeID))}
+	if clientID, ok := clienttypes.ClientIDFromContext(ctx); ok {
+		tags = append([]sdk.Attribute{sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID)}, tags...)
+	}
+
+	var events sdk.Events
+	if len(response.Attributes) > 0 {
+		attributes, err := contractAttributes(tags, response.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, sdk.NewEvent(clienttypes.EventTypeWasmContract, attributes...))
+	}
+
+	for _, event := range response.Events {
+		eventType := strings.TrimSpace(event.Type)
+		if len(eventType) < MinContractEventTypeSize || len(eventType) > MaxContractEventTypeSize {
+			return nil, sdkerrors.Wrapf(ErrInvalidContractEvent, "event type %q must be between %d and %d characters", event.Type, MinContractEventTypeSize, MaxContractEventTypeSize)
+		}
+		attributes, err := contractAttributes(tags, event.Attributes)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "event type %s", eventType)
+		}
+		events = append(events, sdk.NewEvent(clienttypes.EventTypePrefixWasmContract+eventType, attributes...))
+	}
+
+	return events, nil
+}
+
+// contractAttributes converts the attributes of a contract event to SDK attributes following the
+// given tags, trimming whitespace around their keys and values.
+func contractAttributes(tags []sdk.Attribute, attributes wasmvmtypes.EventAttributes) ([]sdk.Attribute, error) {
+	converted := make([]sdk.Attribute, 0, len(tags)+len(attributes))
+	converted = append(converted, tags...)
+	for _, attribute := range attributes {
+		key := strings.TrimSpace(attribute.Key)
+		if len(key) == 0 {
+			return nil, sdkerrors.Wrap(ErrInvalidContractEvent, "empty attribute key")
+		}
+		if len(key) > MaxContractAttributeKeySize {
+			return nil, sdkerrors.Wrapf(ErrInvalidContractEvent, "attribute key of %d characters exceeds %d characters", len(key), MaxContractAttributeKeySize)
+		}
+		if strings.HasPrefix(key, ReservedContractAttributePrefix) {
+			return nil, sdkerrors.Wrapf(ErrInvalidContractEvent, "attribute key %s starts with reserved prefix %s", key, ReservedContractAttributePrefix)
+		}
+		for _, reserved := range reservedContractAttributeKeys {
+			if key == reserved {
+				return nil, sdkerrors.Wrapf(ErrInvalidContractEvent, "attribute key %s is reserved", key)
+			}
+		}
+
+		value := strings.TrimSpace(attribute.Value)
+		if len(value) > MaxContractAttributeValueSize {
+			return nil, sdkerrors.Wrapf(ErrInvalidContractEvent, "value of attribute %s exceeds %d characters", key, MaxContractAttributeValueSize)
+		}
+
+		converted = append(converted, sdk.NewAttribute(key, value))
+	}
+	return converted, nil
+}
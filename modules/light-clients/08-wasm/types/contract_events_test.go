@@ -0,0 +1,160 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *WasmTestSuite) TestContractEvents() {
+	var response *wasmvmtypes.Response
+	header := &wasmtypes.Header{Height: clienttypes.NewHeight(2000, 4)}
+	clientID := "08-wasm-0"
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expEvents func(checksum string) sdk.Events
+		expPass   bool
+	}{
+		{
+			"success: no attributes or events",
+			func() {},
+			func(string) sdk.Events { return sdk.Events{} },
+			true,
+		},
+		{
+			"success: attributes and events are namespaced and tagged",
+			func() {
+				response.Attributes = wasmvmtypes.EventAttributes{{Key: " action ", Value: " update "}}
+				response.Events = wasmvmtypes.Events{
+					{Type: "authority_set", Attributes: wasmvmtypes.EventAttributes{{Key: "set_id", Value: "7"}}},
+					{Type: "rotated"},
+				}
+			},
+			func(checksum string) sdk.Events {
+				return sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeWasmContract,
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, checksum),
+						sdk.NewAttribute("action", "update"),
+					),
+					sdk.NewEvent(
+						clienttypes.EventTypePrefixWasmContract+"authority_set",
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, checksum),
+						sdk.NewAttribute("set_id", "7"),
+					),
+					sdk.NewEvent(
+						clienttypes.EventTypePrefixWasmContract+"rotated",
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyWasmChecksum, checksum),
+					),
+				}
+			},
+			true,
+		},
+		{
+			"failure: events of a failed call are not emitted",
+			func() {
+				response.Data = []byte(`{"is_valid":false,"error_msg":"failed"}`)
+				response.Attributes = wasmvmtypes.EventAttributes{{Key: "action", Value: "update"}}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: empty attribute key",
+			func() {
+				response.Attributes = wasmvmtypes.EventAttributes{{Key: " ", Value: "update"}}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: attribute key with reserved prefix",
+			func() {
+				response.Attributes = wasmvmtypes.EventAttributes{{Key: "_contract_address", Value: "update"}}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: attribute key set by the module",
+			func() {
+				response.Events = wasmvmtypes.Events{
+					{Type: "rotated", Attributes: wasmvmtypes.EventAttributes{{Key: clienttypes.AttributeKeyClientID, Value: "07-tendermint-0"}}},
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: attribute key too long",
+			func() {
+				response.Attributes = wasmvmtypes.EventAttributes{{Key: strings.Repeat("k", wasmtypes.MaxContractAttributeKeySize+1), Value: "update"}}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: attribute value too long",
+			func() {
+				response.Attributes = wasmvmtypes.EventAttributes{{Key: "action", Value: strings.Repeat("v", wasmtypes.MaxContractAttributeValueSize+1)}}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: event type too short",
+			func() {
+				response.Events = wasmvmtypes.Events{{Type: " a "}}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: event type too long",
+			func() {
+				response.Events = wasmvmtypes.Events{{Type: strings.Repeat("t", wasmtypes.MaxContractEventTypeSize+1)}}
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			clientState := suite.clientState.(*wasmtypes.ClientState)
+			response = &wasmvmtypes.Response{Data: []byte(`{"is_valid":true}`)}
+			engine := wasmtesting.NewMockWasmEngine(func(wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+				return response, nil
+			})
+
+			tc.malleate()
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			ctx = wasmtypes.WithWasmEngine(clienttypes.WithClientID(ctx, clientID), engine)
+			updateState := func() {
+				clientState.UpdateStateOnMisbehaviour(ctx, suite.chainA.Codec, suite.store, header)
+			}
+
+			if tc.expPass {
+				suite.Require().NotPanics(updateState)
+				suite.Require().Equal(tc.expEvents(hex.EncodeToString(clientState.CodeId)), ctx.EventManager().Events())
+			} else {
+				suite.Require().Panics(updateState)
+				suite.Require().Empty(ctx.EventManager().Events())
+			}
+		})
+	}
+}
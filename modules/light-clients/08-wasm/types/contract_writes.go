@@ -0,0 +1,164 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	proto "github.com/cosmos/gogoproto/proto"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// trackingStore records the keys written or deleted through it.
+type trackingStore struct {
+	sdk.KVStore
+
+	touched map[string]struct{}
+}
+
+func (s trackingStore) Set(key, value []byte) {
+	s.touched[string(key)] = struct{}{}
+	s.KVStore.Set(key, value)
+}
+
+func (s trackingStore) Delete(key []byte) {
+	s.touched[string(key)] = struct{}{}
+	s.KVStore.Delete(key)
+}
+
+// written returns true if a value was written at the key through the store and is still stored.
+func (s trackingStore) written(key []byte) bool {
+	_, touched := s.touched[string(key)]
+	return touched && s.Has(key)
+}
+
+// withValidatedWrites calls fn with a cached copy of the client store and writes the changes back
+// to the client store once fn succeeded and the IBC-reserved keys written by the contract hold a
+// valid client or consensus state, so that a faulty contract cannot leave the client unreadable.
+// The client state must use one of the given code ids.
+func withValidatedWrites(clientStore sdk.KVStore, fn func(store trackingStore) error, codeIDs ...[]byte) error {
+	cache := cachekv.NewStore(clientStore)
+	store := trackingStore{KVStore: cache, touched: make(map[string]struct{})}
+	if err := fn(store); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(store.touched))
+	for key := range store.touched {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := validateContractWrite(cache, []byte(key), codeIDs); err != nil {
+			return err
+		}
+	}
+
+	cache.Write()
+	return nil
+}
+
+// validateContractWrite checks the value a contract left at a key of the client store. The
+// client state may not be deleted and must remain a wasm client state using one of the given
+// code ids, whose latest height is not zero and has a valid consensus state. Consensus states
+// may be deleted, except the one at the latest height of the client, but must otherwise be valid
+// wasm consensus states. The key of the ABI version is reserved for the module. Other keys are
+// not checked.
+func validateContractWrite(clientStore sdk.KVStore, key []byte, codeIDs [][]byte) error {
+	switch {
+	case bytes.Equal(key, host.ClientStateKey()):
+		bz := clientStore.Get(key)
+		if bz == nil {
+			return sdkerrors.Wrap(ErrInvalidContractWrite, "client state was deleted")
+		}
+
+		var clientState ClientState
+		if err := unmarshalContractState(bz, &clientState); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "client state: %s", err)
+		}
+		if err := clientState.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "client state: %s", err)
+		}
+		if !containsCodeID(codeIDs, clientState.CodeId) {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "client state uses code id %s", hex.EncodeToString(clientState.CodeId))
+		}
+		if clientState.LatestHeight.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidContractWrite, "client state latest height cannot be zero")
+		}
+
+		bz = clientStore.Get(host.ConsensusStateKey(clientState.LatestHeight))
+		if bz == nil {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "no consensus state at the latest height %s", clientState.LatestHeight)
+		}
+
+		var consensusState ConsensusState
+		if err := unmarshalContractState(bz, &consensusState); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "consensus state at the latest height %s: %s", clientState.LatestHeight, err)
+		}
+
+	case bytes.Equal(key, []byte(KeyContractABIVersion)):
+		return sdkerrors.Wrapf(ErrInvalidContractWrite, "key %s is reserved", KeyContractABIVersion)
+
+	case isConsensusStateKey(key):
+		bz := clientStore.Get(key)
+		if bz == nil {
+			// the consensus state at the latest height of the client cannot be deleted
+			var clientState ClientState
+			if err := unmarshalContractState(clientStore.Get(host.ClientStateKey()), &clientState); err == nil && bytes.Equal(key, host.ConsensusStateKey(clientState.LatestHeight)) {
+				return sdkerrors.Wrapf(ErrInvalidContractWrite, "consensus state at the latest height %s was deleted", clientState.LatestHeight)
+			}
+			return nil
+		}
+
+		var consensusState ConsensusState
+		if err := unmarshalContractState(bz, &consensusState); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "consensus state at key %s: %s", key, err)
+		}
+		if err := consensusState.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidContractWrite, "consensus state at key %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalContractState unmarshals a client or consensus state stored as an Any, checking that
+// it has the type of the given state.
+func unmarshalContractState(bz []byte, state codec.ProtoMarshaler) error {
+	var anyState codectypes.Any
+	if err := anyState.Unmarshal(bz); err != nil {
+		return err
+	}
+	if typeURL := "/" + proto.MessageName(state); anyState.TypeUrl != typeURL {
+		return sdkerrors.Wrapf(ErrInvalid, "type %s, expected %s", anyState.TypeUrl, typeURL)
+	}
+	return state.Unmarshal(anyState.Value)
+}
+
+// isConsensusStateKey returns true if the key is the key of a consensus state, as opposed to the
+// keys of the metadata stored next to the consensus states by some light clients.
+func isConsensusStateKey(key []byte) bool {
+	height, found := strings.CutPrefix(string(key), host.KeyConsensusStatePrefix+"/")
+	if !found {
+		return false
+	}
+	_, err := clienttypes.ParseHeight(height)
+	return err == nil
+}
+
+func containsCodeID(codeIDs [][]byte, codeID []byte) bool {
+	for _, id := range codeIDs {
+		if bytes.Equal(id, codeID) {
+			return true
+		}
+	}
+	return false
+}
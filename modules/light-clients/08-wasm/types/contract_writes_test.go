@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	tmclient "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+// contractWrite is a write of a contract to the client store, which deletes the key if the value
+// is nil.
+type contractWrite struct {
+	key   []byte
+	value []byte
+}
+
+func (suite *WasmTestSuite) TestContractWrites() {
+	var (
+		writes         []contractWrite
+		clientState    *wasmtypes.ClientState
+		consensusState *wasmtypes.ConsensusState
+	)
+	header := &wasmtypes.Header{Height: clienttypes.NewHeight(2000, 4)}
+	previousHeight := clienttypes.NewHeight(2000, 3)
+	metadataKey := []byte("consensusStates/2000-4/processedTime")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: valid client and consensus states",
+			func() {
+				clientState.LatestHeight = clienttypes.NewHeight(2000, 5)
+				writes = []contractWrite{
+					{host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, clientState)},
+					{host.ConsensusStateKey(clientState.LatestHeight), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, consensusState)},
+				}
+			},
+			true,
+		},
+		{
+			"success: consensus state below the latest height deleted",
+			func() {
+				writes = []contractWrite{{host.ConsensusStateKey(previousHeight), nil}}
+			},
+			true,
+		},
+		{
+			"success: keys other than client and consensus states are not checked",
+			func() {
+				writes = []contractWrite{{metadataKey, []byte("processed time")}}
+			},
+			true,
+		},
+		{
+			"failure: client state deleted",
+			func() {
+				writes = []contractWrite{{host.ClientStateKey(), nil}}
+			},
+			false,
+		},
+		{
+			"failure: client state cannot be decoded",
+			func() {
+				writes = []contractWrite{{host.ClientStateKey(), []byte("client state")}}
+			},
+			false,
+		},
+		{
+			"failure: client state is not a wasm client state",
+			func() {
+				writes = []contractWrite{{host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, &tmclient.ClientState{})}}
+			},
+			false,
+		},
+		{
+			"failure: client state uses another code id",
+			func() {
+				clientState.CodeId = make([]byte, 32)
+				writes = []contractWrite{{host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, clientState)}}
+			},
+			false,
+		},
+		{
+			"failure: client state latest height is zero",
+			func() {
+				clientState.LatestHeight = clienttypes.ZeroHeight()
+				writes = []contractWrite{{host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, clientState)}}
+			},
+			false,
+		},
+		{
+			"failure: no consensus state at the latest height",
+			func() {
+				clientState.LatestHeight = clienttypes.NewHeight(2000, 5)
+				writes = []contractWrite{{host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, clientState)}}
+			},
+			false,
+		},
+		{
+			"failure: consensus state at the latest height cannot be decoded",
+			func() {
+				clientState.LatestHeight = previousHeight
+				writes = []contractWrite{
+					{host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, clientState)},
+					{host.ConsensusStateKey(previousHeight), []byte("consensus state")},
+				}
+			},
+			false,
+		},
+		{
+			"failure: consensus state at the latest height deleted",
+			func() {
+				writes = []contractWrite{{host.ConsensusStateKey(header.Height), nil}}
+			},
+			false,
+		},
+		{
+			"failure: invalid consensus state",
+			func() {
+				consensusState.Timestamp = 0
+				writes = []contractWrite{{host.ConsensusStateKey(header.Height), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, consensusState)}}
+			},
+			false,
+		},
+		{
+			"failure: ABI version key is reserved",
+			func() {
+				writes = []contractWrite{{[]byte(wasmtypes.KeyContractABIVersion), []byte{2}}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			wasmClientState := *suite.clientState.(*wasmtypes.ClientState)
+			clientState = &wasmClientState
+			wasmConsensusState := suite.consensusState
+			consensusState = &wasmConsensusState
+
+			// the writes of the contract are reverted with the other keys it wrote
+			writes = nil
+			tc.malleate()
+			writes = append(writes, contractWrite{[]byte("contract key"), []byte("contract value")})
+
+			suite.store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.Codec, suite.clientState))
+			suite.store.Set(host.ConsensusStateKey(header.Height), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, &suite.consensusState))
+			suite.store.Set(host.ConsensusStateKey(previousHeight), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, &suite.consensusState))
+
+			engine := wasmtesting.NewMockWasmEngine(func(call wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+				for _, write := range writes {
+					if write.value == nil {
+						call.Store.Delete(write.key)
+					} else {
+						call.Store.Set(write.key, write.value)
+					}
+				}
+				return &wasmvmtypes.Response{Data: []byte(`{"is_valid":true}`)}, nil
+			})
+			ctx := wasmtypes.WithWasmEngine(suite.ctx, engine)
+			updateState := func() {
+				suite.clientState.UpdateStateOnMisbehaviour(ctx, suite.chainA.Codec, suite.store, header)
+			}
+
+			if tc.expPass {
+				suite.Require().NotPanics(updateState)
+				for _, write := range writes {
+					suite.Require().Equal(write.value, suite.store.Get(write.key))
+				}
+			} else {
+				suite.Require().Panics(updateState)
+				suite.Require().Nil(suite.store.Get([]byte("contract key")))
+				suite.Require().Equal(clienttypes.MustMarshalClientState(suite.chainA.Codec, suite.clientState), suite.store.Get(host.ClientStateKey()))
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ WasmEngine = (*cosmwasm.VM)(nil)
+
+// WasmEngine defines the subset of the wasmvm VM used by the 08-wasm light client. The engine is
+// owned by the 08-wasm keeper and passed to the client state methods through the context.
+type WasmEngine interface {
+	// StoreCode compiles the given wasm code and stores it, returning its checksum.
+	StoreCode(code cosmwasm.WasmCode) (cosmwasm.Checksum, error)
+
+	// GetCode returns the wasm code with the given checksum.
+	GetCode(checksum cosmwasm.Checksum) (cosmwasm.WasmCode, error)
+
+	// AnalyzeCode returns a static analysis of the stored wasm code with the given checksum.
+	AnalyzeCode(checksum cosmwasm.Checksum) (*wasmvmtypes.AnalysisReport, error)
+
+	// RemoveCode removes the wasm code with the given checksum.
+	RemoveCode(checksum cosmwasm.Checksum) error
+
+	// Pin pins the wasm code with the given checksum in the in-memory cache.
+	Pin(checksum cosmwasm.Checksum) error
+
+	// Unpin removes the wasm code with the given checksum from the in-memory cache.
+	Unpin(checksum cosmwasm.Checksum) error
+
+	// Instantiate calls the instantiate entry point of the contract.
+	Instantiate(
+		checksum cosmwasm.Checksum,
+		env wasmvmtypes.Env,
+		info wasmvmtypes.MessageInfo,
+		initMsg []byte,
+		store cosmwasm.KVStore,
+		goapi cosmwasm.GoAPI,
+		querier cosmwasm.Querier,
+		gasMeter cosmwasm.GasMeter,
+		gasLimit uint64,
+		deserCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.Response, uint64, error)
+
+	// Execute calls the execute entry point of the contract.
+	Execute(
+		checksum cosmwasm.Checksum,
+		env wasmvmtypes.Env,
+		info wasmvmtypes.MessageInfo,
+		executeMsg []byte,
+		store cosmwasm.KVStore,
+		goapi cosmwasm.GoAPI,
+		querier cosmwasm.Querier,
+		gasMeter cosmwasm.GasMeter,
+		gasLimit uint64,
+		deserCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.Response, uint64, error)
+
+	// Sudo calls the sudo entry point of the contract.
+	Sudo(
+		checksum cosmwasm.Checksum,
+		env wasmvmtypes.Env,
+		sudoMsg []byte,
+		store cosmwasm.KVStore,
+		goapi cosmwasm.GoAPI,
+		querier cosmwasm.Querier,
+		gasMeter cosmwasm.GasMeter,
+		gasLimit uint64,
+		deserCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.Response, uint64, error)
+
+	// Query calls the query entry point of the contract.
+	Query(
+		checksum cosmwasm.Checksum,
+		env wasmvmtypes.Env,
+		queryMsg []byte,
+		store cosmwasm.KVStore,
+		goapi cosmwasm.GoAPI,
+		querier cosmwasm.Querier,
+		gasMeter cosmwasm.GasMeter,
+		gasLimit uint64,
+		deserCost wasmvmtypes.UFraction,
+	) ([]byte, uint64, error)
+
+	// Migrate calls the migrate entry point of the contract.
+	Migrate(
+		checksum cosmwasm.Checksum,
+		env wasmvmtypes.Env,
+		migrateMsg []byte,
+		store cosmwasm.KVStore,
+		goapi cosmwasm.GoAPI,
+		querier cosmwasm.Querier,
+		gasMeter cosmwasm.GasMeter,
+		gasLimit uint64,
+		deserCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.Response, uint64, error)
+}
+
+type wasmEngineContextKey struct{}
+
+// WithWasmEngine returns a copy of the context which carries the given engine.
+func WithWasmEngine(ctx sdk.Context, engine WasmEngine) sdk.Context {
+	return ctx.WithValue(wasmEngineContextKey{}, engine)
+}
+
+// WasmEngineFromContext returns the engine carried by the context. An error is returned if the
+// context does not carry an engine.
+func WasmEngineFromContext(ctx sdk.Context) (WasmEngine, error) {
+	engine, ok := ctx.Value(wasmEngineContextKey{}).(WasmEngine)
+	if !ok || engine == nil {
+		return nil, ErrWasmEngineNotFound
+	}
+
+	return engine, nil
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+func (suite *WasmTestSuite) TestWasmEngine() {
+	suite.SetupWithEmptyClient()
+	clientState := suite.clientState.(*wasmtypes.ClientState)
+
+	// the contract cannot be called without an engine in the context
+	_, err := wasmtypes.WasmEngineFromContext(suite.chainA.GetContext())
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmEngineNotFound)
+	suite.Require().Equal(exported.Unknown, clientState.Status(suite.chainA.GetContext(), suite.store, suite.chainA.Codec))
+
+	// the engine carried by the context is used
+	engine := wasmtesting.NewMockWasmEngine(wasmtesting.DataResponse(`{"status":"Frozen"}`))
+	ctx := wasmtypes.WithWasmEngine(suite.chainA.GetContext(), engine)
+	suite.Require().Equal(exported.Frozen, clientState.Status(ctx, suite.store, suite.chainA.Codec))
+	suite.Require().Len(engine.Calls, 1)
+
+	// a keeper created with an engine does not create a VM and passes its engine to client states
+	app := suite.chainA.App.(*simapp.SimApp)
+	k := keeper.NewKeeper(suite.chainA.Codec, app.GetKey(wasmtypes.StoreKey), "", "", nil, keeper.WithWasmEngine(engine))
+	suite.Require().Equal(engine, k.GetWasmEngine())
+	suite.Require().Equal(exported.Frozen, clientState.Status(k.ClientContext(suite.chainA.GetContext()), suite.store, suite.chainA.Codec))
+	suite.Require().Len(engine.Calls, 2)
+
+	// the client keeper of the app passes the VM of the wasm keeper
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientID, err := clientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Active, clientKeeper.GetClientStatus(suite.chainA.GetContext(), clientState, clientID))
+}
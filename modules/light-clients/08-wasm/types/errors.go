@@ -12,14 +12,31 @@ var (
 	ErrUnableToQuery            = sdkerrors.Register(ModuleName, 7, "unable to query wasm contract")
 	ErrUnableToMarshalPayload   = sdkerrors.Register(ModuleName, 8, "unable to marshal wasm contract payload")
 	// Wasm specific
-	ErrWasmEmptyCode      = sdkerrors.Register(ModuleName, 9, "empty wasm code")
-	ErrWasmEmptyCodeHash  = sdkerrors.Register(ModuleName, 10, "empty wasm code hash")
-	ErrWasmCodeTooLarge   = sdkerrors.Register(ModuleName, 11, "wasm code too large")
-	ErrWasmCodeExists     = sdkerrors.Register(ModuleName, 12, "wasm code already exists")
-	ErrWasmCodeValidation = sdkerrors.Register(ModuleName, 13, "unable to validate wasm code")
-	ErrWasmInvalidCode    = sdkerrors.Register(ModuleName, 14, "invalid wasm code")
-	ErrWasmInvalidCodeID  = sdkerrors.Register(ModuleName, 15, "invalid wasm code id")
-	ErrWasmCodeIDNotFound = sdkerrors.Register(ModuleName, 16, "wasm code id not found")
-	ErrInvalid            = sdkerrors.Register(ModuleName, 17, "invalid")
-	ErrCreateFailed       = sdkerrors.Register(ModuleName, 18, "create wasm contract failed")
+	ErrWasmEmptyCode         = sdkerrors.Register(ModuleName, 9, "empty wasm code")
+	ErrWasmEmptyCodeHash     = sdkerrors.Register(ModuleName, 10, "empty wasm code hash")
+	ErrWasmCodeTooLarge      = sdkerrors.Register(ModuleName, 11, "wasm code too large")
+	ErrWasmCodeExists        = sdkerrors.Register(ModuleName, 12, "wasm code already exists")
+	ErrWasmCodeValidation    = sdkerrors.Register(ModuleName, 13, "unable to validate wasm code")
+	ErrWasmInvalidCode       = sdkerrors.Register(ModuleName, 14, "invalid wasm code")
+	ErrWasmInvalidCodeID     = sdkerrors.Register(ModuleName, 15, "invalid wasm code id")
+	ErrWasmCodeIDNotFound    = sdkerrors.Register(ModuleName, 16, "wasm code id not found")
+	ErrInvalid               = sdkerrors.Register(ModuleName, 17, "invalid")
+	ErrCreateFailed          = sdkerrors.Register(ModuleName, 18, "create wasm contract failed")
+	ErrPinCodeFailed         = sdkerrors.Register(ModuleName, 19, "pin wasm code failed")
+	ErrUnpinCodeFailed       = sdkerrors.Register(ModuleName, 20, "unpin wasm code failed")
+	ErrWasmCodeInUse         = sdkerrors.Register(ModuleName, 21, "wasm code is in use")
+	ErrMigrateContractFailed = sdkerrors.Register(ModuleName, 22, "migrate wasm contract failed")
+	ErrWasmEngineNotFound    = sdkerrors.Register(ModuleName, 23, "wasm engine not found in context")
+	ErrInvalidAllowedQuery   = sdkerrors.Register(ModuleName, 24, "invalid allowed query")
+	ErrInvalidCodeInfo       = sdkerrors.Register(ModuleName, 25, "invalid code info")
+	ErrChecksumNotApproved   = sdkerrors.Register(ModuleName, 26, "wasm code checksum not approved")
+	ErrInvalidUpload         = sdkerrors.Register(ModuleName, 27, "invalid wasm upload")
+	ErrUploadNotFound        = sdkerrors.Register(ModuleName, 28, "wasm upload not found")
+	ErrUnsupportedABIVersion = sdkerrors.Register(ModuleName, 29, "unsupported wasm contract ABI version")
+	ErrReadOnlyStore         = sdkerrors.Register(ModuleName, 30, "wasm contract store is read-only")
+	ErrInvalidContractEvent  = sdkerrors.Register(ModuleName, 31, "invalid wasm contract event")
+	ErrInvalidContractWrite  = sdkerrors.Register(ModuleName, 32, "invalid state written by wasm contract")
+	ErrInvalidParams         = sdkerrors.Register(ModuleName, 33, "invalid 08-wasm params")
+	ErrWasmCodeNotAllowed    = sdkerrors.Register(ModuleName, 34, "wasm code not allowed for new clients")
+	ErrInvalidReportedHeight = sdkerrors.Register(ModuleName, 35, "invalid height reported by wasm contract")
 )
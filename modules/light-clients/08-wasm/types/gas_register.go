@@ -57,11 +57,6 @@ var defaultPerByteUncompressCost = wasmvmtypes.UFraction{
 	Denominator: 100,
 }
 
-var costJSONDeserialization = wasmvmtypes.UFraction{
-	Numerator:   DefaultDeserializationCostPerByte * DefaultGasMultiplier,
-	Denominator: 1,
-}
-
 // DefaultPerByteUncompressCost is how much SDK gas we charge per source byte to unpack
 func DefaultPerByteUncompressCost() wasmvmtypes.UFraction {
 	return defaultPerByteUncompressCost
@@ -172,6 +167,14 @@ func (g WasmGasRegister) FromWasmVMGas(source uint64) sdk.Gas {
 	return source / g.c.GasMultiplier
 }
 
+// deserializationCost is the wasm VM gas charged per byte of the results of contracts
+func (g WasmGasRegister) deserializationCost() wasmvmtypes.UFraction {
+	return wasmvmtypes.UFraction{
+		Numerator:   DefaultDeserializationCostPerByte * g.c.GasMultiplier,
+		Denominator: 1,
+	}
+}
+
 func (g WasmGasRegister) runtimeGasForContract(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
 	if meter.IsOutOfGas() {
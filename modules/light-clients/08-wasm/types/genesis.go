@@ -1,30 +1,26 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"time"
 
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-type ExportMetadataPayload struct {
-	ExportMetadata ExportMetadataInnerPayload `json:"export_metadata"`
-}
-
-type ExportMetadataInnerPayload struct{}
-
-// ExportMetadata is a no-op since wasm client does not store any metadata in client store
-func (c ClientState) ExportMetadata(store sdk.KVStore) []exported.GenesisMetadata {
-	payload := ExportMetadataPayload{}
-
-	encodedData, err := json.Marshal(payload)
+// ExportMetadata queries the contract for the metadata stored in the client store. The ABI version
+// implemented by the contract is exported with it.
+func (c ClientState) ExportMetadata(ctx sdk.Context, store sdk.KVStore) []exported.GenesisMetadata {
+	encodedData, err := json.Marshal(QueryMsg{ExportMetadata: &ExportMetadataMsg{}})
 	if err != nil {
 		panic(err)
 	}
 
-	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1, Time: time.Now()}, true, nil) // context with infinite gas meter
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 	response, err := queryContractWithStore(c.CodeId, ctx, store, encodedData)
 	if err != nil {
 		panic(err)
@@ -37,8 +33,111 @@ func (c ClientState) ExportMetadata(store sdk.KVStore) []exported.GenesisMetadat
 
 	genesisMetadata := make([]exported.GenesisMetadata, len(output.GenesisMetadata))
 	for i, metadata := range output.GenesisMetadata {
-		genesisMetadata[i] = metadata
+		genesisMetadata[i] = clienttypes.NewGenesisMetadata(metadata.Key, metadata.Value)
+	}
+
+	if version := store.Get([]byte(KeyContractABIVersion)); version != nil {
+		genesisMetadata = append(genesisMetadata, clienttypes.NewGenesisMetadata([]byte(KeyContractABIVersion), version))
 	}
 
 	return genesisMetadata
 }
+
+// GetParamsOrDefault returns the parameters of the genesis state, or the default parameters if
+// they are unset.
+func (gs GenesisState) GetParamsOrDefault() Params {
+	if gs.Params == nil {
+		return DefaultParams()
+	}
+	return *gs.Params
+}
+
+// Validate performs basic genesis state validation. Every code id must be the checksum of its
+// code, which must not exceed MaxWasmSize once uncompressed. The max wasm code size parameter only
+// applies to new uploads, the codes may have been stored under a larger value.
+// Approved checksums must not be the code id of a contract, as the approval is consumed by the
+// upload.
+func (gs GenesisState) Validate() error {
+	params := gs.GetParamsOrDefault()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Contracts))
+	for _, contract := range gs.Contracts {
+		if err := validateCodeIds([][]byte{contract.CodeHash}); err != nil {
+			return err
+		}
+
+		codeID := hex.EncodeToString(contract.CodeHash)
+		if seen[codeID] {
+			return sdkerrors.Wrapf(ErrWasmCodeExists, "duplicate code id %s", codeID)
+		}
+		seen[codeID] = true
+
+		code, err := contract.UncompressedCode(MaxWasmSize)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "code id %s", codeID)
+		}
+
+		checksum := sha256.Sum256(code)
+		if !bytes.Equal(checksum[:], contract.CodeHash) {
+			return sdkerrors.Wrapf(ErrInvalidCodeId, "code id %s is not the checksum of its code", codeID)
+		}
+
+		if info := contract.CodeInfo; info != nil {
+			if err := info.Validate(); err != nil {
+				return sdkerrors.Wrapf(err, "code id %s", codeID)
+			}
+			if !bytes.Equal(info.CodeId, contract.CodeHash) {
+				return sdkerrors.Wrapf(ErrInvalidCodeInfo, "code info of code id %s has code id %s", codeID, hex.EncodeToString(info.CodeId))
+			}
+			if info.CodeSize != uint64(len(code)) {
+				return sdkerrors.Wrapf(ErrInvalidCodeInfo, "code info of code id %s has code size %d, expected %d", codeID, info.CodeSize, len(code))
+			}
+			if version := DeclaredABIVersion(code); info.AbiVersion != 0 && info.AbiVersion != version {
+				return sdkerrors.Wrapf(ErrInvalidCodeInfo, "code info of code id %s has ABI version %d, but the code declares version %d", codeID, info.AbiVersion, version)
+			}
+		}
+	}
+
+	if err := ValidateAllowedQueries(gs.AllowedQueries); err != nil {
+		return err
+	}
+
+	if len(gs.ApprovedChecksums) == 0 {
+		return nil
+	}
+	if err := ValidateChecksums(gs.ApprovedChecksums); err != nil {
+		return err
+	}
+	for _, checksum := range gs.ApprovedChecksums {
+		if seen[hex.EncodeToString(checksum)] {
+			return sdkerrors.Wrapf(ErrWasmCodeExists, "approved checksum %s is the code id of a contract", hex.EncodeToString(checksum))
+		}
+	}
+
+	return nil
+}
+
+// UncompressedCode returns the wasm code of the contract, uncompressing it if it is gzip
+// compressed. An error is returned if the code is empty or exceeds the given maximum size.
+func (c GenesisContract) UncompressedCode(maxCodeSize uint64) ([]byte, error) {
+	code := c.ContractCode
+	if IsGzip(code) {
+		var err error
+		code, err = Uncompress(code, maxCodeSize)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrWasmInvalidCode, "failed to uncompress code: %s", err)
+		}
+	}
+
+	if len(code) == 0 {
+		return nil, ErrWasmEmptyCode
+	}
+	if uint64(len(code)) > maxCodeSize {
+		return nil, sdkerrors.Wrapf(ErrWasmCodeTooLarge, "code size %d exceeds the maximum size %d", len(code), maxCodeSize)
+	}
+
+	return code, nil
+}
@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// uploaded light client wasm contracts
 	Contracts []GenesisContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// chain queries which the contracts are allowed to make
+	AllowedQueries []string `protobuf:"bytes,2,rep,name=allowed_queries,json=allowedQueries,proto3" json:"allowed_queries,omitempty"`
+	// checksums of codes approved for upload which have not been pushed yet
+	ApprovedChecksums [][]byte `protobuf:"bytes,3,rep,name=approved_checksums,json=approvedChecksums,proto3" json:"approved_checksums,omitempty"`
+	// the module parameters, the default parameters are used if unset
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,12 +75,37 @@ func (m *GenesisState) GetContracts() []GenesisContract {
 	return nil
 }
 
+func (m *GenesisState) GetAllowedQueries() []string {
+	if m != nil {
+		return m.AllowedQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovedChecksums() [][]byte {
+	if m != nil {
+		return m.ApprovedChecksums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // A contract's code hash and code
 type GenesisContract struct {
-	// store key of metadata without clientID-prefix
+	// the code id, which is the sha256 checksum of the code
 	CodeHash []byte `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
-	// metadata value
+	// the wasm code, which may be gzip compressed
 	ContractCode []byte `protobuf:"bytes,2,opt,name=contract_code,json=contractCode,proto3" json:"contract_code,omitempty"`
+	// whether the code is pinned in the VM memory cache
+	Pinned bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// the metadata of the code
+	CodeInfo *CodeInfo `protobuf:"bytes,4,opt,name=code_info,json=codeInfo,proto3" json:"code_info,omitempty"`
 }
 
 func (m *GenesisContract) Reset()         { *m = GenesisContract{} }
@@ -120,25 +151,34 @@ func init() {
 }
 
 var fileDescriptor_05e250654f164e20 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4c, 0x4a, 0xd6,
-	0xcf, 0xc9, 0x4c, 0xcf, 0x28, 0x49, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x4f, 0x2c,
-	0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0xc8, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xa7, 0x07, 0x52, 0xa7, 0x57, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0xc5, 0x72,
-	0xf1, 0xb8, 0x43, 0x0c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xf2, 0xe5, 0xe2, 0x4c, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd4, 0xc3,
-	0x65, 0xa6, 0x1e, 0x54, 0xab, 0x33, 0x54, 0x87, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x08,
-	0x13, 0x94, 0x22, 0xb9, 0xf8, 0xd1, 0xd4, 0x08, 0x49, 0x83, 0x6c, 0x48, 0x49, 0x8d, 0xcf, 0x48,
-	0x2c, 0xce, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0xe2, 0x00, 0x09, 0x78, 0x24, 0x16, 0x67,
-	0x08, 0x29, 0x73, 0xf1, 0xc2, 0x34, 0xc7, 0x83, 0x04, 0x25, 0x98, 0xc0, 0x0a, 0x78, 0x60, 0x82,
-	0xce, 0xf9, 0x29, 0xa9, 0x56, 0x2c, 0x1d, 0x0b, 0xe4, 0x19, 0x9c, 0x22, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3e, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0x33, 0x29, 0x59, 0x37, 0x3d, 0x5f,
-	0xbf, 0xcc, 0x5c, 0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0x12, 0x96, 0xba, 0xb0, 0xc0,
-	0x34, 0xb0, 0xd0, 0x05, 0x87, 0x67, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x6c, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x52, 0x08, 0x87, 0xfd, 0x75, 0x01, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8a, 0xd4, 0x40,
+	0x14, 0xc6, 0x53, 0x93, 0xa6, 0x99, 0xae, 0x89, 0x0e, 0x16, 0x22, 0xc5, 0x08, 0x99, 0xd0, 0xa2,
+	0xc6, 0x45, 0x52, 0xce, 0xb8, 0x70, 0x70, 0x33, 0x30, 0xbd, 0x50, 0x17, 0x82, 0xc6, 0x95, 0x6e,
+	0x42, 0xa5, 0x52, 0x93, 0x14, 0x26, 0xa9, 0x98, 0xaa, 0xa4, 0xf1, 0x06, 0x2e, 0x3d, 0x82, 0x67,
+	0xf0, 0x14, 0xbd, 0xec, 0xa5, 0x2b, 0x91, 0xee, 0x03, 0x78, 0x05, 0xc9, 0x3f, 0x15, 0x21, 0xbb,
+	0x97, 0xef, 0xfd, 0xde, 0xcb, 0xf7, 0xa8, 0x0f, 0x3e, 0x10, 0x11, 0x23, 0x99, 0x48, 0x52, 0xcd,
+	0x32, 0xc1, 0x0b, 0xad, 0xc8, 0x9a, 0xaa, 0x9c, 0x34, 0x67, 0x24, 0xe1, 0x05, 0x57, 0x42, 0xf9,
+	0x65, 0x25, 0xb5, 0x44, 0x58, 0x44, 0xcc, 0xff, 0x97, 0xf3, 0x5b, 0xce, 0x6f, 0xce, 0x4e, 0x6e,
+	0x27, 0x32, 0x91, 0x1d, 0x44, 0xda, 0xaa, 0xe7, 0x4f, 0xdc, 0xc9, 0xbd, 0x4c, 0xc6, 0x3c, 0x14,
+	0xc5, 0xf5, 0x48, 0xde, 0x9f, 0x24, 0x4b, 0x5a, 0xd1, 0x7c, 0x30, 0xb0, 0xfc, 0x05, 0xa0, 0xf5,
+	0xbc, 0xb7, 0xf4, 0x56, 0x53, 0xcd, 0xd1, 0x2b, 0xb8, 0x60, 0xb2, 0xd0, 0x15, 0x65, 0x5a, 0x61,
+	0xe0, 0x98, 0xee, 0xd1, 0xf9, 0x23, 0x7f, 0xca, 0xa5, 0x3f, 0x8c, 0xae, 0x86, 0x89, 0xab, 0xd9,
+	0xe6, 0xc7, 0xa9, 0x11, 0xfc, 0xdd, 0x80, 0x1e, 0xc2, 0x63, 0x9a, 0x65, 0x72, 0xcd, 0xe3, 0xf0,
+	0x63, 0xcd, 0x2b, 0xc1, 0x15, 0x3e, 0x70, 0x4c, 0x77, 0x11, 0xdc, 0x1c, 0xe4, 0x37, 0xbd, 0x8a,
+	0x3c, 0x88, 0x68, 0x59, 0x56, 0xb2, 0xe1, 0x71, 0xc8, 0x52, 0xce, 0x3e, 0xa8, 0x3a, 0x57, 0xd8,
+	0x74, 0x4c, 0xd7, 0x0a, 0x6e, 0x8d, 0x9d, 0xd5, 0xd8, 0x40, 0x17, 0x70, 0xde, 0xdf, 0x81, 0x67,
+	0x0e, 0x70, 0x8f, 0xce, 0x9d, 0x69, 0x8f, 0xaf, 0x3b, 0x2e, 0x18, 0xf8, 0xe5, 0x37, 0x00, 0x8f,
+	0xff, 0xb3, 0x8d, 0xee, 0xb6, 0x47, 0xc7, 0x3c, 0x4c, 0xa9, 0x4a, 0x31, 0x70, 0x80, 0x6b, 0x05,
+	0x87, 0xad, 0xf0, 0x82, 0xaa, 0x14, 0xdd, 0x83, 0x37, 0xc6, 0x7b, 0xc2, 0x56, 0xc4, 0x07, 0x1d,
+	0x60, 0x8d, 0xe2, 0x4a, 0xc6, 0x1c, 0xdd, 0x81, 0xf3, 0x52, 0x14, 0x05, 0x8f, 0xb1, 0xe9, 0x00,
+	0xf7, 0x30, 0x18, 0xbe, 0xd0, 0x25, 0x5c, 0xfc, 0x79, 0x99, 0xc1, 0xea, 0x72, 0xda, 0x6a, 0xbb,
+	0xea, 0x65, 0x71, 0x2d, 0xfb, 0xbf, 0xb7, 0xd5, 0xb3, 0xd9, 0xe7, 0xaf, 0xa7, 0xc6, 0xd5, 0xbb,
+	0xcd, 0xce, 0x06, 0xdb, 0x9d, 0x0d, 0x7e, 0xee, 0x6c, 0xf0, 0x65, 0x6f, 0x1b, 0xdb, 0xbd, 0x6d,
+	0x7c, 0xdf, 0xdb, 0xc6, 0xfb, 0xcb, 0x44, 0xe8, 0xb4, 0x8e, 0x7c, 0x26, 0x73, 0xc2, 0xa4, 0xca,
+	0xa5, 0x22, 0x22, 0x62, 0x5e, 0x22, 0x49, 0xf3, 0x94, 0xe4, 0x32, 0xae, 0x33, 0xae, 0xfa, 0x1c,
+	0x78, 0x63, 0x10, 0x1e, 0x5f, 0x78, 0x5d, 0x16, 0xf4, 0xa7, 0x92, 0xab, 0x68, 0xde, 0x05, 0xe1,
+	0xc9, 0xef, 0x01, 0x00, 0x0f, 0xc2, 0x61, 0x40, 0xb3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -161,6 +201,36 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ApprovedChecksums) > 0 {
+		for iNdEx := len(m.ApprovedChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovedChecksums[iNdEx])
+			copy(dAtA[i:], m.ApprovedChecksums[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ApprovedChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedQueries) > 0 {
+		for iNdEx := len(m.AllowedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedQueries[iNdEx])
+			copy(dAtA[i:], m.AllowedQueries[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -198,6 +268,28 @@ func (m *GenesisContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CodeInfo != nil {
+		{
+			size, err := m.CodeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ContractCode) > 0 {
 		i -= len(m.ContractCode)
 		copy(dAtA[i:], m.ContractCode)
@@ -238,6 +330,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AllowedQueries) > 0 {
+		for _, s := range m.AllowedQueries {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ApprovedChecksums) > 0 {
+		for _, b := range m.ApprovedChecksums {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -255,6 +363,13 @@ func (m *GenesisContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Pinned {
+		n += 2
+	}
+	if m.CodeInfo != nil {
+		l = m.CodeInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -327,6 +442,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedQueries = append(m.AllowedQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedChecksums = append(m.ApprovedChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ApprovedChecksums[len(m.ApprovedChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -445,6 +660,62 @@ func (m *GenesisContract) Unmarshal(dAtA []byte) error {
 				m.ContractCode = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CodeInfo == nil {
+				m.CodeInfo = &CodeInfo{}
+			}
+			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
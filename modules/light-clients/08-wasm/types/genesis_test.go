@@ -1,15 +1,23 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"os"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	wasm "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
 )
 
 func (suite *WasmTestSuite) TestExportGenesisGrandpa() {
 	suite.SetupWithEmptyClient()
-	gm := suite.clientState.ExportMetadata(suite.store)
+	gm := suite.clientState.ExportMetadata(suite.ctx, suite.store)
 	suite.Require().NotNil(gm, "client returned nil")
 	suite.Require().Len(gm, 0, "exported metadata has unexpected length")
 }
@@ -33,7 +41,7 @@ func (suite *WasmTestSuite) TestExportMetadataTendermint() {
 	initProcessedHeight, found := GetProcessedHeight(clientStore, height)
 	suite.Require().True(found)
 
-	gm := clientState.ExportMetadata(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
+	gm := clientState.ExportMetadata(suite.clientContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
 	suite.Require().NotNil(gm, "client with metadata returned nil exported metadata")
 	suite.Require().Len(gm, 3, "exported metadata has unexpected length")
 
@@ -61,7 +69,7 @@ func (suite *WasmTestSuite) TestExportMetadataTendermint() {
 	processedHeight, found := GetProcessedHeight(clientStore, updateHeight)
 	suite.Require().True(found)
 
-	gm = clientState.ExportMetadata(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
+	gm = clientState.ExportMetadata(suite.clientContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID))
 	suite.Require().NotNil(gm, "client with metadata returned nil exported metadata")
 	suite.Require().Len(gm, 6, "exported metadata has unexpected length")
 
@@ -92,3 +100,256 @@ func (suite *WasmTestSuite) TestExportMetadataTendermint() {
 	suite.Require().Equal(ibctm.IterationKey(updateHeight), gm[5].GetKey(), "metadata has unexpected key")
 	suite.Require().Equal(iteration, gm[5].GetValue(), "metadata has unexpected value")
 }
+
+func (suite *WasmTestSuite) TestGenesisRoundTrip() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+
+	_, err := suite.wasmKeeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(signer, suite.codeID))
+	suite.Require().NoError(err)
+	_, err = suite.wasmKeeper.UpdateAllowedQueries(suite.ctx, wasmtypes.NewMsgUpdateAllowedQueries(signer, "/cosmos.staking.v1beta1.Query/Params"))
+	suite.Require().NoError(err)
+	approvedChecksum := sha256.Sum256([]byte("code"))
+	_, err = suite.wasmKeeper.ApproveChecksums(suite.ctx, wasmtypes.NewMsgApproveChecksums(signer, approvedChecksum[:]))
+	suite.Require().NoError(err)
+	params := wasmtypes.NewParams(wasmtypes.DefaultMaxWasmCodeSize/2, wasmtypes.DefaultGasRegisterConfig(), wasmtypes.DefaultUploadExpiryBlocks, suite.codeID)
+	_, err = suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(signer, params))
+	suite.Require().NoError(err)
+	clientID, err := app.IBCKeeper.ClientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+
+	gs := suite.wasmKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(gs.Validate())
+	suite.Require().Equal(&params, gs.Params)
+	suite.Require().Len(gs.Contracts, 1)
+	suite.Require().Equal(suite.codeID, gs.Contracts[0].CodeHash)
+	suite.Require().True(gs.Contracts[0].Pinned)
+	suite.Require().NotNil(gs.Contracts[0].CodeInfo)
+	suite.Require().Equal(signer, gs.Contracts[0].CodeInfo.Creator)
+	suite.Require().Equal([][]byte{approvedChecksum[:]}, gs.ApprovedChecksums)
+	suite.Require().NoError(wasm.AppModuleBasic{}.ValidateGenesis(app.AppCodec(), nil, app.AppCodec().MustMarshalJSON(&gs)))
+	suite.Require().Error(wasm.AppModuleBasic{}.ValidateGenesis(app.AppCodec(), nil, []byte(`{"contracts":[{"code_hash":"AA=="}]}`)))
+
+	// clear the 08-wasm store and import the exported genesis
+	suite.ctx, _ = suite.ctx.CacheContext()
+	store := suite.ctx.KVStore(app.GetKey(wasmtypes.StoreKey))
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	suite.Require().Empty(suite.clientsByCode(suite.codeID))
+
+	suite.Require().NoError(suite.wasmKeeper.InitGenesis(suite.ctx, gs))
+	reexported := suite.wasmKeeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(app.AppCodec().MustMarshalJSON(&gs), app.AppCodec().MustMarshalJSON(&reexported))
+	suite.Require().True(suite.wasmKeeper.IsPinnedCode(suite.ctx, suite.codeID))
+	suite.Require().True(suite.wasmKeeper.IsApprovedChecksum(suite.ctx, approvedChecksum[:]))
+	suite.Require().Equal([]string{clientID}, suite.clientsByCode(suite.codeID))
+	suite.Require().Equal(params, suite.wasmKeeper.GetParams(suite.ctx))
+
+	// gzipped codes are imported uncompressed
+	suite.ctx, _ = suite.ctx.CacheContext()
+	store = suite.ctx.KVStore(app.GetKey(wasmtypes.StoreKey))
+	store.Delete(wasmtypes.CodeID(suite.codeID))
+	compressedCode, err := wasmtypes.GzipIt(gs.Contracts[0].ContractCode)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.wasmKeeper.InitGenesis(suite.ctx, wasmtypes.GenesisState{
+		Contracts: []wasmtypes.GenesisContract{{CodeHash: suite.codeID, ContractCode: compressedCode}},
+	}))
+	suite.Require().Equal(gs.Contracts[0].ContractCode, store.Get(wasmtypes.CodeID(suite.codeID)))
+	info, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, suite.codeID)
+	suite.Require().True(found)
+	suite.Require().Equal(wasmtypes.CodeInfo{CodeId: suite.codeID, CodeSize: uint64(len(gs.Contracts[0].ContractCode)), AbiVersion: 1}, info)
+	suite.Require().Equal(wasmtypes.DefaultParams(), suite.wasmKeeper.GetParams(suite.ctx))
+
+	// clients must use a code of the genesis
+	suite.ctx, _ = suite.ctx.CacheContext()
+	store = suite.ctx.KVStore(app.GetKey(wasmtypes.StoreKey))
+	store.Delete(wasmtypes.CodeID(suite.codeID))
+	err = suite.wasmKeeper.InitGenesis(suite.ctx, wasmtypes.GenesisState{})
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+	suite.Require().ErrorContains(err, clientID)
+}
+
+func (suite *WasmTestSuite) TestGenesisRoundTripLoweredMaxWasmCodeSize() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+
+	// lower the max wasm code size below the size of the stored code
+	info, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, suite.codeID)
+	suite.Require().True(found)
+	params := wasmtypes.DefaultParams()
+	params.MaxWasmCodeSize = info.CodeSize - 1
+	_, err := suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(signer, params))
+	suite.Require().NoError(err)
+
+	gs := suite.wasmKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(gs.Validate())
+
+	// the code can still be imported
+	suite.ctx, _ = suite.ctx.CacheContext()
+	store := suite.ctx.KVStore(app.GetKey(wasmtypes.StoreKey))
+	store.Delete(wasmtypes.CodeID(suite.codeID))
+	suite.Require().NoError(suite.wasmKeeper.InitGenesis(suite.ctx, gs))
+	suite.Require().Equal(gs.Contracts[0].ContractCode, store.Get(wasmtypes.CodeID(suite.codeID)))
+	suite.Require().Equal(params, suite.wasmKeeper.GetParams(suite.ctx))
+
+	// but the code can no longer be uploaded
+	store.Delete(wasmtypes.CodeID(suite.codeID))
+	_, err = suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, gs.Contracts[0].ContractCode))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeValidation)
+}
+
+func (suite *WasmTestSuite) TestGenesisValidate() {
+	code, err := os.ReadFile("test_data/ics10_grandpa_cw.wasm.gz")
+	suite.Require().NoError(err)
+	uncompressedCode, err := wasmtypes.Uncompress(code, wasmtypes.DefaultMaxWasmCodeSize)
+	suite.Require().NoError(err)
+	checksum := sha256.Sum256(uncompressedCode)
+	codeID := checksum[:]
+
+	tooLargeCode := make([]byte, wasmtypes.MaxWasmSize+1)
+	tooLargeChecksum := sha256.Sum256(tooLargeCode)
+	smallCodeParams := wasmtypes.DefaultParams()
+	smallCodeParams.MaxWasmCodeSize = uint64(len(uncompressedCode) - 1)
+
+	testCases := []struct {
+		name     string
+		genesis  wasmtypes.GenesisState
+		expError error
+	}{
+		{
+			"valid genesis",
+			wasmtypes.GenesisState{
+				Contracts:      []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: uncompressedCode, Pinned: true}},
+				AllowedQueries: []string{"/cosmos.staking.v1beta1.Query/Params"},
+			},
+			nil,
+		},
+		{
+			"valid genesis with gzipped code",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code}}},
+			nil,
+		},
+		{
+			"empty genesis",
+			wasmtypes.GenesisState{},
+			nil,
+		},
+		{
+			"invalid code id",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: wasmtypes.CodeID(codeID), ContractCode: uncompressedCode}}},
+			wasmtypes.ErrInvalidCodeId,
+		},
+		{
+			"code id is not the checksum of the code",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: make([]byte, 32), ContractCode: uncompressedCode}}},
+			wasmtypes.ErrInvalidCodeId,
+		},
+		{
+			"duplicate code id",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: uncompressedCode}, {CodeHash: codeID, ContractCode: code}}},
+			wasmtypes.ErrWasmCodeExists,
+		},
+		{
+			"empty code",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID}}},
+			wasmtypes.ErrWasmEmptyCode,
+		},
+		{
+			"code too large",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: tooLargeChecksum[:], ContractCode: tooLargeCode}}},
+			wasmtypes.ErrWasmCodeTooLarge,
+		},
+		{
+			"invalid gzip",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code[:len(code)/2]}}},
+			wasmtypes.ErrWasmInvalidCode,
+		},
+		{
+			"valid genesis with code info",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code, CodeInfo: &wasmtypes.CodeInfo{CodeId: codeID, CodeSize: uint64(len(uncompressedCode))}}}},
+			nil,
+		},
+		{
+			"code info of another code",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code, CodeInfo: &wasmtypes.CodeInfo{CodeId: make([]byte, 32), CodeSize: uint64(len(uncompressedCode))}}}},
+			wasmtypes.ErrInvalidCodeInfo,
+		},
+		{
+			"code info with wrong code size",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code, CodeInfo: &wasmtypes.CodeInfo{CodeId: codeID, CodeSize: uint64(len(code))}}}},
+			wasmtypes.ErrInvalidCodeInfo,
+		},
+		{
+			"code info with wrong ABI version",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code, CodeInfo: &wasmtypes.CodeInfo{CodeId: codeID, CodeSize: uint64(len(uncompressedCode)), AbiVersion: 2}}}},
+			wasmtypes.ErrInvalidCodeInfo,
+		},
+		{
+			"invalid code info",
+			wasmtypes.GenesisState{Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code, CodeInfo: &wasmtypes.CodeInfo{CodeId: codeID, CodeSize: uint64(len(uncompressedCode)), UploadHeight: -1}}}},
+			wasmtypes.ErrInvalidCodeInfo,
+		},
+		{
+			"invalid allowed query",
+			wasmtypes.GenesisState{AllowedQueries: []string{"invalid"}},
+			wasmtypes.ErrInvalidAllowedQuery,
+		},
+		{
+			"valid approved checksum",
+			wasmtypes.GenesisState{ApprovedChecksums: [][]byte{make([]byte, 32)}},
+			nil,
+		},
+		{
+			"invalid approved checksum",
+			wasmtypes.GenesisState{ApprovedChecksums: [][]byte{{1}}},
+			wasmtypes.ErrInvalidCodeId,
+		},
+		{
+			"duplicate approved checksum",
+			wasmtypes.GenesisState{ApprovedChecksums: [][]byte{make([]byte, 32), make([]byte, 32)}},
+			wasmtypes.ErrInvalidCodeId,
+		},
+		{
+			"invalid params",
+			wasmtypes.GenesisState{Params: &wasmtypes.Params{}},
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"valid genesis with code exceeding the max wasm code size of the params",
+			wasmtypes.GenesisState{
+				Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: uncompressedCode}},
+				Params:    &smallCodeParams,
+			},
+			nil,
+		},
+		{
+			"approved checksum of a contract",
+			wasmtypes.GenesisState{
+				Contracts:         []wasmtypes.GenesisContract{{CodeHash: codeID, ContractCode: code}},
+				ApprovedChecksums: [][]byte{codeID},
+			},
+			wasmtypes.ErrWasmCodeExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.genesis.Validate()
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
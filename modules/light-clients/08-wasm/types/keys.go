@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -14,10 +16,92 @@ const (
 	StoreKey = ModuleName
 
 	LastInstanceIDKey = "lastInstanceId"
+
+	// NextUploadIDKey is the key under which the identifier of the next upload session is stored
+	NextUploadIDKey = "next_upload_id"
+
+	// ParamsKey is the key under which the parameters of the module are stored
+	ParamsKey = "params"
+
+	// KeyContractABIVersion is the key of the client store under which the ABI version implemented
+	// by the contract of the client is stored, unless it is version 1
+	KeyContractABIVersion = "wasmContractABIVersion"
 )
 
-var PrefixCodeIDKey = []byte("code_id/")
+var (
+	PrefixCodeIDKey        = []byte("code_id/")
+	PrefixPinnedCodeKey    = []byte("pinned_code/")
+	PrefixCodeClientKey    = []byte("code_clients/")
+	PrefixClientCodeKey    = []byte("client_code/")
+	PrefixRemovedCode      = []byte("removed_code/")
+	PrefixAllowedQueryKey  = []byte("allowed_query/")
+	PrefixCodeInfoKey      = []byte("code_info/")
+	PrefixApprovedChecksum = []byte("approved_checksum/")
+	PrefixUploadExpiryKey  = []byte("upload_expiry/")
+)
 
 func CodeID(codeID []byte) []byte {
 	return []byte(fmt.Sprintf("code_id/%s", hex.EncodeToString(codeID)))
 }
+
+// PinnedCode returns the key under which the pinned status of the code is stored.
+func PinnedCode(codeID []byte) []byte {
+	return []byte(fmt.Sprintf("pinned_code/%s", hex.EncodeToString(codeID)))
+}
+
+// CodeClientsPrefix returns the prefix under which the identifiers of the clients using the
+// code are indexed.
+func CodeClientsPrefix(codeID []byte) []byte {
+	return []byte(fmt.Sprintf("code_clients/%s/", hex.EncodeToString(codeID)))
+}
+
+// CodeClient returns the key indexing that the client uses the code.
+func CodeClient(codeID []byte, clientID string) []byte {
+	return append(CodeClientsPrefix(codeID), clientID...)
+}
+
+// ClientCode returns the key under which the code id used by the client is stored.
+func ClientCode(clientID string) []byte {
+	return []byte(fmt.Sprintf("client_code/%s", clientID))
+}
+
+// RemovedCode returns the key marking the code for removal from the VM at the end of the block.
+func RemovedCode(codeID []byte) []byte {
+	return []byte(fmt.Sprintf("removed_code/%s", hex.EncodeToString(codeID)))
+}
+
+// AllowedQuery returns the key marking the chain query as allowed for contracts.
+func AllowedQuery(path string) []byte {
+	return []byte(fmt.Sprintf("allowed_query/%s", path))
+}
+
+// CodeInfoKey returns the key under which the metadata of the code is stored.
+func CodeInfoKey(codeID []byte) []byte {
+	return []byte(fmt.Sprintf("code_info/%s", hex.EncodeToString(codeID)))
+}
+
+// ApprovedChecksum returns the key marking the checksum as approved for upload.
+func ApprovedChecksum(checksum []byte) []byte {
+	return []byte(fmt.Sprintf("approved_checksum/%s", hex.EncodeToString(checksum)))
+}
+
+// Upload returns the key under which the upload session is stored.
+func Upload(uploadID uint64) []byte {
+	return []byte(fmt.Sprintf("upload/%d", uploadID))
+}
+
+// UploadChunk returns the key under which the chunk of the upload session is stored.
+func UploadChunk(uploadID uint64, index uint32) []byte {
+	return []byte(fmt.Sprintf("upload_chunk/%d/%d", uploadID, index))
+}
+
+// UploadExpiryPrefix returns the prefix under which the upload sessions expiring at the height are
+// indexed. The height is big endian encoded, so that the index is ordered by expiry height.
+func UploadExpiryPrefix(height int64) []byte {
+	return append(append([]byte{}, PrefixUploadExpiryKey...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// UploadExpiry returns the key indexing that the upload session expires at the height.
+func UploadExpiry(height int64, uploadID uint64) []byte {
+	return append(UploadExpiryPrefix(height), sdk.Uint64ToBigEndian(uploadID)...)
+}
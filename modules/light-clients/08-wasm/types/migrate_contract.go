@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/hex"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrateContract calls the migrate entry point of the code with the given id on the client
+// store, so that the new code can migrate the state written by the current code of the client.
+// The new code reports the ABI version it implements like on instantiation. The client state
+// stored in the client store is left to the caller to update. The migration is reverted if it
+// leaves an invalid client or consensus state.
+func (cs ClientState) MigrateContract(ctx sdk.Context, clientStore sdk.KVStore, newCodeID, migrateMsg []byte) error {
+	// the migration may rewrite the client state with either code id, the caller sets the new one
+	var response *wasmvmtypes.Response
+	if err := withValidatedWrites(clientStore, func(store trackingStore) (err error) {
+		response, err = migrateContract(newCodeID, ctx, store, migrateMsg)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrMigrateContractFailed, "migration from code id %s to %s failed: %s", hex.EncodeToString(cs.CodeId), hex.EncodeToString(newCodeID), err)
+		}
+		return nil
+	}, cs.CodeId, newCodeID); err != nil {
+		return err
+	}
+
+	version, err := contractABIVersion(ctx, newCodeID, response.Data)
+	if err != nil {
+		return err
+	}
+	setContractABIVersion(clientStore, version)
+	return nil
+}
@@ -505,7 +505,7 @@ func (suite *WasmTestSuite) TestVerifyMisbehaviourTendermint() {
 			clientState := path.EndpointA.GetClientState()
 			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
-			err = clientState.VerifyClientMessage(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, misbehaviour)
+			err = clientState.VerifyClientMessage(suite.clientContext(), suite.chainA.App.AppCodec(), clientStore, misbehaviour)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -845,7 +845,7 @@ func (suite *WasmTestSuite) TestCheckForMisbehaviourTendermint() {
 			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
 			foundMisbehaviour := clientState.CheckForMisbehaviour(
-				suite.chainA.GetContext(),
+				suite.clientContext(),
 				suite.chainA.App.AppCodec(),
 				clientStore, // pass in clientID prefixed clientStore
 				clientMessage,
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
+	"encoding/json"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +16,39 @@ var _ sdk.Msg = &MsgPushNewWasmCode{}
 var TypeMsgUpdateWasmCodeId = "update_wasm_code_id"
 var _ sdk.Msg = &MsgUpdateWasmCodeId{}
 
+var TypeMsgPinCodes = "pin_codes"
+var _ sdk.Msg = &MsgPinCodes{}
+
+var TypeMsgUnpinCodes = "unpin_codes"
+var _ sdk.Msg = &MsgUnpinCodes{}
+
+var TypeMsgRemoveWasmCode = "remove_wasm_code"
+var _ sdk.Msg = &MsgRemoveWasmCode{}
+
+var TypeMsgMigrateContract = "migrate_contract"
+var _ sdk.Msg = &MsgMigrateContract{}
+
+var TypeMsgUpdateAllowedQueries = "update_allowed_queries"
+var _ sdk.Msg = &MsgUpdateAllowedQueries{}
+
+var TypeMsgApproveChecksums = "approve_checksums"
+var _ sdk.Msg = &MsgApproveChecksums{}
+
+var TypeMsgRevokeChecksums = "revoke_checksums"
+var _ sdk.Msg = &MsgRevokeChecksums{}
+
+var TypeMsgBeginUpload = "begin_upload"
+var _ sdk.Msg = &MsgBeginUpload{}
+
+var TypeMsgUploadChunk = "upload_chunk"
+var _ sdk.Msg = &MsgUploadChunk{}
+
+var TypeMsgFinalizeUpload = "finalize_upload"
+var _ sdk.Msg = &MsgFinalizeUpload{}
+
+var TypeMsgUpdateParams = "update_params"
+var _ sdk.Msg = &MsgUpdateParams{}
+
 // NewMsgPushNewWasmCode creates a new MsgPushNewWasmCode instance
 //
 //nolint:interfacer
@@ -37,7 +73,7 @@ func (m MsgPushNewWasmCode) ValidateBasic() error {
 		)
 	}
 
-	return nil
+	return validateLabelAndVersion(m.Label, m.Version)
 }
 
 func (m MsgPushNewWasmCode) GetSigners() []sdk.AccAddress {
@@ -98,3 +134,411 @@ func (m MsgUpdateWasmCodeId) GetSigners() []sdk.AccAddress {
 func (msg MsgUpdateWasmCodeId) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// Route Implements Msg.
+func (msg MsgPinCodes) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgPinCodes) Type() string { return TypeMsgPinCodes }
+
+// NewMsgPinCodes creates a new MsgPinCodes instance
+func NewMsgPinCodes(signer string, codeIds ...[]byte) *MsgPinCodes {
+	return &MsgPinCodes{
+		Signer:  signer,
+		CodeIds: codeIds,
+	}
+}
+
+func (m MsgPinCodes) ValidateBasic() error {
+	return validateCodeIds(m.CodeIds)
+}
+
+func (m MsgPinCodes) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgPinCodes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgUnpinCodes) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgUnpinCodes) Type() string { return TypeMsgUnpinCodes }
+
+// NewMsgUnpinCodes creates a new MsgUnpinCodes instance
+func NewMsgUnpinCodes(signer string, codeIds ...[]byte) *MsgUnpinCodes {
+	return &MsgUnpinCodes{
+		Signer:  signer,
+		CodeIds: codeIds,
+	}
+}
+
+func (m MsgUnpinCodes) ValidateBasic() error {
+	return validateCodeIds(m.CodeIds)
+}
+
+func (m MsgUnpinCodes) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUnpinCodes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveWasmCode) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgRemoveWasmCode) Type() string { return TypeMsgRemoveWasmCode }
+
+// NewMsgRemoveWasmCode creates a new MsgRemoveWasmCode instance
+func NewMsgRemoveWasmCode(signer string, codeId []byte) *MsgRemoveWasmCode {
+	return &MsgRemoveWasmCode{
+		Signer: signer,
+		CodeId: codeId,
+	}
+}
+
+func (m MsgRemoveWasmCode) ValidateBasic() error {
+	return validateCodeIds([][]byte{m.CodeId})
+}
+
+func (m MsgRemoveWasmCode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveWasmCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgMigrateContract) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgMigrateContract) Type() string { return TypeMsgMigrateContract }
+
+// NewMsgMigrateContract creates a new MsgMigrateContract instance
+func NewMsgMigrateContract(signer, clientId string, codeId, migrateMsg []byte) *MsgMigrateContract {
+	return &MsgMigrateContract{
+		Signer:   signer,
+		ClientId: clientId,
+		CodeId:   codeId,
+		Msg:      migrateMsg,
+	}
+}
+
+func (m MsgMigrateContract) ValidateBasic() error {
+	if err := validateCodeIds([][]byte{m.CodeId}); err != nil {
+		return err
+	}
+
+	if err := host.ClientIdentifierValidator(m.ClientId); err != nil {
+		return err
+	}
+
+	if !json.Valid(m.Msg) {
+		return sdkerrors.Wrap(ErrInvalidData, "migrate msg must be valid json")
+	}
+
+	return nil
+}
+
+func (m MsgMigrateContract) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgMigrateContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateAllowedQueries) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgUpdateAllowedQueries) Type() string { return TypeMsgUpdateAllowedQueries }
+
+// NewMsgUpdateAllowedQueries creates a new MsgUpdateAllowedQueries instance
+func NewMsgUpdateAllowedQueries(signer string, allowedQueries ...string) *MsgUpdateAllowedQueries {
+	return &MsgUpdateAllowedQueries{
+		Signer:         signer,
+		AllowedQueries: allowedQueries,
+	}
+}
+
+// ValidateBasic validates the query paths. An empty list is valid and disallows all queries.
+func (m MsgUpdateAllowedQueries) ValidateBasic() error {
+	return ValidateAllowedQueries(m.AllowedQueries)
+}
+
+func (m MsgUpdateAllowedQueries) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateAllowedQueries) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic validates the parameters.
+func (m MsgUpdateParams) ValidateBasic() error {
+	return m.Params.Validate()
+}
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgApproveChecksums) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgApproveChecksums) Type() string { return TypeMsgApproveChecksums }
+
+// NewMsgApproveChecksums creates a new MsgApproveChecksums instance
+func NewMsgApproveChecksums(signer string, checksums ...[]byte) *MsgApproveChecksums {
+	return &MsgApproveChecksums{
+		Signer:    signer,
+		Checksums: checksums,
+	}
+}
+
+func (m MsgApproveChecksums) ValidateBasic() error {
+	return ValidateChecksums(m.Checksums)
+}
+
+func (m MsgApproveChecksums) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgApproveChecksums) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeChecksums) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgRevokeChecksums) Type() string { return TypeMsgRevokeChecksums }
+
+// NewMsgRevokeChecksums creates a new MsgRevokeChecksums instance
+func NewMsgRevokeChecksums(signer string, checksums ...[]byte) *MsgRevokeChecksums {
+	return &MsgRevokeChecksums{
+		Signer:    signer,
+		Checksums: checksums,
+	}
+}
+
+func (m MsgRevokeChecksums) ValidateBasic() error {
+	return ValidateChecksums(m.Checksums)
+}
+
+func (m MsgRevokeChecksums) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRevokeChecksums) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgBeginUpload) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgBeginUpload) Type() string { return TypeMsgBeginUpload }
+
+// NewMsgBeginUpload creates a new MsgBeginUpload instance
+func NewMsgBeginUpload(signer string, checksum []byte, size uint64) *MsgBeginUpload {
+	return &MsgBeginUpload{
+		Signer:   signer,
+		Checksum: checksum,
+		CodeSize: size,
+	}
+}
+
+func (m MsgBeginUpload) ValidateBasic() error {
+	if err := validateCodeIds([][]byte{m.Checksum}); err != nil {
+		return err
+	}
+
+	if m.CodeSize == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpload, "upload size cannot be zero")
+	}
+
+	return validateLabelAndVersion(m.Label, m.Version)
+}
+
+func (m MsgBeginUpload) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgBeginUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgUploadChunk) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgUploadChunk) Type() string { return TypeMsgUploadChunk }
+
+// NewMsgUploadChunk creates a new MsgUploadChunk instance
+func NewMsgUploadChunk(signer string, uploadID uint64, index uint32, chunk []byte) *MsgUploadChunk {
+	return &MsgUploadChunk{
+		Signer:   signer,
+		UploadId: uploadID,
+		Index:    index,
+		Chunk:    chunk,
+	}
+}
+
+func (m MsgUploadChunk) ValidateBasic() error {
+	if len(m.Chunk) == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpload, "empty chunk")
+	}
+
+	return nil
+}
+
+func (m MsgUploadChunk) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUploadChunk) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Route Implements Msg.
+func (msg MsgFinalizeUpload) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgFinalizeUpload) Type() string { return TypeMsgFinalizeUpload }
+
+// NewMsgFinalizeUpload creates a new MsgFinalizeUpload instance
+func NewMsgFinalizeUpload(signer string, uploadID uint64) *MsgFinalizeUpload {
+	return &MsgFinalizeUpload{
+		Signer:   signer,
+		UploadId: uploadID,
+	}
+}
+
+func (m MsgFinalizeUpload) ValidateBasic() error {
+	return nil
+}
+
+func (m MsgFinalizeUpload) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgFinalizeUpload) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateChecksums checks that at least one checksum is given and that the checksums are
+// distinct sha256 checksums.
+func ValidateChecksums(checksums [][]byte) error {
+	if err := validateCodeIds(checksums); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(checksums))
+	for _, checksum := range checksums {
+		if seen[string(checksum)] {
+			return sdkerrors.Wrapf(ErrInvalidCodeId, "duplicate checksum %s", hex.EncodeToString(checksum))
+		}
+		seen[string(checksum)] = true
+	}
+
+	return nil
+}
+
+// validateCodeIds checks that at least one code id is given and that all code ids are
+// sha256 checksums.
+func validateCodeIds(codeIds [][]byte) error {
+	if len(codeIds) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCodeId, "code ids cannot be empty")
+	}
+
+	for _, codeId := range codeIds {
+		if len(codeId) != tmhash.Size {
+			return sdkerrors.Wrapf(ErrInvalidCodeId,
+				"invalid code id length (expected %d, got %d)", tmhash.Size, len(codeId),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxWasmCodeSize is the default maximum size in bytes of wasm code, once uncompressed.
+const DefaultMaxWasmCodeSize = 3 * 1024 * 1024
+
+// MaxWasmSize is the hard limit on the size in bytes of wasm code, once uncompressed, which the
+// max wasm code size parameter cannot exceed. The parameter only applies to new uploads: the codes
+// already on chain are checked against the hard limit when they are restored from a snapshot or
+// imported from genesis, so that lowering the parameter does not prevent nodes from joining.
+const MaxWasmSize = 16 * 1024 * 1024
+
+// DefaultUploadExpiryBlocks is the default number of blocks after which an unfinished upload
+// session expires.
+const DefaultUploadExpiryBlocks int64 = 100
+
+// NewParams creates a new Params instance.
+func NewParams(maxWasmCodeSize uint64, gasRegisterConfig WasmGasRegisterConfig, uploadExpiryBlocks int64, allowedCodeIDs ...[]byte) Params {
+	return Params{
+		MaxWasmCodeSize:    maxWasmCodeSize,
+		GasRegisterConfig:  NewGasRegisterConfig(gasRegisterConfig),
+		AllowedCodeIds:     allowedCodeIDs,
+		UploadExpiryBlocks: uploadExpiryBlocks,
+	}
+}
+
+// DefaultParams returns the default parameters, which allow new clients to use any stored code.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxWasmCodeSize, DefaultGasRegisterConfig(), DefaultUploadExpiryBlocks)
+}
+
+// Validate checks that the maximum code size is positive and does not exceed MaxWasmSize, that the
+// gas multiplier is positive, that the uncompress cost is a valid fraction, that the upload expiry
+// is positive and that the allowed code ids are unique checksums.
+func (p Params) Validate() error {
+	if p.MaxWasmCodeSize == 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "max wasm code size cannot be zero")
+	}
+	if p.MaxWasmCodeSize > MaxWasmSize {
+		return sdkerrors.Wrapf(ErrInvalidParams, "max wasm code size %d exceeds the hard limit %d", p.MaxWasmCodeSize, MaxWasmSize)
+	}
+	if p.GasRegisterConfig.GasMultiplier == 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "gas multiplier cannot be zero")
+	}
+	if p.GasRegisterConfig.UncompressCostDenominator == 0 {
+		return sdkerrors.Wrap(ErrInvalidParams, "uncompress cost denominator cannot be zero")
+	}
+	if p.UploadExpiryBlocks <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidParams, "upload expiry must be positive, got %d", p.UploadExpiryBlocks)
+	}
+
+	if len(p.AllowedCodeIds) == 0 {
+		return nil
+	}
+	if err := validateCodeIds(p.AllowedCodeIds); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(p.AllowedCodeIds))
+	for _, codeID := range p.AllowedCodeIds {
+		if seen[string(codeID)] {
+			return sdkerrors.Wrapf(ErrInvalidParams, "duplicate allowed code id %s", hex.EncodeToString(codeID))
+		}
+		seen[string(codeID)] = true
+	}
+
+	return nil
+}
+
+// GasRegister returns the gas register charging the configured gas costs.
+func (p Params) GasRegister() WasmGasRegister {
+	return NewWasmGasRegister(p.GasRegisterConfig.WasmGasRegisterConfig())
+}
+
+// IsAllowedCodeID returns true if new clients may use the code with the given code id, which is
+// the case for any code if no code id is allowed explicitly.
+func (p Params) IsAllowedCodeID(codeID []byte) bool {
+	if len(p.AllowedCodeIds) == 0 {
+		return true
+	}
+	for _, allowedCodeID := range p.AllowedCodeIds {
+		if bytes.Equal(allowedCodeID, codeID) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewGasRegisterConfig returns the parameters holding the given gas register config.
+func NewGasRegisterConfig(c WasmGasRegisterConfig) GasRegisterConfig {
+	return GasRegisterConfig{
+		InstanceCost:              c.InstanceCost,
+		CompileCost:               c.CompileCost,
+		UncompressCostNumerator:   c.UncompressCost.Numerator,
+		UncompressCostDenominator: c.UncompressCost.Denominator,
+		GasMultiplier:             c.GasMultiplier,
+		ContractMessageDataCost:   c.ContractMessageDataCost,
+	}
+}
+
+// WasmGasRegisterConfig returns the gas register config held by the parameters.
+func (c GasRegisterConfig) WasmGasRegisterConfig() WasmGasRegisterConfig {
+	return WasmGasRegisterConfig{
+		InstanceCost: c.InstanceCost,
+		CompileCost:  c.CompileCost,
+		UncompressCost: wasmvmtypes.UFraction{
+			Numerator:   c.UncompressCostNumerator,
+			Denominator: c.UncompressCostDenominator,
+		},
+		GasMultiplier:           c.GasMultiplier,
+		ContractMessageDataCost: c.ContractMessageDataCost,
+	}
+}
+
+// ParamsGetter returns the parameters of the 08-wasm module in the given context.
+type ParamsGetter func(ctx sdk.Context) Params
+
+type paramsGetterContextKey struct{}
+
+// WithParamsGetter returns a copy of the context which carries the given parameters getter. The
+// parameters are only read when a contract is called.
+func WithParamsGetter(ctx sdk.Context, getter ParamsGetter) sdk.Context {
+	return ctx.WithValue(paramsGetterContextKey{}, getter)
+}
+
+// paramsFromContext returns the parameters read by the getter carried by the context. If the
+// context does not carry a getter, the default parameters are returned.
+func paramsFromContext(ctx sdk.Context) Params {
+	getter, ok := ctx.Value(paramsGetterContextKey{}).(ParamsGetter)
+	if !ok || getter == nil {
+		return DefaultParams()
+	}
+
+	return getter(ctx)
+}
@@ -0,0 +1,806 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/wasm/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Parameters of the 08-wasm module, which are updated by the authority
+type Params struct {
+	// the maximum size in bytes of newly uploaded wasm code, once uncompressed
+	MaxWasmCodeSize uint64 `protobuf:"varint,1,opt,name=max_wasm_code_size,json=maxWasmCodeSize,proto3" json:"max_wasm_code_size,omitempty"`
+	// the gas costs charged for the wasm VM
+	GasRegisterConfig GasRegisterConfig `protobuf:"bytes,2,opt,name=gas_register_config,json=gasRegisterConfig,proto3" json:"gas_register_config"`
+	// the code ids which new clients may use, any stored code may be used if empty
+	AllowedCodeIds [][]byte `protobuf:"bytes,3,rep,name=allowed_code_ids,json=allowedCodeIds,proto3" json:"allowed_code_ids,omitempty"`
+	// the number of blocks after which an unfinished upload session expires
+	UploadExpiryBlocks int64 `protobuf:"varint,4,opt,name=upload_expiry_blocks,json=uploadExpiryBlocks,proto3" json:"upload_expiry_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_716edbdc01d381e5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxWasmCodeSize() uint64 {
+	if m != nil {
+		return m.MaxWasmCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetGasRegisterConfig() GasRegisterConfig {
+	if m != nil {
+		return m.GasRegisterConfig
+	}
+	return GasRegisterConfig{}
+}
+
+func (m *Params) GetAllowedCodeIds() [][]byte {
+	if m != nil {
+		return m.AllowedCodeIds
+	}
+	return nil
+}
+
+func (m *Params) GetUploadExpiryBlocks() int64 {
+	if m != nil {
+		return m.UploadExpiryBlocks
+	}
+	return 0
+}
+
+// Gas costs charged for the wasm VM
+type GasRegisterConfig struct {
+	// the SDK gas charged each time a contract instance is loaded
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty"`
+	// the SDK gas charged per byte of compiled wasm code
+	CompileCost uint64 `protobuf:"varint,2,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty"`
+	// the numerator of the SDK gas charged per byte of gzip compressed wasm code to uncompress it
+	UncompressCostNumerator uint64 `protobuf:"varint,3,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty"`
+	// the denominator of the SDK gas charged per byte of gzip compressed wasm code to uncompress it
+	UncompressCostDenominator uint64 `protobuf:"varint,4,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty"`
+	// the number of wasm VM gas points per SDK gas point
+	GasMultiplier uint64 `protobuf:"varint,5,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty"`
+	// the SDK gas charged per byte of the messages sent to contracts
+	ContractMessageDataCost uint64 `protobuf:"varint,6,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty"`
+}
+
+func (m *GasRegisterConfig) Reset()         { *m = GasRegisterConfig{} }
+func (m *GasRegisterConfig) String() string { return proto.CompactTextString(m) }
+func (*GasRegisterConfig) ProtoMessage()    {}
+func (*GasRegisterConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_716edbdc01d381e5, []int{1}
+}
+func (m *GasRegisterConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasRegisterConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRegisterConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasRegisterConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRegisterConfig.Merge(m, src)
+}
+func (m *GasRegisterConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasRegisterConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRegisterConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRegisterConfig proto.InternalMessageInfo
+
+func (m *GasRegisterConfig) GetInstanceCost() uint64 {
+	if m != nil {
+		return m.InstanceCost
+	}
+	return 0
+}
+
+func (m *GasRegisterConfig) GetCompileCost() uint64 {
+	if m != nil {
+		return m.CompileCost
+	}
+	return 0
+}
+
+func (m *GasRegisterConfig) GetUncompressCostNumerator() uint64 {
+	if m != nil {
+		return m.UncompressCostNumerator
+	}
+	return 0
+}
+
+func (m *GasRegisterConfig) GetUncompressCostDenominator() uint64 {
+	if m != nil {
+		return m.UncompressCostDenominator
+	}
+	return 0
+}
+
+func (m *GasRegisterConfig) GetGasMultiplier() uint64 {
+	if m != nil {
+		return m.GasMultiplier
+	}
+	return 0
+}
+
+func (m *GasRegisterConfig) GetContractMessageDataCost() uint64 {
+	if m != nil {
+		return m.ContractMessageDataCost
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.lightclients.wasm.v1.Params")
+	proto.RegisterType((*GasRegisterConfig)(nil), "ibc.lightclients.wasm.v1.GasRegisterConfig")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/params.proto", fileDescriptor_716edbdc01d381e5)
+}
+
+var fileDescriptor_716edbdc01d381e5 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x18, 0x85, 0xe3, 0x24, 0x64, 0x31, 0x4d, 0x0b, 0x1d, 0x2a, 0xe1, 0x16, 0xc9, 0x84, 0xa2, 0x4a,
+	0x91, 0xaa, 0xd8, 0x2d, 0x2c, 0x40, 0x20, 0x81, 0x94, 0x14, 0x21, 0x16, 0x45, 0x28, 0x2c, 0x10,
+	0x6c, 0x46, 0xe3, 0xf1, 0x30, 0x1d, 0xe1, 0xf1, 0x6f, 0xf9, 0x1f, 0xa7, 0x69, 0x4f, 0xc1, 0x7d,
+	0xb8, 0x40, 0x97, 0x5d, 0xb2, 0x42, 0x28, 0xb9, 0x02, 0x07, 0x40, 0x1e, 0x3b, 0x14, 0x8a, 0xd8,
+	0x59, 0xef, 0x7d, 0xcf, 0x7e, 0xbf, 0xfc, 0xc8, 0x9e, 0x8e, 0x45, 0x94, 0x6a, 0x75, 0x62, 0x45,
+	0xaa, 0x65, 0x66, 0x31, 0x3a, 0xe5, 0x68, 0xa2, 0xd9, 0x61, 0x94, 0xf3, 0x82, 0x1b, 0x0c, 0xf3,
+	0x02, 0x2c, 0x50, 0x5f, 0xc7, 0x22, 0xfc, 0x13, 0x0b, 0x2b, 0x2c, 0x9c, 0x1d, 0xee, 0x6c, 0x29,
+	0x50, 0xe0, 0xa0, 0xa8, 0x7a, 0xaa, 0xf9, 0xdd, 0x9f, 0x1e, 0xe9, 0xbd, 0x75, 0x2f, 0xa0, 0xfb,
+	0x84, 0x1a, 0x3e, 0x67, 0x15, 0xcf, 0x04, 0x24, 0x92, 0xa1, 0x3e, 0x97, 0xbe, 0x37, 0xf0, 0x86,
+	0xdd, 0xe9, 0x4d, 0xc3, 0xe7, 0xef, 0x39, 0x9a, 0x09, 0x24, 0xf2, 0x9d, 0x3e, 0x97, 0x94, 0x93,
+	0xdb, 0x8a, 0x23, 0x2b, 0xa4, 0xd2, 0x68, 0x65, 0xc1, 0x04, 0x64, 0x9f, 0xb4, 0xf2, 0xdb, 0x03,
+	0x6f, 0xb8, 0xf6, 0x70, 0x3f, 0xfc, 0x5f, 0x8b, 0xf0, 0x15, 0xc7, 0x69, 0x93, 0x99, 0xb8, 0xc8,
+	0xb8, 0x7b, 0xf1, 0xfd, 0x5e, 0x6b, 0xba, 0xa9, 0xae, 0x1b, 0x74, 0x48, 0x6e, 0xf1, 0x34, 0x85,
+	0x53, 0x99, 0xd4, 0x75, 0x74, 0x82, 0x7e, 0x67, 0xd0, 0x19, 0xf6, 0xa7, 0x1b, 0x8d, 0x5e, 0xb5,
+	0x79, 0x9d, 0x20, 0x3d, 0x20, 0x5b, 0x65, 0x9e, 0x02, 0x4f, 0x98, 0x9c, 0xe7, 0xba, 0x38, 0x63,
+	0x71, 0x0a, 0xe2, 0x33, 0xfa, 0xdd, 0x81, 0x37, 0xec, 0x4c, 0x69, 0xed, 0xbd, 0x74, 0xd6, 0xd8,
+	0x39, 0xbb, 0x5f, 0xdb, 0x64, 0xf3, 0x9f, 0x2a, 0xf4, 0x01, 0x59, 0xd7, 0x19, 0x5a, 0x9e, 0x09,
+	0xc9, 0x04, 0xa0, 0x6d, 0x8e, 0xef, 0xaf, 0xc4, 0x09, 0xa0, 0xa5, 0xf7, 0x49, 0x5f, 0x80, 0xc9,
+	0x75, 0xda, 0x30, 0x6d, 0xc7, 0xac, 0x35, 0x9a, 0x43, 0x9e, 0x92, 0xed, 0x32, 0xab, 0x84, 0x42,
+	0x22, 0x3a, 0x8a, 0x65, 0xa5, 0x91, 0x05, 0xb7, 0x50, 0xf8, 0x1d, 0xc7, 0xdf, 0xb9, 0x02, 0xaa,
+	0xc8, 0x9b, 0x95, 0x4d, 0x9f, 0x93, 0xbb, 0xd7, 0xb3, 0x89, 0xcc, 0xc0, 0xe8, 0xcc, 0xa5, 0xbb,
+	0x2e, 0xbd, 0xfd, 0x77, 0xfa, 0xe8, 0x0a, 0xa0, 0x7b, 0x64, 0xa3, 0xfa, 0x31, 0xa6, 0x4c, 0xad,
+	0xce, 0x53, 0x2d, 0x0b, 0xff, 0x86, 0x8b, 0xac, 0x2b, 0x8e, 0xc7, 0xbf, 0x45, 0xfa, 0x8c, 0xec,
+	0x08, 0xc8, 0x6c, 0xc1, 0x85, 0x65, 0x46, 0x22, 0x72, 0x25, 0x59, 0xc2, 0x2d, 0xaf, 0x6f, 0xea,
+	0xd5, 0x1d, 0x57, 0xc4, 0x71, 0x0d, 0x1c, 0x71, 0xcb, 0xab, 0xcf, 0x8d, 0x3f, 0x5c, 0x2c, 0x02,
+	0xef, 0x72, 0x11, 0x78, 0x3f, 0x16, 0x81, 0xf7, 0x65, 0x19, 0xb4, 0x2e, 0x97, 0x41, 0xeb, 0xdb,
+	0x32, 0x68, 0x7d, 0x7c, 0xa1, 0xb4, 0x3d, 0x29, 0xe3, 0x50, 0x80, 0x89, 0x04, 0xa0, 0x01, 0x8c,
+	0x74, 0x2c, 0x46, 0x0a, 0xa2, 0xd9, 0xe3, 0xc8, 0x40, 0x52, 0xa6, 0x12, 0xeb, 0x15, 0x8f, 0x56,
+	0x33, 0x3e, 0x78, 0x32, 0x72, 0x4b, 0xb6, 0x67, 0xb9, 0xc4, 0xb8, 0xe7, 0x66, 0xf9, 0xe8, 0xd7,
+	0x00, 0xf4, 0xc5, 0x2b, 0x38, 0xef, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UploadExpiryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UploadExpiryBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedCodeIds) > 0 {
+		for iNdEx := len(m.AllowedCodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCodeIds[iNdEx])
+			copy(dAtA[i:], m.AllowedCodeIds[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedCodeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.GasRegisterConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxWasmCodeSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWasmCodeSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasRegisterConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasRegisterConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasRegisterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContractMessageDataCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxWasmCodeSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxWasmCodeSize))
+	}
+	l = m.GasRegisterConfig.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedCodeIds) > 0 {
+		for _, b := range m.AllowedCodeIds {
+			l = len(b)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.UploadExpiryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.UploadExpiryBlocks))
+	}
+	return n
+}
+
+func (m *GasRegisterConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovParams(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovParams(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovParams(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovParams(uint64(m.UncompressCostDenominator))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovParams(uint64(m.GasMultiplier))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovParams(uint64(m.ContractMessageDataCost))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmCodeSize", wireType)
+			}
+			m.MaxWasmCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWasmCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRegisterConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRegisterConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCodeIds = append(m.AllowedCodeIds, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedCodeIds[len(m.AllowedCodeIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadExpiryBlocks", wireType)
+			}
+			m.UploadExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadExpiryBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasRegisterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRegisterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRegisterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
+			}
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
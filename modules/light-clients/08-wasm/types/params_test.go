@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+func (suite *WasmTestSuite) TestParamsValidate() {
+	codeID := sha256.Sum256([]byte("code"))
+
+	testCases := []struct {
+		name     string
+		malleate func(params *wasmtypes.Params)
+		expError error
+	}{
+		{
+			"default params",
+			func(*wasmtypes.Params) {},
+			nil,
+		},
+		{
+			"allowed code ids",
+			func(params *wasmtypes.Params) { params.AllowedCodeIds = [][]byte{codeID[:]} },
+			nil,
+		},
+		{
+			"zero max wasm code size",
+			func(params *wasmtypes.Params) { params.MaxWasmCodeSize = 0 },
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"max wasm code size exceeding the hard limit",
+			func(params *wasmtypes.Params) { params.MaxWasmCodeSize = wasmtypes.MaxWasmSize + 1 },
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"zero gas multiplier",
+			func(params *wasmtypes.Params) { params.GasRegisterConfig.GasMultiplier = 0 },
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"zero uncompress cost denominator",
+			func(params *wasmtypes.Params) { params.GasRegisterConfig.UncompressCostDenominator = 0 },
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"zero upload expiry",
+			func(params *wasmtypes.Params) { params.UploadExpiryBlocks = 0 },
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"negative upload expiry",
+			func(params *wasmtypes.Params) { params.UploadExpiryBlocks = -1 },
+			wasmtypes.ErrInvalidParams,
+		},
+		{
+			"invalid allowed code id",
+			func(params *wasmtypes.Params) { params.AllowedCodeIds = [][]byte{{1}} },
+			wasmtypes.ErrInvalidCodeId,
+		},
+		{
+			"duplicate allowed code id",
+			func(params *wasmtypes.Params) { params.AllowedCodeIds = [][]byte{codeID[:], codeID[:]} },
+			wasmtypes.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := wasmtypes.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+
+	// the gas register config round-trips
+	suite.Require().Equal(wasmtypes.DefaultGasRegisterConfig(), wasmtypes.DefaultParams().GasRegisterConfig.WasmGasRegisterConfig())
+}
+
+func (suite *WasmTestSuite) TestUpdateParams() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.Require().Equal(wasmtypes.DefaultParams(), suite.wasmKeeper.GetParams(suite.ctx))
+
+	params := wasmtypes.NewParams(wasmtypes.DefaultMaxWasmCodeSize*2, wasmtypes.DefaultGasRegisterConfig(), wasmtypes.DefaultUploadExpiryBlocks, suite.codeID)
+
+	// test invalid signer and params
+	_, err := suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(suite.chainA.SenderAccount.GetAddress().String(), params))
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(signer, wasmtypes.Params{}))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidParams)
+	suite.Require().ErrorIs(wasmtypes.NewMsgUpdateParams(signer, wasmtypes.Params{}).ValidateBasic(), wasmtypes.ErrInvalidParams)
+	suite.Require().Equal(wasmtypes.DefaultParams(), suite.wasmKeeper.GetParams(suite.ctx))
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.wasmKeeper.UpdateParams(ctx, wasmtypes.NewMsgUpdateParams(signer, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.wasmKeeper.GetParams(suite.ctx))
+
+	event := ctx.EventManager().Events()[0]
+	suite.Require().Equal(clienttypes.EventTypeUpdateWasmParams, event.Type)
+	suite.Require().Equal([]abci.EventAttribute{
+		{Key: clienttypes.AttributeKeyMaxWasmCodeSize, Value: "6291456"},
+		{Key: clienttypes.AttributeKeyAllowedCodeIDs, Value: hex.EncodeToString(suite.codeID)},
+	}, event.Attributes)
+
+	// test query
+	res, err := suite.wasmKeeper.Params(suite.ctx, &wasmtypes.ParamsQuery{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+	_, err = suite.wasmKeeper.Params(suite.ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *WasmTestSuite) TestParamsAllowedCodeIDs() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	otherCodeID := sha256.Sum256([]byte("code"))
+
+	// new clients cannot use a code which is not allowed
+	params := wasmtypes.NewParams(wasmtypes.DefaultMaxWasmCodeSize, wasmtypes.DefaultGasRegisterConfig(), wasmtypes.DefaultUploadExpiryBlocks, otherCodeID[:])
+	_, err := suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(signer, params))
+	suite.Require().NoError(err)
+	_, err = clientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeNotAllowed)
+
+	params.AllowedCodeIds = append(params.AllowedCodeIds, suite.codeID)
+	_, err = suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(signer, params))
+	suite.Require().NoError(err)
+	_, err = clientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+}
+
+func (suite *WasmTestSuite) TestParamsGasRegisterAndCodeSize() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, "", nil, keeper.WithWasmEngine(wasmtesting.NewMockWasmEngine(nil)))
+	code := wasmtesting.WasmModule("instantiate", "execute", "query")
+
+	pushCode := func(params wasmtypes.Params) (sdk.Gas, error) {
+		ctx, _ := suite.ctx.CacheContext()
+		suite.Require().NoError(k.SetParams(ctx, params))
+
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := k.PushNewWasmCode(ctx, wasmtypes.NewMsgPushNewWasmCode(signer, code))
+		return ctx.GasMeter().GasConsumed(), err
+	}
+
+	// the compile cost is charged per byte of code
+	params := wasmtypes.DefaultParams()
+	gasUsed, err := pushCode(params)
+	suite.Require().NoError(err)
+
+	params.GasRegisterConfig.CompileCost++
+	increasedGasUsed, err := pushCode(params)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gasUsed+uint64(len(code)), increasedGasUsed)
+
+	// the code cannot exceed the max wasm code size
+	params.MaxWasmCodeSize = uint64(len(code) - 1)
+	_, err = pushCode(params)
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeValidation)
+}
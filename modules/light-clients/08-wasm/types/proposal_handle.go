@@ -9,12 +9,6 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-type checkSubstituteAndUpdateStatePayload struct {
-	CheckSubstituteAndUpdateState CheckSubstituteAndUpdateStatePayload `json:"check_substitute_and_update_state"`
-}
-
-type CheckSubstituteAndUpdateStatePayload struct{}
-
 func (c ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, _ codec.BinaryCodec, subjectClientStore,
 	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
@@ -32,16 +26,22 @@ func (c ClientState) CheckSubstituteAndUpdateState(
 		)
 	}
 
-	store := NewWrappedStore(subjectClientStore, substituteClientStore, SubjectPrefix, SubstitutePrefix)
-
-	payload := checkSubstituteAndUpdateStatePayload{
-		CheckSubstituteAndUpdateState: CheckSubstituteAndUpdateStatePayload{},
+	msg := SudoMsg{
+		CheckSubstituteAndUpdateState: &CheckSubstituteAndUpdateStateMsg{},
 	}
 
-	_, err := call[contractResult](payload, &c, ctx, store)
-	if err != nil {
-		return err
-	}
+	// the writes to the subject client store are validated like for the other calls
+	return withValidatedWrites(subjectClientStore, func(subjectStore trackingStore) error {
+		// the ABI version is read from the subject client store, as the wrapped store reads keys
+		// without prefix from the substitute client store for version 1
+		version := getContractABIVersion(subjectStore)
 
-	return nil
+		store := NewWrappedStore(subjectStore, substituteClientStore, SubjectPrefix, SubstitutePrefix)
+		if version == 1 {
+			store = newLegacyWrappedStore(subjectStore, substituteClientStore, SubjectPrefix, SubstitutePrefix)
+		}
+
+		_, err := callWithABIVersion[contractResult](msg, &c, ctx, store, version)
+		return err
+	}, c.CodeId)
 }
@@ -157,7 +157,7 @@ func (suite *WasmTestSuite) TestCheckSubstituteUpdateStateBasicTendermint() {
 			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
 			substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
 
-			err = subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState)
+			err = subjectClientState.CheckSubstituteAndUpdateState(suite.clientContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState)
 			suite.Require().Error(err)
 		})
 	}
@@ -258,7 +258,7 @@ func (suite *WasmTestSuite) TestCheckSubstituteAndUpdateStateTendermint() {
 			suite.Require().True(found)
 			expectedIterationKey := ibctm.GetIterationKey(substituteClientStore, substituteWasmClientState.GetLatestHeight())
 
-			err = subjectWasmClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteWasmClientState)
+			err = subjectWasmClientState.CheckSubstituteAndUpdateState(suite.clientContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteWasmClientState)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
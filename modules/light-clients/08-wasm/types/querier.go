@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// QueryRouter routes the chain queries made by contracts to the gRPC query services of the
+// chain. It is implemented by the gRPC query router of baseapp.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
+
+// QuerierFactory creates the querier passed to contracts called with the given context.
+type QuerierFactory func(ctx sdk.Context) cosmwasm.Querier
+
+type querierFactoryContextKey struct{}
+
+// WithQuerierFactory returns a copy of the context which carries the given querier factory.
+func WithQuerierFactory(ctx sdk.Context, factory QuerierFactory) sdk.Context {
+	return ctx.WithValue(querierFactoryContextKey{}, factory)
+}
+
+// querierFromContext returns the querier created by the factory carried by the context. If the
+// context does not carry a factory, a querier rejecting all queries is returned.
+func querierFromContext(ctx sdk.Context) cosmwasm.Querier {
+	factory, ok := ctx.Value(querierFactoryContextKey{}).(QuerierFactory)
+	if !ok || factory == nil {
+		return rejectingQuerier{}
+	}
+
+	return factory(ctx)
+}
+
+// rejectingQuerier is a querier which rejects all queries.
+type rejectingQuerier struct{}
+
+func (rejectingQuerier) Query(_ wasmvmtypes.QueryRequest, _ uint64) ([]byte, error) {
+	return nil, wasmvmtypes.UnsupportedRequest{Kind: "chain queries are not supported"}
+}
+
+func (rejectingQuerier) GasConsumed() uint64 {
+	return 0
+}
+
+// ValidateAllowedQuery validates that the query path is a full gRPC method name, e.g.
+// /cosmos.staking.v1beta1.Query/Params.
+func ValidateAllowedQuery(path string) error {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" || strings.ContainsAny(path, " \t\n") {
+		return sdkerrors.Wrapf(ErrInvalidAllowedQuery, "query path %q is not a full gRPC method name", path)
+	}
+
+	return nil
+}
+
+// ValidateAllowedQueries validates every query path and that no path is duplicated.
+func ValidateAllowedQueries(paths []string) error {
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if err := ValidateAllowedQuery(path); err != nil {
+			return err
+		}
+		if seen[path] {
+			return sdkerrors.Wrap(ErrInvalidAllowedQuery, fmt.Sprintf("duplicate query path %s", path))
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
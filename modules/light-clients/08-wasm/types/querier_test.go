@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+const (
+	stakingParamsPath    = "/cosmos.staking.v1beta1.Query/Params"
+	stakingValidatorPath = "/cosmos.staking.v1beta1.Query/Validator"
+)
+
+// chainQuery is a contract handler which makes a chain query and converts an address with the
+// querier and api passed by the client state, recording the results.
+type chainQuery struct {
+	request     wasmvmtypes.QueryRequest
+	response    []byte
+	queryErr    error
+	address     string
+	humanized   string
+	canonical   []byte
+	convertErr  error
+	gasConsumed uint64
+}
+
+func (q *chainQuery) contract(call wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+	q.response, q.queryErr = call.Querier.Query(q.request, call.GasLimit)
+	q.gasConsumed = call.Querier.GasConsumed()
+
+	q.canonical, _, q.convertErr = call.GoAPI.CanonicalAddress(q.address)
+	if q.convertErr == nil {
+		q.humanized, _, q.convertErr = call.GoAPI.HumanAddress(q.canonical)
+	}
+
+	return &wasmvmtypes.Response{Data: []byte(`{"status":"Active"}`)}, nil
+}
+
+func (suite *WasmTestSuite) TestAllowedQueries() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+
+	query := &chainQuery{
+		request: wasmvmtypes.QueryRequest{
+			Stargate: &wasmvmtypes.StargateQuery{
+				Path: stakingParamsPath,
+				Data: app.AppCodec().MustMarshal(&stakingtypes.QueryParamsRequest{}),
+			},
+		},
+		address: signer,
+	}
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, "", nil,
+		keeper.WithWasmEngine(wasmtesting.NewMockWasmEngine(query.contract)),
+		keeper.WithQueryRouter(app.GRPCQueryRouter()),
+	)
+	clientState := suite.clientState.(*wasmtypes.ClientState)
+	status := func() exported.Status {
+		ctx := k.ClientContext(suite.chainA.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter()))
+		return clientState.Status(ctx, suite.store, suite.chainA.Codec)
+	}
+
+	// queries are not allowed by default
+	suite.Require().Empty(k.GetAllowedQueries(suite.chainA.GetContext()))
+	suite.Require().Equal(exported.Active, status())
+	suite.Require().Error(query.queryErr)
+	suite.Require().Nil(query.response)
+
+	// the address is converted by the api
+	suite.Require().NoError(query.convertErr)
+	suite.Require().Equal(sdk.MustAccAddressFromBech32(signer).Bytes(), query.canonical)
+	suite.Require().Equal(signer, query.humanized)
+
+	// allowed queries are answered with the protobuf encoded response
+	_, err := k.UpdateAllowedQueries(suite.chainA.GetContext(), wasmtypes.NewMsgUpdateAllowedQueries(signer, stakingParamsPath))
+	suite.Require().NoError(err)
+	suite.Require().True(k.IsAllowedQuery(suite.chainA.GetContext(), stakingParamsPath))
+	suite.Require().Equal([]string{stakingParamsPath}, k.GetAllowedQueries(suite.chainA.GetContext()))
+	suite.Require().Equal([]string{stakingParamsPath}, k.ExportGenesis(suite.chainA.GetContext()).AllowedQueries)
+
+	suite.Require().Equal(exported.Active, status())
+	suite.Require().NoError(query.queryErr)
+	var res stakingtypes.QueryParamsResponse
+	suite.Require().NoError(app.AppCodec().Unmarshal(query.response, &res))
+	suite.Require().Equal(app.StakingKeeper.UnbondingTime(suite.chainA.GetContext()), res.Params.UnbondingTime)
+	suite.Require().NotZero(query.gasConsumed)
+
+	// the errors of allowed queries are redacted to their codespace and code
+	validatorAddr := sdk.ValAddress(make([]byte, 20)).String()
+	_, err = k.UpdateAllowedQueries(suite.chainA.GetContext(), wasmtypes.NewMsgUpdateAllowedQueries(signer, stakingParamsPath, stakingValidatorPath))
+	suite.Require().NoError(err)
+	query.request = wasmvmtypes.QueryRequest{
+		Stargate: &wasmvmtypes.StargateQuery{
+			Path: stakingValidatorPath,
+			Data: app.AppCodec().MustMarshal(&stakingtypes.QueryValidatorRequest{ValidatorAddr: validatorAddr}),
+		},
+	}
+	suite.Require().Equal(exported.Active, status())
+	suite.Require().EqualError(query.queryErr, "codespace: undefined, code: 1")
+	suite.Require().Nil(query.response)
+
+	// queries other than stargate queries are not supported
+	query.request = wasmvmtypes.QueryRequest{Bank: &wasmvmtypes.BankQuery{}}
+	suite.Require().Equal(exported.Active, status())
+	suite.Require().Error(query.queryErr)
+
+	// invalid addresses are rejected by the api
+	query.address = "invalid"
+	suite.Require().Equal(exported.Active, status())
+	suite.Require().Error(query.convertErr)
+
+	// an empty list disallows all queries
+	_, err = k.UpdateAllowedQueries(suite.chainA.GetContext(), wasmtypes.NewMsgUpdateAllowedQueries(signer))
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsAllowedQuery(suite.chainA.GetContext(), stakingParamsPath))
+	suite.Require().Empty(k.GetAllowedQueries(suite.chainA.GetContext()))
+}
+
+func (suite *WasmTestSuite) TestAllowedQueriesWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// test invalid signer
+	_, err := suite.wasmKeeper.UpdateAllowedQueries(suite.ctx, wasmtypes.NewMsgUpdateAllowedQueries("invalid", stakingParamsPath))
+	suite.Require().Error(err)
+
+	// test query without route
+	_, err = suite.wasmKeeper.UpdateAllowedQueries(suite.ctx, wasmtypes.NewMsgUpdateAllowedQueries(signer, stakingParamsPath, "/cosmos.staking.v1beta1.Query/Unknown"))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidAllowedQuery)
+	suite.Require().False(suite.wasmKeeper.IsAllowedQuery(suite.ctx, stakingParamsPath))
+
+	// test invalid genesis
+	err = suite.wasmKeeper.InitGenesis(suite.ctx, wasmtypes.GenesisState{AllowedQueries: []string{"invalid"}})
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidAllowedQuery)
+
+	// test invalid messages
+	suite.Require().NoError(wasmtypes.NewMsgUpdateAllowedQueries(signer).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgUpdateAllowedQueries(signer, stakingParamsPath).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUpdateAllowedQueries(signer, "").ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUpdateAllowedQueries(signer, "cosmos.staking.v1beta1.Query/Params").ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUpdateAllowedQueries(signer, "/cosmos.staking.v1beta1.Query").ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUpdateAllowedQueries(signer, "/cosmos.staking.v1beta1.Query/Params/").ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUpdateAllowedQueries(signer, stakingParamsPath, stakingParamsPath).ValidateBasic())
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -219,384 +220,2268 @@ func (m *WasmCodeResponse) GetCode() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*WasmCodeQuery)(nil), "ibc.lightclients.wasm.v1.WasmCodeQuery")
-	proto.RegisterType((*AllWasmCodeIDQuery)(nil), "ibc.lightclients.wasm.v1.AllWasmCodeIDQuery")
-	proto.RegisterType((*AllWasmCodeIDResponse)(nil), "ibc.lightclients.wasm.v1.AllWasmCodeIDResponse")
-	proto.RegisterType((*WasmCodeResponse)(nil), "ibc.lightclients.wasm.v1.WasmCodeResponse")
+// CodeInfo query
+type CodeInfoQuery struct {
+	CodeId string `protobuf:"bytes,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/lightclients/wasm/v1/query.proto", fileDescriptor_9e3718a8cb915777)
+func (m *CodeInfoQuery) Reset()         { *m = CodeInfoQuery{} }
+func (m *CodeInfoQuery) String() string { return proto.CompactTextString(m) }
+func (*CodeInfoQuery) ProtoMessage()    {}
+func (*CodeInfoQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{4}
 }
-
-var fileDescriptor_9e3718a8cb915777 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x8b, 0xd4, 0x30,
-	0x18, 0xc7, 0x27, 0xa3, 0xee, 0x4b, 0x74, 0x41, 0x02, 0xe2, 0x58, 0xa4, 0x2c, 0x45, 0x77, 0xca,
-	0x60, 0x93, 0xed, 0x7a, 0xd0, 0x9b, 0xf8, 0x82, 0xb2, 0x37, 0xed, 0x45, 0x14, 0x61, 0x49, 0xdb,
-	0xd0, 0x0d, 0xa4, 0x4d, 0x77, 0x93, 0x56, 0x96, 0xc5, 0x8b, 0x9f, 0x40, 0xf1, 0xec, 0x27, 0xf1,
-	0x0b, 0x78, 0x5c, 0xf0, 0xe2, 0x51, 0x66, 0xfc, 0x20, 0xd2, 0xa4, 0x1d, 0xa7, 0x48, 0x19, 0x6f,
-	0x2d, 0xfc, 0x9f, 0xe7, 0xf7, 0x7b, 0x9e, 0x3c, 0xf0, 0x0e, 0x8f, 0x13, 0x22, 0x78, 0x76, 0xac,
-	0x13, 0xc1, 0x59, 0xa1, 0x15, 0x79, 0x4f, 0x55, 0x4e, 0xea, 0x90, 0x9c, 0x54, 0xec, 0xf4, 0x0c,
-	0x97, 0xa7, 0x52, 0x4b, 0x34, 0xe1, 0x71, 0x82, 0x57, 0x53, 0xb8, 0x49, 0xe1, 0x3a, 0x74, 0x6e,
-	0x67, 0x52, 0x66, 0x82, 0x11, 0x5a, 0x72, 0x42, 0x8b, 0x42, 0x6a, 0xaa, 0xb9, 0x2c, 0x94, 0xad,
-	0x73, 0x66, 0x89, 0x54, 0xb9, 0x54, 0x24, 0xa6, 0x8a, 0xd9, 0x86, 0xa4, 0x0e, 0x63, 0xa6, 0x69,
-	0x48, 0x4a, 0x9a, 0xf1, 0xc2, 0x84, 0x6d, 0xd6, 0xf3, 0xe1, 0xce, 0x6b, 0xaa, 0xf2, 0xa7, 0x32,
-	0x65, 0xaf, 0x9a, 0x24, 0xba, 0x09, 0x37, 0x13, 0x99, 0xb2, 0x23, 0x9e, 0x4e, 0xc0, 0x2e, 0xf0,
-	0xb7, 0xa3, 0x8d, 0xe6, 0xf7, 0x30, 0xf5, 0xde, 0x41, 0xf4, 0x58, 0x88, 0x2e, 0x7c, 0xf8, 0xcc,
-	0xc6, 0x9f, 0x43, 0xf8, 0xb7, 0xa7, 0xa9, 0xb8, 0x7a, 0xb0, 0x87, 0xad, 0x00, 0x6e, 0x04, 0xb0,
-	0x9d, 0xa8, 0x15, 0xc0, 0x2f, 0x69, 0xc6, 0x22, 0x76, 0x52, 0x31, 0xa5, 0xa3, 0x95, 0x4a, 0xef,
-	0x1c, 0xde, 0xe8, 0x75, 0x8f, 0x98, 0x2a, 0x65, 0xa1, 0x18, 0xba, 0x05, 0xb7, 0x5a, 0x1f, 0x35,
-	0x01, 0xbb, 0x97, 0xfc, 0xed, 0x68, 0xd3, 0x0a, 0x29, 0xf4, 0xa2, 0xc7, 0x1e, 0x1b, 0xf6, 0x74,
-	0x2d, 0xdb, 0xf6, 0xed, 0xc1, 0xf7, 0xe0, 0xf5, 0x8e, 0xbc, 0xe4, 0x22, 0x78, 0xb9, 0xe1, 0x98,
-	0x91, 0xae, 0x45, 0xe6, 0xfb, 0xe0, 0xdb, 0x18, 0x5e, 0xb1, 0x63, 0x7f, 0x06, 0x70, 0xab, 0x2b,
-	0x41, 0x53, 0x3c, 0xf4, 0x50, 0xb8, 0xb7, 0x5b, 0x67, 0xb6, 0x3e, 0xd8, 0xf1, 0xbd, 0xfd, 0x8f,
-	0x3f, 0x7e, 0x7f, 0x19, 0xcf, 0x90, 0x4f, 0x06, 0x6f, 0xa5, 0x71, 0x22, 0xe7, 0xed, 0x76, 0x3e,
-	0xa0, 0xaf, 0x00, 0xee, 0xf4, 0x76, 0x88, 0xee, 0x0d, 0xf3, 0xfe, 0x7d, 0x4a, 0x87, 0xfc, 0x67,
-	0x7a, 0xa9, 0x18, 0x18, 0xc5, 0x29, 0xba, 0x3b, 0xac, 0x48, 0x85, 0x38, 0x6a, 0x05, 0x9f, 0xbc,
-	0xf9, 0x3e, 0x77, 0xc1, 0xc5, 0xdc, 0x05, 0xbf, 0xe6, 0x2e, 0xf8, 0xb4, 0x70, 0x47, 0x17, 0x0b,
-	0x77, 0xf4, 0x73, 0xe1, 0x8e, 0xde, 0x3e, 0xca, 0xb8, 0x3e, 0xae, 0x62, 0x9c, 0xc8, 0x9c, 0xb4,
-	0xb7, 0xcb, 0xe3, 0x24, 0xc8, 0x24, 0xa9, 0x1f, 0x90, 0x5c, 0xa6, 0x95, 0x60, 0xca, 0xf6, 0x0f,
-	0x3a, 0xc0, 0xfe, 0xc3, 0xc0, 0x30, 0xf4, 0x59, 0xc9, 0x54, 0xbc, 0x61, 0x8e, 0xf9, 0xfe, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x9b, 0x09, 0x83, 0x58, 0x03, 0x00, 0x00,
+func (m *CodeInfoQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeInfoQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeInfoQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeInfoQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeInfoQuery.Merge(m, src)
+}
+func (m *CodeInfoQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeInfoQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeInfoQuery.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CodeInfoQuery proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get Wasm code for given code id
-	WasmCode(ctx context.Context, in *WasmCodeQuery, opts ...grpc.CallOption) (*WasmCodeResponse, error)
-	// Get All Wasm code for given code id
-	AllWasmCodeID(ctx context.Context, in *AllWasmCodeIDQuery, opts ...grpc.CallOption) (*AllWasmCodeIDResponse, error)
+func (m *CodeInfoQuery) GetCodeId() string {
+	if m != nil {
+		return m.CodeId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// CodeInfo response
+type CodeInfoResponse struct {
+	CodeInfo CodeInfo `protobuf:"bytes,1,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
+	// the identifiers of the clients using the code
+	ClientIds []string `protobuf:"bytes,2,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *CodeInfoResponse) Reset()         { *m = CodeInfoResponse{} }
+func (m *CodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*CodeInfoResponse) ProtoMessage()    {}
+func (*CodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{5}
 }
-
-func (c *queryClient) WasmCode(ctx context.Context, in *WasmCodeQuery, opts ...grpc.CallOption) (*WasmCodeResponse, error) {
-	out := new(WasmCodeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/WasmCode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CodeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeInfoResponse.Merge(m, src)
+}
+func (m *CodeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeInfoResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllWasmCodeID(ctx context.Context, in *AllWasmCodeIDQuery, opts ...grpc.CallOption) (*AllWasmCodeIDResponse, error) {
-	out := new(AllWasmCodeIDResponse)
-	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/AllWasmCodeID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CodeInfoResponse proto.InternalMessageInfo
+
+func (m *CodeInfoResponse) GetCodeInfo() CodeInfo {
+	if m != nil {
+		return m.CodeInfo
 	}
-	return out, nil
+	return CodeInfo{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get Wasm code for given code id
-	WasmCode(context.Context, *WasmCodeQuery) (*WasmCodeResponse, error)
-	// Get All Wasm code for given code id
-	AllWasmCodeID(context.Context, *AllWasmCodeIDQuery) (*AllWasmCodeIDResponse, error)
+func (m *CodeInfoResponse) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// ClientsByCode query
+type ClientsByCodeQuery struct {
+	CodeId string `protobuf:"bytes,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) WasmCode(ctx context.Context, req *WasmCodeQuery) (*WasmCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WasmCode not implemented")
+func (m *ClientsByCodeQuery) Reset()         { *m = ClientsByCodeQuery{} }
+func (m *ClientsByCodeQuery) String() string { return proto.CompactTextString(m) }
+func (*ClientsByCodeQuery) ProtoMessage()    {}
+func (*ClientsByCodeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{6}
 }
-func (*UnimplementedQueryServer) AllWasmCodeID(ctx context.Context, req *AllWasmCodeIDQuery) (*AllWasmCodeIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllWasmCodeID not implemented")
+func (m *ClientsByCodeQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ClientsByCodeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientsByCodeQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientsByCodeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientsByCodeQuery.Merge(m, src)
+}
+func (m *ClientsByCodeQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientsByCodeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientsByCodeQuery.DiscardUnknown(m)
 }
 
-func _Query_WasmCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WasmCodeQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WasmCode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.lightclients.wasm.v1.Query/WasmCode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WasmCode(ctx, req.(*WasmCodeQuery))
+var xxx_messageInfo_ClientsByCodeQuery proto.InternalMessageInfo
+
+func (m *ClientsByCodeQuery) GetCodeId() string {
+	if m != nil {
+		return m.CodeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AllWasmCodeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllWasmCodeIDQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllWasmCodeID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.lightclients.wasm.v1.Query/AllWasmCodeID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllWasmCodeID(ctx, req.(*AllWasmCodeIDQuery))
+func (m *ClientsByCodeQuery) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.lightclients.wasm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WasmCode",
-			Handler:    _Query_WasmCode_Handler,
-		},
-		{
-			MethodName: "AllWasmCodeID",
-			Handler:    _Query_AllWasmCodeID_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/lightclients/wasm/v1/query.proto",
+// ClientsByCode response
+type ClientsByCodeResponse struct {
+	ClientIds []string `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *WasmCodeQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClientsByCodeResponse) Reset()         { *m = ClientsByCodeResponse{} }
+func (m *ClientsByCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*ClientsByCodeResponse) ProtoMessage()    {}
+func (*ClientsByCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{7}
+}
+func (m *ClientsByCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientsByCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientsByCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *WasmCodeQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClientsByCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientsByCodeResponse.Merge(m, src)
+}
+func (m *ClientsByCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientsByCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientsByCodeResponse.DiscardUnknown(m)
 }
 
-func (m *WasmCodeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeId) > 0 {
-		i -= len(m.CodeId)
-		copy(dAtA[i:], m.CodeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ClientsByCodeResponse proto.InternalMessageInfo
+
+func (m *ClientsByCodeResponse) GetClientIds() []string {
+	if m != nil {
+		return m.ClientIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AllWasmCodeIDQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClientsByCodeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AllWasmCodeIDQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ApprovedChecksums query
+type ApprovedChecksumsQuery struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *AllWasmCodeIDQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ApprovedChecksumsQuery) Reset()         { *m = ApprovedChecksumsQuery{} }
+func (m *ApprovedChecksumsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApprovedChecksumsQuery) ProtoMessage()    {}
+func (*ApprovedChecksumsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{8}
+}
+func (m *ApprovedChecksumsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovedChecksumsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApprovedChecksumsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AllWasmCodeIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ApprovedChecksumsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovedChecksumsQuery.Merge(m, src)
 }
-
-func (m *AllWasmCodeIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ApprovedChecksumsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovedChecksumsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovedChecksumsQuery.DiscardUnknown(m)
 }
 
-func (m *AllWasmCodeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_ApprovedChecksumsQuery proto.InternalMessageInfo
+
+func (m *ApprovedChecksumsQuery) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.CodeIds) > 0 {
-		for iNdEx := len(m.CodeIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CodeIds[iNdEx])
-			copy(dAtA[i:], m.CodeIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// ApprovedChecksums response
+type ApprovedChecksumsResponse struct {
+	// the hex encoded checksums of the codes approved for upload
+	Checksums []string `protobuf:"bytes,1,rep,name=checksums,proto3" json:"checksums,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ApprovedChecksumsResponse) Reset()         { *m = ApprovedChecksumsResponse{} }
+func (m *ApprovedChecksumsResponse) String() string { return proto.CompactTextString(m) }
+func (*ApprovedChecksumsResponse) ProtoMessage()    {}
+func (*ApprovedChecksumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{9}
+}
+func (m *ApprovedChecksumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovedChecksumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApprovedChecksumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ApprovedChecksumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovedChecksumsResponse.Merge(m, src)
+}
+func (m *ApprovedChecksumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovedChecksumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovedChecksumsResponse.DiscardUnknown(m)
 }
 
-func (m *WasmCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApprovedChecksumsResponse proto.InternalMessageInfo
+
+func (m *ApprovedChecksumsResponse) GetChecksums() []string {
+	if m != nil {
+		return m.Checksums
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WasmCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ApprovedChecksumsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *WasmCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Upload query
+type UploadQuery struct {
+	UploadId uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *UploadQuery) Reset()         { *m = UploadQuery{} }
+func (m *UploadQuery) String() string { return proto.CompactTextString(m) }
+func (*UploadQuery) ProtoMessage()    {}
+func (*UploadQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{10}
 }
-func (m *WasmCodeQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CodeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *UploadQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UploadQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadQuery.Merge(m, src)
+}
+func (m *UploadQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadQuery.DiscardUnknown(m)
 }
 
-func (m *AllWasmCodeIDQuery) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_UploadQuery proto.InternalMessageInfo
+
+func (m *UploadQuery) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// Upload response
+type UploadResponse struct {
+	Upload UploadSession `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload"`
+}
+
+func (m *UploadResponse) Reset()         { *m = UploadResponse{} }
+func (m *UploadResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadResponse) ProtoMessage()    {}
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{11}
+}
+func (m *UploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadResponse.Merge(m, src)
+}
+func (m *UploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadResponse.DiscardUnknown(m)
 }
 
-func (m *AllWasmCodeIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_UploadResponse proto.InternalMessageInfo
+
+func (m *UploadResponse) GetUpload() UploadSession {
+	if m != nil {
+		return m.Upload
 	}
-	var l int
-	_ = l
-	if len(m.CodeIds) > 0 {
-		for _, s := range m.CodeIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return UploadSession{}
+}
+
+// Params query
+type ParamsQuery struct {
+}
+
+func (m *ParamsQuery) Reset()         { *m = ParamsQuery{} }
+func (m *ParamsQuery) String() string { return proto.CompactTextString(m) }
+func (*ParamsQuery) ProtoMessage()    {}
+func (*ParamsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{12}
+}
+func (m *ParamsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *ParamsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsQuery.Merge(m, src)
+}
+func (m *ParamsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsQuery.DiscardUnknown(m)
 }
 
-func (m *WasmCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ParamsQuery proto.InternalMessageInfo
+
+// Params response
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{13}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterType((*WasmCodeQuery)(nil), "ibc.lightclients.wasm.v1.WasmCodeQuery")
+	proto.RegisterType((*AllWasmCodeIDQuery)(nil), "ibc.lightclients.wasm.v1.AllWasmCodeIDQuery")
+	proto.RegisterType((*AllWasmCodeIDResponse)(nil), "ibc.lightclients.wasm.v1.AllWasmCodeIDResponse")
+	proto.RegisterType((*WasmCodeResponse)(nil), "ibc.lightclients.wasm.v1.WasmCodeResponse")
+	proto.RegisterType((*CodeInfoQuery)(nil), "ibc.lightclients.wasm.v1.CodeInfoQuery")
+	proto.RegisterType((*CodeInfoResponse)(nil), "ibc.lightclients.wasm.v1.CodeInfoResponse")
+	proto.RegisterType((*ClientsByCodeQuery)(nil), "ibc.lightclients.wasm.v1.ClientsByCodeQuery")
+	proto.RegisterType((*ClientsByCodeResponse)(nil), "ibc.lightclients.wasm.v1.ClientsByCodeResponse")
+	proto.RegisterType((*ApprovedChecksumsQuery)(nil), "ibc.lightclients.wasm.v1.ApprovedChecksumsQuery")
+	proto.RegisterType((*ApprovedChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.ApprovedChecksumsResponse")
+	proto.RegisterType((*UploadQuery)(nil), "ibc.lightclients.wasm.v1.UploadQuery")
+	proto.RegisterType((*UploadResponse)(nil), "ibc.lightclients.wasm.v1.UploadResponse")
+	proto.RegisterType((*ParamsQuery)(nil), "ibc.lightclients.wasm.v1.ParamsQuery")
+	proto.RegisterType((*ParamsResponse)(nil), "ibc.lightclients.wasm.v1.ParamsResponse")
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/query.proto", fileDescriptor_9e3718a8cb915777)
+}
+
+var fileDescriptor_9e3718a8cb915777 = []byte{
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x51, 0x4f, 0xdb, 0x56,
+	0x14, 0x8e, 0x19, 0x0b, 0xc9, 0x61, 0x41, 0xec, 0x6a, 0x6c, 0xe0, 0xb1, 0x2c, 0xb2, 0x06, 0x58,
+	0x11, 0xf1, 0x4d, 0x60, 0xd3, 0x78, 0xda, 0x04, 0x6c, 0x4c, 0x79, 0x63, 0x9e, 0x26, 0xb4, 0x69,
+	0x12, 0x73, 0xec, 0x8b, 0xb1, 0xe6, 0xf8, 0x9a, 0x5c, 0x27, 0x2b, 0x42, 0xa8, 0x12, 0xfd, 0x01,
+	0x6d, 0x55, 0xa9, 0x6f, 0x7d, 0xef, 0x63, 0x7f, 0x06, 0xea, 0x13, 0x52, 0x5f, 0xfa, 0x54, 0x55,
+	0xd0, 0x1f, 0x52, 0xf9, 0x5e, 0xdb, 0xc4, 0x0d, 0x89, 0x43, 0xc5, 0x9b, 0x7d, 0xfd, 0x9d, 0xf3,
+	0x7d, 0xe7, 0x9c, 0x7b, 0x3e, 0x19, 0xbe, 0x73, 0x5a, 0x26, 0x76, 0x1d, 0xfb, 0x30, 0x30, 0x5d,
+	0x87, 0x78, 0x01, 0xc3, 0xff, 0x1b, 0xac, 0x8d, 0x7b, 0x0d, 0x7c, 0xd4, 0x25, 0x9d, 0x63, 0xcd,
+	0xef, 0xd0, 0x80, 0xa2, 0x79, 0xa7, 0x65, 0x6a, 0xfd, 0x28, 0x2d, 0x44, 0x69, 0xbd, 0x86, 0xfc,
+	0x85, 0x4d, 0x6d, 0xca, 0x41, 0x38, 0x7c, 0x12, 0x78, 0x79, 0xd1, 0xa6, 0xd4, 0x76, 0x09, 0x36,
+	0x7c, 0x07, 0x1b, 0x9e, 0x47, 0x03, 0x23, 0x70, 0xa8, 0xc7, 0xa2, 0xaf, 0x55, 0x93, 0xb2, 0x36,
+	0x65, 0xb8, 0x65, 0x30, 0x22, 0x68, 0x70, 0xaf, 0xd1, 0x22, 0x81, 0xd1, 0xc0, 0xbe, 0x61, 0x3b,
+	0x1e, 0x07, 0x47, 0x58, 0x75, 0xa8, 0x3e, 0x93, 0x5a, 0x64, 0xdf, 0xf1, 0x0e, 0x62, 0xce, 0xa5,
+	0xa1, 0x48, 0xdf, 0xe8, 0x18, 0x6d, 0x96, 0x09, 0xeb, 0xfa, 0x2e, 0x35, 0x2c, 0x01, 0x53, 0x54,
+	0x28, 0xed, 0x19, 0xac, 0xbd, 0x4d, 0x2d, 0xf2, 0x7b, 0xa8, 0x10, 0x7d, 0x05, 0x53, 0x82, 0xd1,
+	0x9a, 0x97, 0x2a, 0x92, 0x5a, 0xd4, 0xf3, 0xe1, 0x6b, 0xd3, 0x52, 0xfe, 0x01, 0xb4, 0xe9, 0xba,
+	0x31, 0xb8, 0xf9, 0x8b, 0x80, 0xef, 0x00, 0x5c, 0xd7, 0xc2, 0x23, 0xa6, 0xd7, 0x96, 0x35, 0x51,
+	0xb8, 0x16, 0x16, 0xae, 0x89, 0xfe, 0x46, 0x85, 0x6b, 0xbb, 0x86, 0x4d, 0x74, 0x72, 0xd4, 0x25,
+	0x2c, 0xd0, 0xfb, 0x22, 0x95, 0x13, 0x98, 0x4b, 0x65, 0xd7, 0x09, 0xf3, 0xa9, 0xc7, 0x08, 0x5a,
+	0x80, 0x42, 0xa4, 0x87, 0xcd, 0x4b, 0x95, 0x4f, 0xd4, 0xa2, 0x3e, 0x25, 0x04, 0x31, 0xf4, 0x5b,
+	0x8a, 0x7b, 0x82, 0x73, 0xaf, 0x64, 0x72, 0x8b, 0xbc, 0x29, 0xf2, 0x65, 0x98, 0x8d, 0x99, 0x13,
+	0x5e, 0x04, 0x93, 0x21, 0x0f, 0x2f, 0xe9, 0x33, 0x9d, 0x3f, 0x87, 0xcd, 0xe2, 0xea, 0xbc, 0x03,
+	0x9a, 0xd1, 0xac, 0x7b, 0x30, 0x1b, 0x23, 0x93, 0x8c, 0xbf, 0x42, 0x31, 0x99, 0x65, 0xd4, 0x29,
+	0x45, 0x1b, 0x76, 0xe1, 0xb4, 0x38, 0x7c, 0x6b, 0xf2, 0xfc, 0xcd, 0xb7, 0x39, 0xbd, 0x60, 0x46,
+	0xef, 0xe8, 0x1b, 0x00, 0x81, 0xe5, 0x2d, 0x99, 0xe0, 0x2d, 0x29, 0x8a, 0x93, 0xa6, 0xc5, 0x94,
+	0x2e, 0xa0, 0x6d, 0x91, 0x6a, 0xeb, 0x38, 0x7b, 0xaa, 0x68, 0xe7, 0x86, 0x1e, 0x7e, 0xcc, 0xfc,
+	0xee, 0xc3, 0x5c, 0x8a, 0x36, 0xa9, 0x3a, 0x2d, 0x57, 0xfa, 0x40, 0xee, 0xdd, 0xcd, 0xf0, 0x5f,
+	0xf8, 0x72, 0xd3, 0xf7, 0x3b, 0xb4, 0x47, 0xac, 0xed, 0x43, 0x62, 0xfe, 0xc7, 0xba, 0x6d, 0x76,
+	0xb7, 0x57, 0xf4, 0x4c, 0x82, 0x85, 0x01, 0x8a, 0xa4, 0xce, 0x45, 0x28, 0x9a, 0xf1, 0x61, 0x52,
+	0x66, 0x7c, 0x70, 0x77, 0x65, 0x56, 0x61, 0xfa, 0x4f, 0xbe, 0xbf, 0xa2, 0xb6, 0xaf, 0xa1, 0x28,
+	0xd6, 0x39, 0x9e, 0xec, 0xa4, 0x5e, 0x10, 0x07, 0x4d, 0x4b, 0xd9, 0x83, 0x19, 0x81, 0xed, 0xbb,
+	0x82, 0x79, 0xf1, 0x35, 0x6a, 0xc3, 0xca, 0xf0, 0xfb, 0x27, 0x22, 0xff, 0x20, 0x8c, 0x39, 0xd4,
+	0x8b, 0x2e, 0x61, 0x14, 0xac, 0x94, 0x60, 0x7a, 0x97, 0x7b, 0x0d, 0x17, 0xa1, 0xec, 0xc2, 0x8c,
+	0x78, 0x4d, 0x78, 0x7e, 0x82, 0xbc, 0x30, 0xa3, 0x88, 0xa7, 0x32, 0x9c, 0x47, 0x44, 0xc6, 0x04,
+	0x22, 0x6a, 0xed, 0x65, 0x01, 0x3e, 0x15, 0x05, 0x3e, 0x96, 0xa0, 0x10, 0xef, 0x26, 0x1a, 0x21,
+	0x37, 0x65, 0x62, 0x72, 0x35, 0x1b, 0x18, 0x6b, 0x55, 0xea, 0x67, 0xaf, 0xde, 0x3d, 0x99, 0xa8,
+	0x22, 0x15, 0x8f, 0xb4, 0x60, 0x7c, 0x12, 0x2d, 0xd0, 0x29, 0x7a, 0x26, 0x41, 0x29, 0x65, 0x56,
+	0x68, 0x75, 0x38, 0xdf, 0xa0, 0x67, 0xca, 0x78, 0x4c, 0x74, 0x22, 0xb1, 0xc6, 0x25, 0xae, 0xa0,
+	0xa5, 0xe1, 0x12, 0x0d, 0xd7, 0xdd, 0x8f, 0x04, 0xa2, 0xa7, 0x12, 0x14, 0x62, 0xfb, 0x18, 0xd5,
+	0xb3, 0x94, 0x97, 0xc9, 0xd5, 0x6c, 0x60, 0x22, 0xe8, 0x07, 0x2e, 0x08, 0xa3, 0xda, 0xb8, 0x3d,
+	0xc3, 0xa1, 0xe9, 0xa1, 0xe7, 0x12, 0x94, 0x52, 0x2e, 0x31, 0xaa, 0x71, 0x83, 0x2e, 0x26, 0xe3,
+	0x31, 0xd1, 0x89, 0xce, 0x0d, 0xae, 0x73, 0x0d, 0xd5, 0xc7, 0xd6, 0x19, 0x7d, 0x46, 0x2f, 0x24,
+	0xf8, 0x7c, 0x60, 0xd9, 0x51, 0x7d, 0xc4, 0xe4, 0x6e, 0x34, 0x1f, 0x79, 0xfd, 0x16, 0x11, 0x89,
+	0xec, 0xef, 0xb9, 0x6c, 0x0d, 0xad, 0x8e, 0x98, 0x77, 0x14, 0xbc, 0x7f, 0xed, 0x31, 0x0f, 0x25,
+	0xc8, 0x8b, 0xad, 0x45, 0x4b, 0x59, 0x7b, 0x2d, 0xc4, 0xa9, 0x59, 0xb0, 0xdb, 0x28, 0x12, 0xde,
+	0x80, 0x4f, 0x12, 0x3f, 0x3a, 0x45, 0x0f, 0x24, 0xc8, 0x8b, 0xfd, 0x1e, 0xa5, 0xa8, 0xcf, 0x4a,
+	0x64, 0x35, 0x0b, 0x96, 0x28, 0x52, 0xb9, 0x22, 0x05, 0x55, 0x70, 0xc6, 0xff, 0xd0, 0xd6, 0x5f,
+	0xe7, 0x97, 0x65, 0xe9, 0xe2, 0xb2, 0x2c, 0xbd, 0xbd, 0x2c, 0x4b, 0x8f, 0xae, 0xca, 0xb9, 0x8b,
+	0xab, 0x72, 0xee, 0xf5, 0x55, 0x39, 0xf7, 0xf7, 0xcf, 0xb6, 0x13, 0x1c, 0x76, 0x5b, 0x9a, 0x49,
+	0xdb, 0x38, 0xfa, 0x57, 0x73, 0x5a, 0x66, 0xcd, 0xa6, 0xb8, 0xf7, 0x23, 0x6e, 0x53, 0xab, 0xeb,
+	0x12, 0x26, 0x52, 0xd7, 0xe2, 0xdc, 0xf5, 0x8d, 0x1a, 0x4f, 0x1f, 0x1c, 0xfb, 0x84, 0xb5, 0xf2,
+	0xfc, 0x27, 0x6a, 0xfd, 0xfd, 0x00, 0x46, 0xa6, 0x3c, 0xbf, 0x5e, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get Wasm code for given code id
+	WasmCode(ctx context.Context, in *WasmCodeQuery, opts ...grpc.CallOption) (*WasmCodeResponse, error)
+	// Get All Wasm code for given code id
+	AllWasmCodeID(ctx context.Context, in *AllWasmCodeIDQuery, opts ...grpc.CallOption) (*AllWasmCodeIDResponse, error)
+	// Get the metadata of the wasm code for given code id
+	CodeInfo(ctx context.Context, in *CodeInfoQuery, opts ...grpc.CallOption) (*CodeInfoResponse, error)
+	// Get the clients using the wasm code for given code id
+	ClientsByCode(ctx context.Context, in *ClientsByCodeQuery, opts ...grpc.CallOption) (*ClientsByCodeResponse, error)
+	// Get the checksums of the wasm codes approved for upload
+	ApprovedChecksums(ctx context.Context, in *ApprovedChecksumsQuery, opts ...grpc.CallOption) (*ApprovedChecksumsResponse, error)
+	// Get the upload session for given upload id
+	Upload(ctx context.Context, in *UploadQuery, opts ...grpc.CallOption) (*UploadResponse, error)
+	// Get the parameters of the module
+	Params(ctx context.Context, in *ParamsQuery, opts ...grpc.CallOption) (*ParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WasmCode(ctx context.Context, in *WasmCodeQuery, opts ...grpc.CallOption) (*WasmCodeResponse, error) {
+	out := new(WasmCodeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/WasmCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllWasmCodeID(ctx context.Context, in *AllWasmCodeIDQuery, opts ...grpc.CallOption) (*AllWasmCodeIDResponse, error) {
+	out := new(AllWasmCodeIDResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/AllWasmCodeID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CodeInfo(ctx context.Context, in *CodeInfoQuery, opts ...grpc.CallOption) (*CodeInfoResponse, error) {
+	out := new(CodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/CodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientsByCode(ctx context.Context, in *ClientsByCodeQuery, opts ...grpc.CallOption) (*ClientsByCodeResponse, error) {
+	out := new(ClientsByCodeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ClientsByCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ApprovedChecksums(ctx context.Context, in *ApprovedChecksumsQuery, opts ...grpc.CallOption) (*ApprovedChecksumsResponse, error) {
+	out := new(ApprovedChecksumsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ApprovedChecksums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Upload(ctx context.Context, in *UploadQuery, opts ...grpc.CallOption) (*UploadResponse, error) {
+	out := new(UploadResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/Upload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsQuery, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get Wasm code for given code id
+	WasmCode(context.Context, *WasmCodeQuery) (*WasmCodeResponse, error)
+	// Get All Wasm code for given code id
+	AllWasmCodeID(context.Context, *AllWasmCodeIDQuery) (*AllWasmCodeIDResponse, error)
+	// Get the metadata of the wasm code for given code id
+	CodeInfo(context.Context, *CodeInfoQuery) (*CodeInfoResponse, error)
+	// Get the clients using the wasm code for given code id
+	ClientsByCode(context.Context, *ClientsByCodeQuery) (*ClientsByCodeResponse, error)
+	// Get the checksums of the wasm codes approved for upload
+	ApprovedChecksums(context.Context, *ApprovedChecksumsQuery) (*ApprovedChecksumsResponse, error)
+	// Get the upload session for given upload id
+	Upload(context.Context, *UploadQuery) (*UploadResponse, error)
+	// Get the parameters of the module
+	Params(context.Context, *ParamsQuery) (*ParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WasmCode(ctx context.Context, req *WasmCodeQuery) (*WasmCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmCode not implemented")
+}
+func (*UnimplementedQueryServer) AllWasmCodeID(ctx context.Context, req *AllWasmCodeIDQuery) (*AllWasmCodeIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllWasmCodeID not implemented")
+}
+func (*UnimplementedQueryServer) CodeInfo(ctx context.Context, req *CodeInfoQuery) (*CodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeInfo not implemented")
+}
+func (*UnimplementedQueryServer) ClientsByCode(ctx context.Context, req *ClientsByCodeQuery) (*ClientsByCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientsByCode not implemented")
+}
+func (*UnimplementedQueryServer) ApprovedChecksums(ctx context.Context, req *ApprovedChecksumsQuery) (*ApprovedChecksumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovedChecksums not implemented")
+}
+func (*UnimplementedQueryServer) Upload(ctx context.Context, req *UploadQuery) (*UploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsQuery) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WasmCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WasmCodeQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/WasmCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmCode(ctx, req.(*WasmCodeQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllWasmCodeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllWasmCodeIDQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllWasmCodeID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/AllWasmCodeID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllWasmCodeID(ctx, req.(*AllWasmCodeIDQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodeInfoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/CodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeInfo(ctx, req.(*CodeInfoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientsByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientsByCodeQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientsByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ClientsByCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientsByCode(ctx, req.(*ClientsByCodeQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ApprovedChecksums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovedChecksumsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovedChecksums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ApprovedChecksums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovedChecksums(ctx, req.(*ApprovedChecksumsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Upload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Upload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/Upload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Upload(ctx, req.(*UploadQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.wasm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WasmCode",
+			Handler:    _Query_WasmCode_Handler,
+		},
+		{
+			MethodName: "AllWasmCodeID",
+			Handler:    _Query_AllWasmCodeID_Handler,
+		},
+		{
+			MethodName: "CodeInfo",
+			Handler:    _Query_CodeInfo_Handler,
+		},
+		{
+			MethodName: "ClientsByCode",
+			Handler:    _Query_ClientsByCode_Handler,
+		},
+		{
+			MethodName: "ApprovedChecksums",
+			Handler:    _Query_ApprovedChecksums_Handler,
+		},
+		{
+			MethodName: "Upload",
+			Handler:    _Query_Upload_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/wasm/v1/query.proto",
+}
+
+func (m *WasmCodeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmCodeQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmCodeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllWasmCodeIDQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllWasmCodeIDQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllWasmCodeIDQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllWasmCodeIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllWasmCodeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllWasmCodeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeIds) > 0 {
+		for iNdEx := len(m.CodeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeIds[iNdEx])
+			copy(dAtA[i:], m.CodeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfoQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfoQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfoQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.CodeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientsByCodeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientsByCodeQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientsByCodeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientsByCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientsByCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientsByCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientIds) > 0 {
+		for iNdEx := len(m.ClientIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientIds[iNdEx])
+			copy(dAtA[i:], m.ClientIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovedChecksumsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovedChecksumsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovedChecksumsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovedChecksumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovedChecksumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovedChecksumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upload.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WasmCodeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllWasmCodeIDQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllWasmCodeIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIds) > 0 {
+		for _, s := range m.CodeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WasmCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeInfoQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientsByCodeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClientsByCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		for _, s := range m.ClientIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ApprovedChecksumsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ApprovedChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for _, s := range m.Checksums {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		n += 1 + sovQuery(uint64(m.UploadId))
+	}
+	return n
+}
+
+func (m *UploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upload.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WasmCodeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmCodeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmCodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllWasmCodeIDQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllWasmCodeIDQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllWasmCodeIDQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllWasmCodeIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllWasmCodeIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllWasmCodeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeIds = append(m.CodeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfoQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfoQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfoQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientsByCodeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientsByCodeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientsByCodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *WasmCodeQuery) Unmarshal(dAtA []byte) error {
+func (m *ClientsByCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -619,15 +2504,15 @@ func (m *WasmCodeQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WasmCodeQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientsByCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WasmCodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientsByCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -655,7 +2540,43 @@ func (m *WasmCodeQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeId = string(dAtA[iNdEx:postIndex])
+			m.ClientIds = append(m.ClientIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -678,7 +2599,7 @@ func (m *WasmCodeQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllWasmCodeIDQuery) Unmarshal(dAtA []byte) error {
+func (m *ApprovedChecksumsQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -701,10 +2622,10 @@ func (m *AllWasmCodeIDQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllWasmCodeIDQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApprovedChecksumsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllWasmCodeIDQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApprovedChecksumsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -764,7 +2685,7 @@ func (m *AllWasmCodeIDQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllWasmCodeIDResponse) Unmarshal(dAtA []byte) error {
+func (m *ApprovedChecksumsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -787,15 +2708,15 @@ func (m *AllWasmCodeIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllWasmCodeIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApprovedChecksumsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllWasmCodeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApprovedChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -823,7 +2744,7 @@ func (m *AllWasmCodeIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeIds = append(m.CodeIds, string(dAtA[iNdEx:postIndex]))
+			m.Checksums = append(m.Checksums, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -882,7 +2803,7 @@ func (m *AllWasmCodeIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WasmCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *UploadQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,17 +2826,86 @@ func (m *WasmCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WasmCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WasmCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
+			}
+			m.UploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -925,24 +2915,156 @@ func (m *WasmCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			if err := m.Upload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
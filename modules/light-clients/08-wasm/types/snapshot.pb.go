@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/wasm/v1/snapshot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A wasm code stored in a state sync snapshot
+type SnapshotCode struct {
+	// the checksum of the code, which is its code id
+	CodeId []byte `protobuf:"bytes,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// the gzip compressed wasm code
+	CompressedCode []byte `protobuf:"bytes,2,opt,name=compressed_code,json=compressedCode,proto3" json:"compressed_code,omitempty"`
+	// whether the code is pinned in the VM memory cache
+	Pinned bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (m *SnapshotCode) Reset()         { *m = SnapshotCode{} }
+func (m *SnapshotCode) String() string { return proto.CompactTextString(m) }
+func (*SnapshotCode) ProtoMessage()    {}
+func (*SnapshotCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a1de3613e5cc7e0, []int{0}
+}
+func (m *SnapshotCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotCode.Merge(m, src)
+}
+func (m *SnapshotCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotCode proto.InternalMessageInfo
+
+func (m *SnapshotCode) GetCodeId() []byte {
+	if m != nil {
+		return m.CodeId
+	}
+	return nil
+}
+
+func (m *SnapshotCode) GetCompressedCode() []byte {
+	if m != nil {
+		return m.CompressedCode
+	}
+	return nil
+}
+
+func (m *SnapshotCode) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*SnapshotCode)(nil), "ibc.lightclients.wasm.v1.SnapshotCode")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/snapshot.proto", fileDescriptor_1a1de3613e5cc7e0)
+}
+
+var fileDescriptor_1a1de3613e5cc7e0 = []byte{
+	// 237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0x87, 0x73, 0x0a, 0x51, 0x8e, 0xa2, 0x90, 0x41, 0x33, 0x1d, 0xc5, 0xa5, 0x5d, 0x72, 0x67,
+	0x71, 0xd0, 0x4d, 0xd0, 0xc9, 0xb5, 0x4e, 0xba, 0x94, 0xde, 0x1f, 0x92, 0x17, 0x72, 0x79, 0x8f,
+	0xbe, 0xd7, 0x88, 0xdf, 0xc2, 0x8f, 0xe5, 0xd8, 0xd1, 0x51, 0x92, 0x2f, 0x22, 0x89, 0x15, 0x3b,
+	0xfe, 0x1e, 0x1e, 0x7e, 0xf0, 0xf0, 0x19, 0x68, 0xa3, 0x6a, 0x28, 0xab, 0x68, 0x6a, 0x70, 0x4d,
+	0x24, 0xf5, 0xb6, 0x26, 0xaf, 0xda, 0x85, 0xa2, 0x66, 0x1d, 0xa8, 0xc2, 0x28, 0xc3, 0x06, 0x23,
+	0x66, 0x39, 0x68, 0x23, 0x0f, 0x45, 0x39, 0x88, 0xb2, 0x5d, 0x5c, 0x55, 0x7c, 0xf2, 0xbc, 0x77,
+	0x1f, 0xd1, 0xba, 0xec, 0x92, 0x9f, 0x18, 0xb4, 0x6e, 0x05, 0x36, 0x67, 0x53, 0x36, 0x9f, 0x2c,
+	0xd3, 0x61, 0x3e, 0xd9, 0x6c, 0xc6, 0xcf, 0x0d, 0xfa, 0xb0, 0x71, 0x44, 0xce, 0xae, 0x06, 0x98,
+	0x1f, 0x8d, 0xc2, 0xd9, 0x3f, 0x1e, 0x1f, 0x2e, 0x78, 0x1a, 0xa0, 0x69, 0x9c, 0xcd, 0x8f, 0xa7,
+	0x6c, 0x7e, 0xba, 0xdc, 0xaf, 0x87, 0x97, 0xcf, 0x4e, 0xb0, 0x5d, 0x27, 0xd8, 0x77, 0x27, 0xd8,
+	0x47, 0x2f, 0x92, 0x5d, 0x2f, 0x92, 0xaf, 0x5e, 0x24, 0xaf, 0xf7, 0x25, 0xc4, 0x6a, 0xab, 0xa5,
+	0x41, 0xaf, 0x0c, 0x92, 0x47, 0x52, 0xa0, 0x4d, 0x51, 0xa2, 0x6a, 0x6f, 0x95, 0x47, 0xbb, 0xad,
+	0x1d, 0xfd, 0x66, 0x16, 0x7f, 0x9d, 0xd7, 0x77, 0xc5, 0x98, 0x1a, 0xdf, 0x83, 0x23, 0x9d, 0x8e,
+	0x95, 0x37, 0x3f, 0x03, 0x00, 0x38, 0x18, 0xb3, 0xd7, 0x10, 0x01, 0x00, 0x00,
+}
+
+func (m *SnapshotCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CompressedCode) > 0 {
+		i -= len(m.CompressedCode)
+		copy(dAtA[i:], m.CompressedCode)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.CompressedCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SnapshotCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.CompressedCode)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Pinned {
+		n += 2
+	}
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SnapshotCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeId = append(m.CodeId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeId == nil {
+				m.CodeId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedCode = append(m.CompressedCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedCode == nil {
+				m.CompressedCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"io"
+
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	snapshot "github.com/cosmos/cosmos-sdk/snapshots/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+// payloadReader returns a reader of the given snapshot items.
+func payloadReader(payloads [][]byte) snapshot.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
+
+func (suite *WasmTestSuite) TestSnapshotter() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+
+	_, err := suite.wasmKeeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(signer, suite.codeID))
+	suite.Require().NoError(err)
+
+	// lowering the max wasm code size below the size of the stored code does not prevent restoring it
+	info, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, suite.codeID)
+	suite.Require().True(found)
+	params := wasmtypes.DefaultParams()
+	params.MaxWasmCodeSize = info.CodeSize - 1
+	_, err = suite.wasmKeeper.UpdateParams(suite.ctx, wasmtypes.NewMsgUpdateParams(signer, params))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+	height := uint64(app.LastBlockHeight())
+
+	var payloads [][]byte
+	err = keeper.NewWasmSnapshotter(app.CommitMultiStore(), &suite.wasmKeeper).SnapshotExtension(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(payloads, 1)
+
+	var item wasmtypes.SnapshotCode
+	suite.Require().NoError(item.Unmarshal(payloads[0]))
+	suite.Require().Equal(suite.codeID, item.CodeId)
+	suite.Require().True(item.Pinned)
+
+	// restore restores the codes in the VM of a keeper with an empty VM
+	restore := func(format uint32, payloads [][]byte) (*cosmwasm.VM, error) {
+		k := keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, suite.T().TempDir(), nil)
+		suite.T().Cleanup(k.Cleanup)
+
+		vm := k.GetWasmEngine().(*cosmwasm.VM)
+		return vm, keeper.NewWasmSnapshotter(app.CommitMultiStore(), &k).RestoreExtension(height, format, payloadReader(payloads))
+	}
+
+	vm, err := restore(keeper.SnapshotFormat, payloads)
+	suite.Require().NoError(err)
+	_, err = vm.GetCode(suite.codeID)
+	suite.Require().NoError(err)
+	metrics, err := vm.GetMetrics()
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), metrics.ElementsPinnedMemoryCache)
+
+	// the legacy format carrying only the gzipped code can still be restored
+	vm, err = restore(keeper.SnapshotFormatV1, [][]byte{item.CompressedCode})
+	suite.Require().NoError(err)
+	_, err = vm.GetCode(suite.codeID)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		format   uint32
+		malleate func(item *wasmtypes.SnapshotCode)
+		payloads func(item wasmtypes.SnapshotCode) [][]byte
+	}{
+		{
+			"code id does not exist on chain",
+			keeper.SnapshotFormat,
+			func(item *wasmtypes.SnapshotCode) { item.CodeId = make([]byte, 32) },
+			nil,
+		},
+		{
+			"pinned status does not match",
+			keeper.SnapshotFormat,
+			func(item *wasmtypes.SnapshotCode) { item.Pinned = false },
+			nil,
+		},
+		{
+			"code is not gzipped",
+			keeper.SnapshotFormat,
+			func(item *wasmtypes.SnapshotCode) { item.CompressedCode = []byte("wasm") },
+			nil,
+		},
+		{
+			"code stored on chain is missing",
+			keeper.SnapshotFormat,
+			nil,
+			func(_ wasmtypes.SnapshotCode) [][]byte { return nil },
+		},
+		{
+			"invalid item",
+			keeper.SnapshotFormat,
+			nil,
+			func(_ wasmtypes.SnapshotCode) [][]byte { return [][]byte{[]byte("invalid")} },
+		},
+		{
+			"legacy code is not gzipped",
+			keeper.SnapshotFormatV1,
+			nil,
+			func(_ wasmtypes.SnapshotCode) [][]byte { return [][]byte{[]byte("wasm")} },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			invalidItem := item
+			if tc.malleate != nil {
+				tc.malleate(&invalidItem)
+			}
+
+			payloads := func(item wasmtypes.SnapshotCode) [][]byte {
+				payload, err := item.Marshal()
+				suite.Require().NoError(err)
+				return [][]byte{payload}
+			}
+			if tc.payloads != nil {
+				payloads = tc.payloads
+			}
+
+			_, err := restore(tc.format, payloads(invalidItem))
+			suite.Require().Error(err)
+		})
+	}
+
+	// unknown formats cannot be restored
+	_, err = restore(3, payloads)
+	suite.Require().ErrorIs(err, snapshot.ErrUnknownFormat)
+}
@@ -17,15 +17,23 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-// WrappedStore combines two KVStores into one while transparently routing the calls based on key prefix
+// WrappedStore combines two KVStores into one while transparently routing the calls based on key prefix.
+// The second store is read-only. Iterators merge the keys of both stores, with their prefix
 type WrappedStore struct {
 	first  sdk.KVStore
 	second sdk.KVStore
 
 	firstPrefix  []byte
 	secondPrefix []byte
+
+	// legacyRouting routes the reads of keys without either prefix to the second store and their
+	// writes to the first store
+	legacyRouting bool
+	// prefixedWrites records the keys written with the first prefix when legacyRouting is set
+	prefixedWrites map[string]struct{}
 }
 
+// NewWrappedStore returns a store combining the given stores. The keys must start with either prefix.
 func NewWrappedStore(first, second sdk.KVStore, firstPrefix, secondPrefix []byte) WrappedStore {
 	return WrappedStore{
 		first:        first,
@@ -35,20 +43,41 @@ func NewWrappedStore(first, second sdk.KVStore, firstPrefix, secondPrefix []byte
 	}
 }
 
+// newLegacyWrappedStore returns a store combining the given stores, which reads the keys without
+// either prefix from the second store and writes them to the first store, keeping the second store
+// read-only. The writes without prefix of a key already written with the first prefix are ignored,
+// so that the prefixed value takes precedence. Contracts implementing ABI version 1 rely on it.
+func newLegacyWrappedStore(first, second sdk.KVStore, firstPrefix, secondPrefix []byte) WrappedStore {
+	ws := NewWrappedStore(first, second, firstPrefix, secondPrefix)
+	ws.legacyRouting = true
+	ws.prefixedWrites = make(map[string]struct{})
+	return ws
+}
+
 func (ws WrappedStore) Get(key []byte) []byte {
-	return ws.getStore(key).Get(ws.trimPrefix(key))
+	store, storeKey := ws.route(key, false)
+	return store.Get(storeKey)
 }
 
 func (ws WrappedStore) Has(key []byte) bool {
-	return ws.getStore(key).Has(ws.trimPrefix(key))
+	store, storeKey := ws.route(key, false)
+	return store.Has(storeKey)
 }
 
 func (ws WrappedStore) Set(key, value []byte) {
-	ws.getStore(key).Set(ws.trimPrefix(key), value)
+	store, storeKey := ws.route(key, true)
+	if store == nil {
+		return
+	}
+	store.Set(storeKey, value)
 }
 
 func (ws WrappedStore) Delete(key []byte) {
-	ws.getStore(key).Delete(ws.trimPrefix(key))
+	store, storeKey := ws.route(key, true)
+	if store == nil {
+		return
+	}
+	store.Delete(storeKey)
 }
 
 func (ws WrappedStore) GetStoreType() storetypes.StoreType {
@@ -56,11 +85,36 @@ func (ws WrappedStore) GetStoreType() storetypes.StoreType {
 }
 
 func (ws WrappedStore) Iterator(start, end []byte) sdk.Iterator {
-	return ws.getStore(start).Iterator(ws.trimPrefix(start), ws.trimPrefix(end))
+	return ws.iterator(start, end, true)
 }
 
 func (ws WrappedStore) ReverseIterator(start, end []byte) sdk.Iterator {
-	return ws.getStore(start).ReverseIterator(ws.trimPrefix(start), ws.trimPrefix(end))
+	return ws.iterator(start, end, false)
+}
+
+// iterator merges the iterators over the keys of both stores which fall in the range from start
+// to end once prefixed.
+func (ws WrappedStore) iterator(start, end []byte, ascending bool) sdk.Iterator {
+	var iterators []prefixedIterator
+	for _, s := range []struct {
+		store  sdk.KVStore
+		prefix []byte
+	}{{ws.first, ws.firstPrefix}, {ws.second, ws.secondPrefix}} {
+		storeStart, storeEnd, ok := prefixRange(s.prefix, start, end)
+		if !ok {
+			continue
+		}
+
+		var iterator sdk.Iterator
+		if ascending {
+			iterator = s.store.Iterator(storeStart, storeEnd)
+		} else {
+			iterator = s.store.ReverseIterator(storeStart, storeEnd)
+		}
+		iterators = append(iterators, prefixedIterator{Iterator: iterator, prefix: s.prefix})
+	}
+
+	return newMergedIterator(start, end, ascending, iterators...)
 }
 
 func (ws WrappedStore) CacheWrap() storetypes.CacheWrap {
@@ -75,22 +129,159 @@ func (ws WrappedStore) CacheWrapWithListeners(storeKey storetypes.StoreKey, list
 	return cachekv.NewStore(listenkv.NewStore(ws, storeKey, listeners))
 }
 
-func (ws WrappedStore) trimPrefix(key []byte) []byte {
-	if bytes.HasPrefix(key, ws.firstPrefix) {
-		key = bytes.TrimPrefix(key, ws.firstPrefix)
-	} else {
-		key = bytes.TrimPrefix(key, ws.secondPrefix)
+// route returns the store of the key, which is read or written, and the key without its prefix.
+// It panics if the key is written to the read-only second store, and returns a nil store if the
+// write must be ignored.
+func (ws WrappedStore) route(key []byte, write bool) (sdk.KVStore, []byte) {
+	switch {
+	case bytes.HasPrefix(key, ws.firstPrefix):
+		storeKey := key[len(ws.firstPrefix):]
+		if write && ws.legacyRouting {
+			ws.prefixedWrites[string(storeKey)] = struct{}{}
+		}
+		return ws.first, storeKey
+	case bytes.HasPrefix(key, ws.secondPrefix):
+		if write {
+			panic(sdkerrors.Wrapf(ErrReadOnlyStore, "cannot write key %X", key))
+		}
+		return ws.second, key[len(ws.secondPrefix):]
+	case ws.legacyRouting && write:
+		if _, ok := ws.prefixedWrites[string(key)]; ok {
+			return nil, key
+		}
+		return ws.first, key
+	case ws.legacyRouting:
+		return ws.second, key
+	default:
+		panic(sdkerrors.Wrapf(ErrInvalid, "key %X must start with %s or %s", key, ws.firstPrefix, ws.secondPrefix))
+	}
+}
+
+// prefixRange returns the range of the keys of a store such that the keys with the given prefix
+// prepended fall in the range from start to end. It returns false if no such key exists.
+func prefixRange(prefix, start, end []byte) ([]byte, []byte, bool) {
+	var storeStart, storeEnd []byte
+	switch {
+	case bytes.HasPrefix(start, prefix):
+		if len(start) > len(prefix) {
+			storeStart = start[len(prefix):]
+		}
+	case bytes.Compare(start, prefix) > 0:
+		return nil, nil, false
+	}
+
+	switch {
+	case end == nil:
+	case bytes.HasPrefix(end, prefix):
+		storeEnd = end[len(prefix):]
+		if len(storeEnd) == 0 {
+			return nil, nil, false
+		}
+	case bytes.Compare(end, prefix) < 0:
+		return nil, nil, false
 	}
 
-	return key
+	return storeStart, storeEnd, true
 }
 
-func (ws WrappedStore) getStore(key []byte) sdk.KVStore {
-	if bytes.HasPrefix(key, ws.firstPrefix) {
-		return ws.first
+// prefixedIterator iterates over a store, prepending a prefix to the returned keys.
+type prefixedIterator struct {
+	sdk.Iterator
+
+	prefix []byte
+}
+
+func (it prefixedIterator) Key() []byte {
+	return append(append([]byte{}, it.prefix...), it.Iterator.Key()...)
+}
+
+var _ sdk.Iterator = (*mergedIterator)(nil)
+
+// mergedIterator merges the iterators of the two stores of a WrappedStore in ascending or
+// descending order of their prefixed keys.
+type mergedIterator struct {
+	start, end []byte
+	ascending  bool
+
+	iterators []prefixedIterator
+}
+
+func newMergedIterator(start, end []byte, ascending bool, iterators ...prefixedIterator) *mergedIterator {
+	return &mergedIterator{start: start, end: end, ascending: ascending, iterators: iterators}
+}
+
+// current returns the iterator whose key comes next, or nil if all iterators are exhausted.
+func (it *mergedIterator) current() sdk.Iterator {
+	var next *prefixedIterator
+	for i := range it.iterators {
+		iterator := &it.iterators[i]
+		if !iterator.Valid() {
+			continue
+		}
+		if next == nil {
+			next = iterator
+			continue
+		}
+		cmp := bytes.Compare(iterator.Key(), next.Key())
+		if (it.ascending && cmp < 0) || (!it.ascending && cmp > 0) {
+			next = iterator
+		}
+	}
+	if next == nil {
+		return nil
 	}
+	return next
+}
 
-	return ws.second
+func (it *mergedIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+func (it *mergedIterator) Valid() bool {
+	return it.current() != nil
+}
+
+func (it *mergedIterator) Next() {
+	current := it.current()
+	if current == nil {
+		panic("iterator is invalid")
+	}
+	current.Next()
+}
+
+func (it *mergedIterator) Key() []byte {
+	current := it.current()
+	if current == nil {
+		panic("iterator is invalid")
+	}
+	return current.Key()
+}
+
+func (it *mergedIterator) Value() []byte {
+	current := it.current()
+	if current == nil {
+		panic("iterator is invalid")
+	}
+	return current.Value()
+}
+
+func (it *mergedIterator) Error() error {
+	for _, iterator := range it.iterators {
+		if err := iterator.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (it *mergedIterator) Close() error {
+	var err error
+	for _, iterator := range it.iterators {
+		if closeErr := iterator.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
 }
 
 // setClientState stores the client state
@@ -168,3 +359,24 @@ func (s StoreAdapter) Iterator(start, end []byte) wasmvmtypes.Iterator {
 func (s StoreAdapter) ReverseIterator(start, end []byte) wasmvmtypes.Iterator {
 	return s.parent.ReverseIterator(start, end)
 }
+
+var _ wasmvmtypes.KVStore = &ReadOnlyStoreAdapter{}
+
+// ReadOnlyStoreAdapter bridges an SDK store to wasmvm for read-only operations. Writes panic with
+// ErrReadOnlyStore, which makes the contract call fail.
+type ReadOnlyStoreAdapter struct {
+	StoreAdapter
+}
+
+// NewReadOnlyStoreAdapter constructor
+func NewReadOnlyStoreAdapter(s sdk.KVStore) *ReadOnlyStoreAdapter {
+	return &ReadOnlyStoreAdapter{StoreAdapter: *NewStoreAdapter(s)}
+}
+
+func (s ReadOnlyStoreAdapter) Set(key, _ []byte) {
+	panic(sdkerrors.Wrapf(ErrReadOnlyStore, "cannot set key %X", key))
+}
+
+func (s ReadOnlyStoreAdapter) Delete(key []byte) {
+	panic(sdkerrors.Wrapf(ErrReadOnlyStore, "cannot delete key %X", key))
+}
@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *WasmTestSuite) TestWrappedStoreIterator() {
+	var (
+		subjectPrefix    = []byte("subject/")
+		substitutePrefix = []byte("substitute/")
+	)
+
+	testCases := []struct {
+		name       string
+		start, end []byte
+		expKeys    []string
+	}{
+		{
+			"nil start and end iterate over both stores",
+			nil, nil,
+			[]string{"subject/a", "subject/b", "substitute/a", "substitute/c"},
+		},
+		{
+			"range spanning both prefixes",
+			[]byte("subject/b"), []byte("substitute/b"),
+			[]string{"subject/b", "substitute/a"},
+		},
+		{
+			"range within the subject prefix",
+			[]byte("subject/"), []byte("subject/b"),
+			[]string{"subject/a"},
+		},
+		{
+			"range within the substitute prefix",
+			[]byte("substitute/b"), nil,
+			[]string{"substitute/c"},
+		},
+		{
+			"range before both prefixes",
+			nil, []byte("subject/"),
+			nil,
+		},
+		{
+			"range after both prefixes",
+			[]byte("substitute0"), nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			subjectStore := clientKeeper.ClientStore(suite.ctx, "08-wasm-100")
+			substituteStore := clientKeeper.ClientStore(suite.ctx, "08-wasm-101")
+			for _, key := range []string{"a", "b"} {
+				subjectStore.Set([]byte(key), []byte("subject"))
+			}
+			for _, key := range []string{"a", "c"} {
+				substituteStore.Set([]byte(key), []byte("substitute"))
+			}
+
+			store := wasmtypes.NewWrappedStore(subjectStore, substituteStore, subjectPrefix, substitutePrefix)
+
+			keys := iteratorKeys(store.Iterator(tc.start, tc.end))
+			suite.Require().Equal(tc.expKeys, keys)
+
+			reverseKeys := iteratorKeys(store.ReverseIterator(tc.start, tc.end))
+			for i, j := 0, len(reverseKeys)-1; i < j; i, j = i+1, j-1 {
+				reverseKeys[i], reverseKeys[j] = reverseKeys[j], reverseKeys[i]
+			}
+			suite.Require().Equal(tc.expKeys, reverseKeys)
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestWrappedStoreWrites() {
+	suite.SetupWithEmptyClient()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	subjectStore := clientKeeper.ClientStore(suite.ctx, "08-wasm-100")
+	substituteStore := clientKeeper.ClientStore(suite.ctx, "08-wasm-101")
+	substituteStore.Set([]byte("key"), []byte("substitute"))
+
+	store := wasmtypes.NewWrappedStore(subjectStore, substituteStore, []byte("subject/"), []byte("substitute/"))
+
+	store.Set([]byte("subject/key"), []byte("subject"))
+	suite.Require().Equal([]byte("subject"), subjectStore.Get([]byte("key")))
+	suite.Require().Equal([]byte("substitute"), store.Get([]byte("substitute/key")))
+
+	// the substitute store is read-only
+	suite.Require().Panics(func() { store.Set([]byte("substitute/key"), []byte("subject")) })
+	suite.Require().Panics(func() { store.Delete([]byte("substitute/key")) })
+	suite.Require().Equal([]byte("substitute"), substituteStore.Get([]byte("key")))
+
+	// keys must be prefixed
+	suite.Require().Panics(func() { store.Get([]byte("key")) })
+	suite.Require().Panics(func() { store.Set([]byte("key"), []byte("subject")) })
+}
+
+func (suite *WasmTestSuite) TestWrappedStoreLegacyRouting() {
+	suite.SetupWithEmptyClient()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	subjectStore := clientKeeper.ClientStore(suite.ctx, "08-wasm-100")
+	substituteStore := clientKeeper.ClientStore(suite.ctx, "08-wasm-101")
+	substituteStore.Set([]byte("key"), []byte("substitute"))
+
+	// the contract implements ABI version 1 and uses keys without prefix
+	var read []byte
+	engine := wasmtesting.NewMockWasmEngine(func(call wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+		read = call.Store.Get([]byte("key"))
+		call.Store.Set([]byte("key"), []byte("legacy"))
+		call.Store.Set([]byte("subject/prefixed"), []byte("subject"))
+		call.Store.Set([]byte("prefixed"), []byte("legacy"))
+		return &wasmvmtypes.Response{Data: []byte(`{"is_valid":true}`)}, nil
+	})
+	ctx := wasmtypes.WithWasmEngine(suite.ctx, engine)
+	clientState := suite.clientState.(*wasmtypes.ClientState)
+	err := clientState.CheckSubstituteAndUpdateState(ctx, suite.chainA.Codec, subjectStore, substituteStore, clientState)
+	suite.Require().NoError(err)
+
+	// keys without prefix are read from the substitute store and written to the subject store
+	suite.Require().Equal([]byte("substitute"), read)
+	suite.Require().Equal([]byte("legacy"), subjectStore.Get([]byte("key")))
+	suite.Require().Equal([]byte("substitute"), substituteStore.Get([]byte("key")))
+
+	// the prefixed write takes precedence
+	suite.Require().Equal([]byte("subject"), subjectStore.Get([]byte("prefixed")))
+	suite.Require().False(substituteStore.Has([]byte("prefixed")))
+}
+
+func iteratorKeys(iterator sdk.Iterator) []string {
+	defer iterator.Close()
+
+	var keys []string
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, string(iterator.Key()))
+	}
+	return keys
+}
@@ -0,0 +1,47 @@
+;; Minimal CosmWasm contract exporting the instantiate, migrate, execute and query entry points. On
+;; migration it writes the key "migrated" to the contract store. The entry points return an empty
+;; response.
+(module
+  (import "env" "db_write" (func $db_write (param i32 i32)))
+  (memory (export "memory") 2)
+
+  (func (export "interface_version_8"))
+
+  ;; allocate returns a region of the given capacity from a bump allocator whose heap pointer
+  ;; is stored at address 0. Regions are kept 4-byte aligned.
+  (func (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    (local.set $region (i32.load (i32.const 0)))
+    (i32.store offset=0 (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (i32.store (i32.const 0)
+      (i32.add
+        (i32.add (local.get $region) (i32.and (i32.add (local.get $size) (i32.const 3)) (i32.const -4)))
+        (i32.const 12)))
+    (local.get $region))
+
+  (func (export "deallocate") (param i32))
+
+  (func (export "instantiate") (param i32 i32 i32) (result i32)
+    (i32.const 1048))
+
+  (func (export "migrate") (param i32 i32) (result i32)
+    (call $db_write (i32.const 1024) (i32.const 1036))
+    (i32.const 1048))
+
+  (func (export "execute") (param i32 i32 i32) (result i32)
+    (i32.const 1048))
+
+  (func (export "query") (param i32 i32) (result i32)
+    (i32.const 1048))
+
+  ;; the heap pointer, the regions of the key, the value and the response, and their data
+  (data (i32.const 0) "\00\10\00\00")
+  (data (i32.const 1024)
+    "\4c\04\00\00\08\00\00\00\08\00\00\00"
+    "\60\04\00\00\01\00\00\00\01\00\00\00"
+    "\b0\04\00\00\3e\00\00\00\3e\00\00\00")
+  (data (i32.const 1100) "migrated")
+  (data (i32.const 1120) "1")
+  (data (i32.const 1200) "{\22ok\22:{\22messages\22:[],\22attributes\22:[],\22events\22:[],\22data\22:null}}"))
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -8,7 +9,10 @@ import (
 	"testing"
 	"time"
 
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -19,8 +23,10 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v7/modules/core/types"
 	tmclient "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
 	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	"github.com/cosmos/ibc-go/v7/testing/simapp"
@@ -70,7 +76,7 @@ func (suite *WasmTestSuite) SetupWasmTendermint() {
 	suite.coordinator.CommitNBlocks(suite.chainA, 2)
 	suite.coordinator.CommitNBlocks(suite.chainB, 2)
 
-	suite.ctx = suite.chainA.GetContext().WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	suite.ctx = suite.chainA.App.GetWasmKeeper().ClientContext(suite.chainA.GetContext().WithBlockGasMeter(sdk.NewInfiniteGasMeter()))
 	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.ctx, "08-wasm-0")
 
 	err := os.MkdirAll("tmp", 0o755)
@@ -123,6 +129,36 @@ func SetupTestingWithChannel() (ibctesting.TestingApp, map[string]json.RawMessag
 		genesisState[exported.ModuleName] = appState[exported.ModuleName]
 	}
 
+	// the 08-wasm client of the fixture must use a code of the 08-wasm genesis
+	code, err := os.ReadFile("test_data/ics10_grandpa_cw.wasm.gz")
+	if err != nil {
+		panic(err)
+	}
+	uncompressedCode, err := wasmtypes.Uncompress(code, wasmtypes.DefaultMaxWasmCodeSize)
+	if err != nil {
+		panic(err)
+	}
+	codeID := sha256.Sum256(uncompressedCode)
+
+	var ibcGenesis ibctypes.GenesisState
+	encCdc.Marshaler.MustUnmarshalJSON(genesisState[exported.ModuleName], &ibcGenesis)
+	for i, client := range ibcGenesis.ClientGenesis.Clients {
+		clientState, err := clienttypes.UnpackClientState(client.ClientState)
+		if err != nil {
+			panic(err)
+		}
+		if wasmClientState, ok := clientState.(*wasmtypes.ClientState); ok {
+			wasmClientState.CodeId = codeID[:]
+			if ibcGenesis.ClientGenesis.Clients[i].ClientState, err = clienttypes.PackClientState(wasmClientState); err != nil {
+				panic(err)
+			}
+		}
+	}
+	genesisState[exported.ModuleName] = encCdc.Marshaler.MustMarshalJSON(&ibcGenesis)
+	genesisState[wasmtypes.ModuleName] = encCdc.Marshaler.MustMarshalJSON(&wasmtypes.GenesisState{
+		Contracts: []wasmtypes.GenesisContract{{CodeHash: codeID[:], ContractCode: code}},
+	})
+
 	return app, genesisState
 }
 
@@ -176,12 +212,19 @@ func (suite *WasmTestSuite) CommonSetupTest() {
 	err = json.Unmarshal(testData, &suite.testData)
 	suite.Require().NoError(err)
 
-	suite.ctx = suite.chainA.GetContext().WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	suite.ctx = suite.chainA.App.GetWasmKeeper().ClientContext(suite.chainA.GetContext().WithBlockGasMeter(sdk.NewInfiniteGasMeter()))
 	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.ctx, "08-wasm-0")
 
 	err = os.MkdirAll("tmp", 0o755)
 	suite.Require().NoError(err)
 	suite.wasmKeeper = suite.chainA.App.GetWasmKeeper()
+
+	// the code may already be stored by the genesis of the chain
+	if gs := suite.wasmKeeper.ExportGenesis(suite.ctx); len(gs.Contracts) > 0 {
+		suite.codeID = gs.Contracts[0].CodeHash
+		return
+	}
+
 	wasmContract, err := os.ReadFile("test_data/ics10_grandpa_cw.wasm.gz")
 	suite.Require().NoError(err)
 
@@ -209,6 +252,33 @@ func (suite *WasmTestSuite) TestPushNewWasmCodeWithErrors() {
 	suite.Require().Error(err)
 }
 
+func (suite *WasmTestSuite) TestPushNewWasmCodeCapabilities() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+	code := wasmtesting.WasmModule("instantiate", "execute", "query")
+	checksum := sha256.Sum256(code)
+
+	// code requiring a capability the VM does not support is removed from the VM and not stored
+	engine := wasmtesting.NewMockWasmEngine(nil)
+	engine.RequiredCapabilities = "iterator,stargate"
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, "", nil, keeper.WithWasmEngine(engine))
+	_, err := k.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, code))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmInvalidCode)
+	suite.Require().Equal([]cosmwasm.Checksum{checksum[:]}, engine.Removed)
+	_, err = k.WasmCode(suite.ctx, &wasmtypes.WasmCodeQuery{CodeId: hex.EncodeToString(checksum[:])})
+	suite.Require().Error(err)
+
+	// code requiring supported capabilities is stored
+	engine = wasmtesting.NewMockWasmEngine(nil)
+	engine.RequiredCapabilities = "iterator"
+	k = keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, "", nil, keeper.WithWasmEngine(engine))
+	response, err := k.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, code))
+	suite.Require().NoError(err)
+	suite.Require().Equal(checksum[:], response.CodeId)
+	suite.Require().Empty(engine.Removed)
+}
+
 func (suite *WasmTestSuite) TestUpdateWasmCodeId() {
 	suite.SetupWithChannel()
 	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -269,6 +339,243 @@ func (suite *WasmTestSuite) TestUpdateWasmCodeIdWithErrors() {
 	suite.Require().Error(err)
 }
 
+func (suite *WasmTestSuite) TestPinCodes() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, err := suite.wasmKeeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(signer, suite.codeID))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.wasmKeeper.IsPinnedCode(suite.ctx, suite.codeID))
+
+	metrics, err := suite.vmMetrics()
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), metrics.ElementsPinnedMemoryCache)
+
+	var pinnedCodes [][]byte
+	suite.wasmKeeper.IteratePinnedCodes(suite.ctx, func(codeID []byte) bool {
+		pinnedCodes = append(pinnedCodes, codeID)
+		return false
+	})
+	suite.Require().Equal([][]byte{suite.codeID}, pinnedCodes)
+
+	// pinned codes are pinned again on startup
+	suite.Require().NoError(suite.wasmKeeper.InitializePinnedCodes(suite.ctx))
+
+	_, err = suite.wasmKeeper.UnpinCodes(suite.ctx, wasmtypes.NewMsgUnpinCodes(signer, suite.codeID))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.wasmKeeper.IsPinnedCode(suite.ctx, suite.codeID))
+
+	metrics, err = suite.vmMetrics()
+	suite.Require().NoError(err)
+	suite.Require().Zero(metrics.ElementsPinnedMemoryCache)
+}
+
+func (suite *WasmTestSuite) TestPinCodesWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// test invalid signer
+	_, err := suite.wasmKeeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes("invalid", suite.codeID))
+	suite.Require().Error(err)
+
+	_, err = suite.wasmKeeper.UnpinCodes(suite.ctx, wasmtypes.NewMsgUnpinCodes("invalid", suite.codeID))
+	suite.Require().Error(err)
+
+	// test non-existing code id
+	nonExistingCodeId := make([]byte, 32)
+	_, err = suite.wasmKeeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(signer, suite.codeID, nonExistingCodeId))
+	suite.Require().Error(err)
+
+	_, err = suite.wasmKeeper.UnpinCodes(suite.ctx, wasmtypes.NewMsgUnpinCodes(signer, nonExistingCodeId))
+	suite.Require().Error(err)
+
+	// test invalid messages
+	suite.Require().Error(wasmtypes.NewMsgPinCodes(signer).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgPinCodes(signer, []byte{1}).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUnpinCodes(signer).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUnpinCodes(signer, suite.codeID, []byte{1}).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgUnpinCodes(signer, suite.codeID).ValidateBasic())
+}
+
+func (suite *WasmTestSuite) TestRemoveWasmCode() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{clientID}, suite.clientsByCode(suite.codeID))
+
+	// code used by a client cannot be removed
+	_, err = suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(signer, suite.codeID))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeInUse)
+
+	data, err := os.ReadFile("test_data/ics07_tendermint_cw.wasm.gz")
+	suite.Require().NoError(err)
+	response, err := suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, data))
+	suite.Require().NoError(err)
+	newCodeId := response.CodeId
+
+	_, err = suite.wasmKeeper.UpdateWasmCodeId(suite.ctx, wasmtypes.NewMsgUpdateWasmCodeId(signer, newCodeId, clientID))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.clientsByCode(suite.codeID))
+	suite.Require().Equal([]string{clientID}, suite.clientsByCode(newCodeId))
+
+	_, err = suite.wasmKeeper.PinCodes(suite.ctx, wasmtypes.NewMsgPinCodes(signer, suite.codeID))
+	suite.Require().NoError(err)
+
+	_, err = suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(signer, suite.codeID))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.wasmKeeper.IsPinnedCode(suite.ctx, suite.codeID))
+
+	_, err = suite.wasmKeeper.WasmCode(suite.ctx, &wasmtypes.WasmCodeQuery{CodeId: hex.EncodeToString(suite.codeID)})
+	suite.Require().Error(err)
+
+	// the code is removed from the VM at the end of the block
+	_, err = suite.wasmKeeper.GetWasmEngine().GetCode(suite.codeID)
+	suite.Require().NoError(err)
+
+	suite.wasmKeeper.RemoveCodesFromVM(suite.ctx)
+	_, err = suite.wasmKeeper.GetWasmEngine().GetCode(suite.codeID)
+	suite.Require().Error(err)
+
+	metrics, err := suite.vmMetrics()
+	suite.Require().NoError(err)
+	suite.Require().Zero(metrics.ElementsPinnedMemoryCache)
+
+	// removed code can be pushed again
+	data, err = os.ReadFile("test_data/ics10_grandpa_cw.wasm.gz")
+	suite.Require().NoError(err)
+	_, err = suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, data))
+	suite.Require().NoError(err)
+}
+
+func (suite *WasmTestSuite) TestRemoveWasmCodeWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// test invalid signer
+	_, err := suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode("invalid", suite.codeID))
+	suite.Require().Error(err)
+
+	// test non-existing code id
+	_, err = suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(signer, make([]byte, 32)))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+
+	// test invalid messages
+	suite.Require().Error(wasmtypes.NewMsgRemoveWasmCode(signer, nil).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgRemoveWasmCode(signer, []byte{1}).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgRemoveWasmCode(signer, suite.codeID).ValidateBasic())
+}
+
+func (suite *WasmTestSuite) TestIndexClientCodes() {
+	suite.SetupWithEmptyClient()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientKeeper.SetClientState(suite.ctx, "08-wasm-5", suite.clientState)
+	suite.Require().Empty(suite.clientsByCode(suite.codeID))
+
+	suite.wasmKeeper.IndexClientCodes(suite.ctx)
+	suite.Require().Equal([]string{"08-wasm-5"}, suite.clientsByCode(suite.codeID))
+}
+
+func (suite *WasmTestSuite) TestMigrateContract() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	clientID, err := clientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+
+	data, err := os.ReadFile("test_data/ics07_tendermint_cw.wasm.gz")
+	suite.Require().NoError(err)
+	response, err := suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, data))
+	suite.Require().NoError(err)
+	noMigrateCodeId := response.CodeId
+
+	data, err = os.ReadFile("test_data/migrate_cw.wasm.gz")
+	suite.Require().NoError(err)
+	response, err = suite.wasmKeeper.PushNewWasmCode(suite.ctx, wasmtypes.NewMsgPushNewWasmCode(signer, data))
+	suite.Require().NoError(err)
+	newCodeId := response.CodeId
+
+	// the switch is rejected if the new code cannot migrate the contract
+	_, err = suite.wasmKeeper.MigrateContract(suite.ctx, wasmtypes.NewMsgMigrateContract(signer, clientID, noMigrateCodeId, []byte("{}")))
+	suite.Require().ErrorIs(err, wasmtypes.ErrMigrateContractFailed)
+
+	clientState, found := clientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.codeID, clientState.(*wasmtypes.ClientState).CodeId)
+	suite.Require().Equal([]string{clientID}, suite.clientsByCode(suite.codeID))
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.wasmKeeper.MigrateContract(ctx, wasmtypes.NewMsgMigrateContract(signer, clientID, newCodeId, []byte("{}")))
+	suite.Require().NoError(err)
+
+	clientState, found = clientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(newCodeId, clientState.(*wasmtypes.ClientState).CodeId)
+	suite.Require().Equal([]byte("1"), clientKeeper.ClientStore(suite.ctx, clientID).Get([]byte("migrated")))
+	suite.Require().Empty(suite.clientsByCode(suite.codeID))
+	suite.Require().Equal([]string{clientID}, suite.clientsByCode(newCodeId))
+
+	event := ctx.EventManager().Events()[0]
+	suite.Require().Equal(clienttypes.EventTypeMigrateContract, event.Type)
+	suite.Require().Equal([]abci.EventAttribute{
+		{Key: clienttypes.AttributeKeyClientID, Value: clientID},
+		{Key: clienttypes.AttributeKeyOldWasmCodeID, Value: hex.EncodeToString(suite.codeID)},
+		{Key: clienttypes.AttributeKeyNewWasmCodeID, Value: hex.EncodeToString(newCodeId)},
+	}, event.Attributes)
+}
+
+func (suite *WasmTestSuite) TestMigrateContractWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.ctx, suite.clientState, &suite.consensusState)
+	suite.Require().NoError(err)
+
+	// test invalid signer
+	_, err = suite.wasmKeeper.MigrateContract(suite.ctx, wasmtypes.NewMsgMigrateContract("invalid", clientID, suite.codeID, []byte("{}")))
+	suite.Require().Error(err)
+
+	// test non-existing code id
+	_, err = suite.wasmKeeper.MigrateContract(suite.ctx, wasmtypes.NewMsgMigrateContract(signer, clientID, make([]byte, 32), []byte("{}")))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeIDNotFound)
+
+	// test non-existing client id
+	_, err = suite.wasmKeeper.MigrateContract(suite.ctx, wasmtypes.NewMsgMigrateContract(signer, "08-wasm-100", suite.codeID, []byte("{}")))
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+
+	// test invalid messages
+	suite.Require().Error(wasmtypes.NewMsgMigrateContract(signer, clientID, []byte{1}, []byte("{}")).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgMigrateContract(signer, "invalid\n", suite.codeID, []byte("{}")).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgMigrateContract(signer, clientID, suite.codeID, nil).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgMigrateContract(signer, clientID, suite.codeID, []byte("{")).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgMigrateContract(signer, clientID, suite.codeID, []byte("{}")).ValidateBasic())
+}
+
+// clientsByCode returns the identifiers of the clients indexed for the code.
+func (suite *WasmTestSuite) clientsByCode(codeID []byte) []string {
+	var clientIDs []string
+	suite.wasmKeeper.IterateClientsByCode(suite.ctx, codeID, func(clientID string) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+	return clientIDs
+}
+
+// vmMetrics returns the metrics of the VM used by the wasm keeper.
+func (suite *WasmTestSuite) vmMetrics() (*wasmvmtypes.Metrics, error) {
+	vm, ok := suite.wasmKeeper.GetWasmEngine().(*cosmwasm.VM)
+	suite.Require().True(ok)
+	return vm.GetMetrics()
+}
+
+// clientContext returns the current context of chain A, which carries the engine of its wasm keeper.
+func (suite *WasmTestSuite) clientContext() sdk.Context {
+	return suite.chainA.App.GetWasmKeeper().ClientContext(suite.chainA.GetContext())
+}
+
 func (suite *WasmTestSuite) TestQueryWasmCode() {
 	// test invalid query request
 	_, err := suite.wasmKeeper.WasmCode(suite.ctx, &wasmtypes.WasmCodeQuery{})
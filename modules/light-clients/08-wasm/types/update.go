@@ -2,116 +2,135 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 var _ exported.ClientState = (*ClientState)(nil)
 
-type verifyClientMessagePayload struct {
-	VerifyClientMessage verifyClientMessageInnerPayload `json:"verify_client_message"`
-}
-
-type clientMessageConcretePayloadClientMessage struct {
-	Header       *Header       `json:"header,omitempty"`
-	Misbehaviour *Misbehaviour `json:"misbehaviour,omitempty"`
-}
-type verifyClientMessageInnerPayload struct {
-	ClientMessage clientMessageConcretePayloadClientMessage `json:"client_message"`
-}
-
 // VerifyClientMessage must verify a ClientMessage. A ClientMessage could be a Header, Misbehaviour, or batch update.
 // It must handle each type of ClientMessage appropriately. Calls to CheckForMisbehaviour, UpdateState, and UpdateStateOnMisbehaviour
 // will assume that the content of the ClientMessage has been verified and can be trusted. An error should be returned
 // if the ClientMessage fails to verify.
 func (c ClientState) VerifyClientMessage(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) error {
-	clientMsgConcrete := clientMessageConcretePayloadClientMessage{
-		Header:       nil,
-		Misbehaviour: nil,
-	}
-	switch clientMsg := clientMsg.(type) {
-	case *Header:
-		clientMsgConcrete.Header = clientMsg
-	case *Misbehaviour:
-		clientMsgConcrete.Misbehaviour = clientMsg
-	}
-	inner := verifyClientMessageInnerPayload{
-		ClientMessage: clientMsgConcrete,
-	}
-	payload := verifyClientMessagePayload{
-		VerifyClientMessage: inner,
+	msg := QueryMsg{
+		VerifyClientMessage: &VerifyClientMessageMsg{
+			ClientMessage: NewContractClientMessage(clientMsg),
+		},
 	}
-	_, err := call[contractResult](payload, &c, ctx, clientStore)
+	_, err := callQuery[contractResult](msg, &c, ctx, clientStore)
 	return err
 }
 
-type updateStatePayload struct {
-	UpdateState updateStateInnerPayload `json:"update_state"`
-}
-type updateStateInnerPayload struct {
-	ClientMessage clientMessageConcretePayload `json:"client_message"`
+// updateStateResult is the result of the update state entry point, which carries the heights of
+// the consensus states written by the contract.
+type updateStateResult struct {
+	contractResult
+	Heights []ContractHeight `json:"heights,omitempty"`
 }
 
-type clientMessageConcretePayload struct {
-	Header       *Header       `json:"header,omitempty"`
-	Misbehaviour *Misbehaviour `json:"misbehaviour,omitempty"`
-}
-
-// Client state and new consensus states are updated in the store by the contract
+// Client state and new consensus states are updated in the store by the contract, which reports the
+// heights of the consensus states it wrote. At least one height must be reported, and each reported
+// height must be non-zero, reported once and the height of a consensus state written by the contract
+// during the call. Otherwise the changes of the contract are reverted. Version 1 of the contract ABI
+// predates the reported heights, so the heights of the consensus states written by a contract
+// implementing it are used if it reports none.
 func (c ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, store sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
 	header, ok := clientMsg.(*Header)
 	if !ok {
 		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
 	}
-	
-	payload := updateStatePayload{
-		UpdateState: updateStateInnerPayload{
-			ClientMessage: clientMessageConcretePayload{
-				Header: header,
-			},
+
+	msg := SudoMsg{
+		UpdateState: &UpdateStateMsg{
+			ClientMessage: NewContractClientMessage(header),
 		},
 	}
 
-	_, err := call[contractResult](payload, &c, ctx, store)
+	var heights []exported.Height
+	err := withValidatedWrites(store, func(store trackingStore) error {
+		version := getContractABIVersion(store)
+		output, err := callWithABIVersion[updateStateResult](msg, &c, ctx, store, version)
+		if err != nil {
+			return err
+		}
+
+		if version == 1 && len(output.Heights) == 0 {
+			output.Heights = writtenConsensusHeights(store)
+		}
+
+		heights, err = reportedHeights(store, output.Heights)
+		return err
+	}, c.CodeId)
 	if err != nil {
 		panic(err)
 	}
 
-	return []exported.Height{clientMsg.(*Header).Height}
+	return heights
 }
 
-type updateStateOnMisbehaviourPayload struct {
-	UpdateStateOnMisbehaviour updateStateOnMisbehaviourInnerPayload `json:"update_state_on_misbehaviour"`
-}
-type updateStateOnMisbehaviourInnerPayload struct {
-	ClientMessage clientMessageConcretePayloadClientMessage `json:"client_message"`
-}
+// reportedHeights returns the heights reported by the contract after an update, checking that a
+// consensus state was written through the given store at each of them.
+func reportedHeights(store trackingStore, contractHeights []ContractHeight) ([]exported.Height, error) {
+	if len(contractHeights) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidReportedHeight, "contract reported no height")
+	}
 
-// UpdateStateOnMisbehaviour should perform appropriate state changes on a client state given that misbehaviour has been detected and verified
-// Client state is updated in the store by contract
-func (c ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) {
-	clientMsgConcrete := clientMessageConcretePayloadClientMessage{
-		Header:       nil,
-		Misbehaviour: nil,
+	heights := make([]exported.Height, len(contractHeights))
+	reported := make(map[clienttypes.Height]bool, len(contractHeights))
+	for i, contractHeight := range contractHeights {
+		height := contractHeight.Height()
+		switch {
+		case height.IsZero():
+			return nil, sdkerrors.Wrap(ErrInvalidReportedHeight, "contract reported a zero height")
+		case reported[height]:
+			return nil, sdkerrors.Wrapf(ErrInvalidReportedHeight, "contract reported height %s more than once", height)
+		case !store.written(host.ConsensusStateKey(height)):
+			return nil, sdkerrors.Wrapf(ErrInvalidReportedHeight, "contract reported height %s without writing a consensus state", height)
+		}
+
+		reported[height] = true
+		heights[i] = height
 	}
-	switch clientMsg := clientMsg.(type) {
-	case *Header:
-		clientMsgConcrete.Header = clientMsg
-	case *Misbehaviour:
-		clientMsgConcrete.Misbehaviour = clientMsg
+
+	return heights, nil
+}
+
+// writtenConsensusHeights returns the heights of the consensus states written through the given
+// store, in ascending order.
+func writtenConsensusHeights(store trackingStore) []ContractHeight {
+	var heights []clienttypes.Height
+	for key := range store.touched {
+		if isConsensusStateKey([]byte(key)) && store.written([]byte(key)) {
+			heights = append(heights, clienttypes.MustParseHeight(strings.TrimPrefix(key, host.KeyConsensusStatePrefix+"/")))
+		}
 	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i].LT(heights[j]) })
 
-	inner := updateStateOnMisbehaviourInnerPayload{
-		ClientMessage: clientMsgConcrete,
+	contractHeights := make([]ContractHeight, len(heights))
+	for i, height := range heights {
+		contractHeights[i] = NewContractHeight(height)
 	}
+	return contractHeights
+}
 
-	payload := updateStateOnMisbehaviourPayload{
-		UpdateStateOnMisbehaviour: inner,
+// UpdateStateOnMisbehaviour should perform appropriate state changes on a client state given that misbehaviour has been detected and verified
+// Client state is updated in the store by contract
+func (c ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) {
+	msg := SudoMsg{
+		UpdateStateOnMisbehaviour: &UpdateStateOnMisbehaviourMsg{
+			ClientMessage: NewContractClientMessage(clientMsg),
+		},
 	}
 
-	_, err := call[contractResult](payload, &c, ctx, clientStore)
+	_, err := call[contractResult](msg, &c, ctx, clientStore)
 	if err != nil {
 		panic(err)
 	}
@@ -2,8 +2,10 @@ package types_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"time"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -11,6 +13,7 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
 	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	ibctestingmock "github.com/cosmos/ibc-go/v7/testing/mock"
@@ -379,7 +382,7 @@ func (suite *WasmTestSuite) TestVerifyHeaderTendermint() {
 
 			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
-			err = clientState.VerifyClientMessage(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, header)
+			err = clientState.VerifyClientMessage(suite.clientContext(), suite.chainA.App.AppCodec(), clientStore, header)
 
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
@@ -578,7 +581,7 @@ func (suite *WasmTestSuite) TestUpdateStateTendermint() {
 			clientStore = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
 			if tc.expPass {
-				consensusHeights = clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
+				consensusHeights = clientState.UpdateState(suite.clientContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
 
 				header := clientMessage.(*wasmtypes.Header)
 				var eHeader exported.ClientMessage
@@ -604,7 +607,7 @@ func (suite *WasmTestSuite) TestUpdateStateTendermint() {
 
 			} else {
 				suite.Require().Panics(func() {
-					clientState.UpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
+					clientState.UpdateState(suite.clientContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
 				})
 			}
 
@@ -770,12 +773,14 @@ func (suite *WasmTestSuite) TestUpdateStateGrandpa() {
 
 				newClientState := clienttypes.MustUnmarshalClientState(suite.chainA.Codec, clientStateBz)
 
-				suite.Require().Len(consensusHeights, 1)
-				suite.Require().Equal(clienttypes.Height{
-					RevisionNumber: 2000,
-					RevisionHeight: 39,
-				}, consensusHeights[0])
-				suite.Require().Equal(consensusHeights[0], newClientState.(*wasmtypes.ClientState).LatestHeight)
+				// the contract implements version 1 of the ABI and reports no heights, the heights of the
+				// consensus states it wrote for the parachain headers are returned
+				suite.Require().Equal([]exported.Height{
+					clienttypes.NewHeight(2000, 34),
+					clienttypes.NewHeight(2000, 37),
+					clienttypes.NewHeight(2000, 39),
+				}, consensusHeights)
+				suite.Require().Equal(consensusHeights[2], newClientState.(*wasmtypes.ClientState).LatestHeight)
 			} else {
 				suite.Require().Panics(func() {
 					clientState.UpdateState(suite.ctx, suite.chainA.Codec, suite.store, clientMsg)
@@ -877,7 +882,7 @@ func (suite *WasmTestSuite) TestUpdateStateOnMisbehaviourTendermint() {
 			clientMessage := &wasmtypes.Misbehaviour{
 				Data: wasmData,
 			}
-			clientState.UpdateStateOnMisbehaviour(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
+			clientState.UpdateStateOnMisbehaviour(suite.clientContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
 
 			if tc.expPass {
 				clientStateBz := clientStore.Get(host.ClientStateKey())
@@ -891,9 +896,150 @@ func (suite *WasmTestSuite) TestUpdateStateOnMisbehaviourTendermint() {
 				suite.Require().NoError(err)
 				suite.Require().Equal(misbehaviourHeader.GetHeight(), innerClientState.(*ibctm.ClientState).FrozenHeight)
 
-				status := clientState.Status(suite.chainA.GetContext(), clientStore, suite.chainA.Codec)
+				status := clientState.Status(suite.clientContext(), clientStore, suite.chainA.Codec)
 				suite.Require().Equal(exported.Frozen, status)
 			}
 		})
 	}
 }
+
+func (suite *WasmTestSuite) TestUpdateStateReportedHeights() {
+	// the contract implements the given ABI version, writes consensus states at the written heights
+	// and reports the reported heights
+	var (
+		version           uint64
+		written, reported []clienttypes.Height
+		malleateStore     func(store sdk.KVStore)
+	)
+	header := &wasmtypes.Header{Height: clienttypes.NewHeight(1, 10)}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expHeights []exported.Height
+		expPass    bool
+	}{
+		{
+			"success: reported heights are returned",
+			func() {
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 8), clienttypes.NewHeight(1, 9), clienttypes.NewHeight(1, 10)}
+				reported = written
+			},
+			[]exported.Height{clienttypes.NewHeight(1, 8), clienttypes.NewHeight(1, 9), clienttypes.NewHeight(1, 10)},
+			true,
+		},
+		{
+			"success: reported height differs from the header height",
+			func() {
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 12)}
+				reported = written
+			},
+			[]exported.Height{clienttypes.NewHeight(1, 12)},
+			true,
+		},
+		{
+			"success: heights of the written consensus states are returned if a version 1 contract reports no height",
+			func() {
+				version = 1
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 10), clienttypes.NewHeight(1, 8)}
+			},
+			[]exported.Height{clienttypes.NewHeight(1, 8), clienttypes.NewHeight(1, 10)},
+			true,
+		},
+		{
+			"failure: no height is reported",
+			func() {
+				written = []clienttypes.Height{header.Height}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: version 1 contract reports no height and writes no consensus state",
+			func() {
+				version = 1
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: zero height is reported",
+			func() {
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 10), clienttypes.ZeroHeight()}
+				reported = written
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: height is reported twice",
+			func() {
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 10)}
+				reported = []clienttypes.Height{clienttypes.NewHeight(1, 10), clienttypes.NewHeight(1, 10)}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: no consensus state at a reported height",
+			func() {
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 9)}
+				reported = []clienttypes.Height{clienttypes.NewHeight(1, 9), clienttypes.NewHeight(1, 10)}
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: consensus state at a reported height was not written by the contract",
+			func() {
+				malleateStore = func(store sdk.KVStore) {
+					store.Set(host.ConsensusStateKey(clienttypes.NewHeight(1, 9)), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, &wasmtypes.ConsensusState{Data: []byte("data"), Timestamp: 1}))
+				}
+				written = []clienttypes.Height{clienttypes.NewHeight(1, 10)}
+				reported = []clienttypes.Height{clienttypes.NewHeight(1, 9), clienttypes.NewHeight(1, 10)}
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWithEmptyClient()
+			version, written, reported, malleateStore = 2, nil, nil, func(sdk.KVStore) {}
+			tc.malleate()
+
+			consensusState := clienttypes.MustMarshalConsensusState(suite.chainA.Codec, &wasmtypes.ConsensusState{Data: []byte("data"), Timestamp: 1})
+			engine := wasmtesting.NewMockWasmEngine(func(call wasmtesting.ContractCall) (*wasmvmtypes.Response, error) {
+				for _, height := range written {
+					call.Store.Set(host.ConsensusStateKey(height), consensusState)
+				}
+
+				data, err := json.Marshal(map[string]any{"is_valid": true, "heights": reported})
+				if err != nil {
+					return nil, err
+				}
+				return &wasmvmtypes.Response{Data: data}, nil
+			})
+			ctx := wasmtypes.WithWasmEngine(suite.ctx, engine)
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, "08-wasm-0")
+			store.Set([]byte(wasmtypes.KeyContractABIVersion), sdk.Uint64ToBigEndian(version))
+			malleateStore(store)
+
+			if tc.expPass {
+				heights := suite.clientState.UpdateState(ctx, suite.chainA.Codec, store, header)
+				suite.Require().Equal(tc.expHeights, heights)
+			} else {
+				suite.Require().Panics(func() {
+					suite.clientState.UpdateState(ctx, suite.chainA.Codec, store, header)
+				})
+
+				// the consensus states written by the contract are reverted
+				for _, height := range written {
+					suite.Require().False(store.Has(host.ConsensusStateKey(height)))
+				}
+			}
+		})
+	}
+}
@@ -9,17 +9,6 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-type verifyUpgradeAndUpdateStatePayload struct {
-	VerifyUpgradeAndUpdateStateMsg verifyUpgradeAndUpdateStateMsgPayload `json:"verify_upgrade_and_update_state"`
-}
-
-type verifyUpgradeAndUpdateStateMsgPayload struct {
-	UpgradeClientState         exported.ClientState    `json:"upgrade_client_state"`
-	UpgradeConsensusState      exported.ConsensusState `json:"upgrade_consensus_state"`
-	ProofUpgradeClient         []byte                  `json:"proof_upgrade_client"`
-	ProofUpgradeConsensusState []byte                  `json:"proof_upgrade_consensus_state"`
-}
-
 // VerifyUpgradeAndUpdateState, on a successful verification expects the contract to update
 // the new client state, consensus state, and any other client metadata
 func (c ClientState) VerifyUpgradeAndUpdateState(
@@ -50,16 +39,16 @@ func (c ClientState) VerifyUpgradeAndUpdateState(
 		return sdkerrors.Wrap(err, "could not retrieve consensus state for lastHeight")
 	}
 
-	payload := verifyUpgradeAndUpdateStatePayload{
-		VerifyUpgradeAndUpdateStateMsg: verifyUpgradeAndUpdateStateMsgPayload{
-			UpgradeClientState:         newClient,
-			UpgradeConsensusState:      newConsState,
+	msg := SudoMsg{
+		VerifyUpgradeAndUpdateState: &VerifyUpgradeAndUpdateStateMsg{
+			UpgradeClientState:         NewContractClientState(wasmUpgradeClientState),
+			UpgradeConsensusState:      NewContractConsensusState(wasmUpgradeConsState),
 			ProofUpgradeClient:         proofUpgradeClient,
 			ProofUpgradeConsensusState: proofUpgradeConsState,
 		},
 	}
 
-	_, err = call[contractResult](payload, &c, ctx, store)
+	_, err = call[contractResult](msg, &c, ctx, store)
 
 	return err
 }
@@ -36,9 +36,17 @@ func (suite *WasmTestSuite) TestVerifyUpgradeGrandpa() {
 	}{
 		{
 			"successful upgrade",
-			func() {},
+			func() {
+				// the stubbed code does not set a consensus state at the incremented height
+				suite.store.Set(host.ConsensusStateKey(clientState.GetLatestHeight().Increment()), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, upgradedConsState))
+			},
 			true,
 		},
+		{
+			"unsuccessful upgrade: no consensus state at the new latest height",
+			func() {},
+			false,
+		},
 		{
 			"unsuccessful upgrade: invalid new client state",
 			func() {
@@ -618,7 +626,7 @@ func (suite *WasmTestSuite) TestVerifyUpgradeTendermint() {
 		clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
 		err = cs.VerifyUpgradeAndUpdateState(
-			suite.chainA.GetContext(),
+			suite.clientContext(),
 			suite.chainA.Codec,
 			clientStore,
 			upgradedClient,
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsComplete returns true if all bytes of the code have been received.
+func (u UploadSession) IsComplete() bool {
+	return u.Received == u.CodeSize
+}
+
+// ValidateUploadSize checks that the size of the code to upload is positive and does not exceed
+// the given maximum size.
+func ValidateUploadSize(size, maxCodeSize uint64) error {
+	if size == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpload, "upload size cannot be zero")
+	}
+	if size > maxCodeSize {
+		return sdkerrors.Wrapf(ErrWasmCodeTooLarge, "upload size %d exceeds the maximum size %d", size, maxCodeSize)
+	}
+	return nil
+}
@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/wasm/v1/upload.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A session uploading a wasm code in chunks
+type UploadSession struct {
+	// the identifier of the session
+	UploadId uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// the address which began the session and may send its chunks
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// the expected code id, which is the sha256 checksum of the uncompressed code
+	Checksum []byte `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// the expected size in bytes of the uploaded code, which may be gzip compressed
+	CodeSize uint64 `protobuf:"varint,4,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+	// the number of bytes received so far
+	Received uint64 `protobuf:"varint,5,opt,name=received,proto3" json:"received,omitempty"`
+	// the number of chunks received so far
+	Chunks uint32 `protobuf:"varint,6,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// the block height after which the session expires and its chunks are deleted
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// an optional label of the code
+	Label string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	// an optional version of the code
+	Version string `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *UploadSession) Reset()         { *m = UploadSession{} }
+func (m *UploadSession) String() string { return proto.CompactTextString(m) }
+func (*UploadSession) ProtoMessage()    {}
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7e55523d9972abb, []int{0}
+}
+func (m *UploadSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSession.Merge(m, src)
+}
+func (m *UploadSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadSession proto.InternalMessageInfo
+
+func (m *UploadSession) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
+	}
+	return 0
+}
+
+func (m *UploadSession) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *UploadSession) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *UploadSession) GetCodeSize() uint64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
+func (m *UploadSession) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *UploadSession) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *UploadSession) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *UploadSession) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *UploadSession) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UploadSession)(nil), "ibc.lightclients.wasm.v1.UploadSession")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/wasm/v1/upload.proto", fileDescriptor_c7e55523d9972abb)
+}
+
+var fileDescriptor_c7e55523d9972abb = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
+	0x18, 0xc4, 0xeb, 0xfe, 0xaf, 0xd5, 0x2e, 0x16, 0x42, 0x16, 0x48, 0x51, 0x04, 0x42, 0xca, 0xd2,
+	0x98, 0x8a, 0x01, 0x36, 0x24, 0x26, 0x58, 0x53, 0x31, 0xc0, 0x52, 0x25, 0xce, 0xa7, 0xc6, 0x6a,
+	0x52, 0x47, 0xb1, 0x13, 0x68, 0x9f, 0x80, 0x91, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0xda, 0x17, 0x41,
+	0x8e, 0x1b, 0xc4, 0xf8, 0x3b, 0xdf, 0x77, 0xf2, 0xe9, 0xf0, 0x95, 0x88, 0x38, 0x4b, 0xc5, 0x32,
+	0xd1, 0x3c, 0x15, 0xb0, 0xd6, 0x8a, 0xbd, 0x85, 0x2a, 0x63, 0xd5, 0x8c, 0x95, 0x79, 0x2a, 0xc3,
+	0xd8, 0xcf, 0x0b, 0xa9, 0x25, 0xa1, 0x22, 0xe2, 0xfe, 0x7f, 0x9b, 0x6f, 0x6c, 0x7e, 0x35, 0xbb,
+	0xf8, 0x68, 0xe3, 0xc9, 0x73, 0x6d, 0x9d, 0x83, 0x52, 0x42, 0xae, 0xc9, 0x39, 0x1e, 0xd9, 0xdb,
+	0x85, 0x88, 0x29, 0x72, 0x91, 0xd7, 0x0d, 0x86, 0x56, 0x78, 0x8a, 0x09, 0xc5, 0x03, 0x5e, 0x40,
+	0xa8, 0x65, 0x41, 0xdb, 0x2e, 0xf2, 0x46, 0x41, 0x83, 0xe4, 0x0c, 0x0f, 0x79, 0x02, 0x7c, 0xa5,
+	0xca, 0x8c, 0x76, 0x5c, 0xe4, 0x8d, 0x83, 0x3f, 0x36, 0x91, 0x5c, 0xc6, 0xb0, 0x50, 0x62, 0x0b,
+	0xb4, 0x6b, 0x23, 0x8d, 0x30, 0x17, 0x5b, 0x30, 0x87, 0x05, 0x70, 0x10, 0x15, 0xc4, 0xb4, 0x67,
+	0xdf, 0x1a, 0x26, 0xa7, 0xb8, 0xcf, 0x93, 0x72, 0xbd, 0x52, 0xb4, 0xef, 0x22, 0x6f, 0x12, 0x1c,
+	0x89, 0x5c, 0xe2, 0x09, 0xbc, 0xe7, 0xa2, 0xd8, 0x2c, 0x12, 0x30, 0xad, 0xe8, 0xc0, 0x45, 0x5e,
+	0x27, 0x18, 0x5b, 0xf1, 0xb1, 0xd6, 0xc8, 0x09, 0xee, 0xa5, 0x61, 0x04, 0x29, 0x1d, 0xd6, 0x3f,
+	0xb5, 0x60, 0x1a, 0x54, 0x50, 0x98, 0xa6, 0x74, 0x64, 0x1b, 0x1c, 0xf1, 0xe1, 0xe5, 0x6b, 0xef,
+	0xa0, 0xdd, 0xde, 0x41, 0x3f, 0x7b, 0x07, 0x7d, 0x1e, 0x9c, 0xd6, 0xee, 0xe0, 0xb4, 0xbe, 0x0f,
+	0x4e, 0xeb, 0xf5, 0x7e, 0x29, 0x74, 0x52, 0x46, 0x3e, 0x97, 0x19, 0xe3, 0x52, 0x65, 0x52, 0x31,
+	0x11, 0xf1, 0xe9, 0x52, 0xb2, 0xea, 0x96, 0x65, 0x32, 0x2e, 0x53, 0x50, 0x76, 0x85, 0x69, 0x33,
+	0xc3, 0xf5, 0xdd, 0xb4, 0x5e, 0x42, 0x6f, 0x72, 0x50, 0x51, 0xbf, 0x9e, 0xe1, 0xe6, 0x77, 0x00,
+	0xa5, 0x01, 0xa5, 0x2c, 0xaf, 0x01, 0x00, 0x00,
+}
+
+func (m *UploadSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Received != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CodeSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.CodeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UploadId != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UploadSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		n += 1 + sovUpload(uint64(m.UploadId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	if m.CodeSize != 0 {
+		n += 1 + sovUpload(uint64(m.CodeSize))
+	}
+	if m.Received != 0 {
+		n += 1 + sovUpload(uint64(m.Received))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovUpload(uint64(m.Chunks))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovUpload(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	return n
+}
+
+func sovUpload(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpload(x uint64) (n int) {
+	return sovUpload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UploadSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
+			}
+			m.UploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
+			}
+			m.CodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpload(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpload
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpload
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpload
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpload        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpload          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpload = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,204 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"os"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v7/testing/simapp"
+)
+
+// splitChunks splits the data into the given number of chunks.
+func splitChunks(data []byte, n int) [][]byte {
+	chunks := make([][]byte, 0, n)
+	size := (len(data) + n - 1) / n
+	for len(data) > size {
+		chunks = append(chunks, data[:size])
+		data = data[size:]
+	}
+	return append(chunks, data)
+}
+
+func (suite *WasmTestSuite) TestUpload() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	uploader := suite.chainA.SenderAccount.GetAddress().String()
+	app := suite.chainA.App.(*simapp.SimApp)
+
+	data, err := os.ReadFile("test_data/migrate_cw.wasm.gz")
+	suite.Require().NoError(err)
+	code, err := wasmtypes.Uncompress(data, wasmtypes.DefaultMaxWasmCodeSize)
+	suite.Require().NoError(err)
+	checksum := sha256.Sum256(code)
+
+	// the gzipped code is uploaded in chunks by an account whose checksum is approved
+	_, err = suite.wasmKeeper.ApproveChecksums(suite.ctx, wasmtypes.NewMsgApproveChecksums(signer, checksum[:]))
+	suite.Require().NoError(err)
+
+	msg := wasmtypes.NewMsgBeginUpload(uploader, checksum[:], uint64(len(data)))
+	msg.Label = "migrate"
+	suite.Require().NoError(msg.ValidateBasic())
+	res, err := suite.wasmKeeper.BeginUpload(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.UploadId)
+
+	chunks := splitChunks(data, 3)
+	for i, chunk := range chunks {
+		_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(uploader, res.UploadId, uint32(i), chunk))
+		suite.Require().NoError(err)
+	}
+
+	uploadRes, err := suite.wasmKeeper.Upload(suite.ctx, &wasmtypes.UploadQuery{UploadId: res.UploadId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmtypes.UploadSession{
+		UploadId:     res.UploadId,
+		Creator:      uploader,
+		Checksum:     checksum[:],
+		CodeSize:     uint64(len(data)),
+		Received:     uint64(len(data)),
+		Chunks:       uint32(len(chunks)),
+		ExpiryHeight: suite.ctx.BlockHeight() + wasmtypes.DefaultUploadExpiryBlocks,
+		Label:        "migrate",
+	}, uploadRes.Upload)
+	suite.Require().True(uploadRes.Upload.IsComplete())
+
+	finalizeRes, err := suite.wasmKeeper.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(uploader, res.UploadId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(checksum[:], finalizeRes.CodeId)
+	suite.Require().False(suite.wasmKeeper.IsApprovedChecksum(suite.ctx, checksum[:]))
+
+	info, found := suite.wasmKeeper.GetCodeInfo(suite.ctx, finalizeRes.CodeId)
+	suite.Require().True(found)
+	suite.Require().Equal(uploader, info.Creator)
+	suite.Require().Equal("migrate", info.Label)
+	suite.Require().Equal(uint64(len(code)), info.CodeSize)
+
+	// the session and its chunks are deleted
+	_, found = suite.wasmKeeper.GetUpload(suite.ctx, res.UploadId)
+	suite.Require().False(found)
+	store := suite.ctx.KVStore(app.GetKey(wasmtypes.StoreKey))
+	suite.Require().False(store.Has(wasmtypes.UploadChunk(res.UploadId, 0)))
+	suite.Require().False(store.Has(wasmtypes.UploadExpiry(uploadRes.Upload.ExpiryHeight, res.UploadId)))
+
+	// the authority uploads any code and the identifiers are not reused
+	_, err = suite.wasmKeeper.RemoveWasmCode(suite.ctx, wasmtypes.NewMsgRemoveWasmCode(signer, finalizeRes.CodeId))
+	suite.Require().NoError(err)
+	res, err = suite.wasmKeeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(signer, checksum[:], uint64(len(code))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.UploadId)
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 0, code))
+	suite.Require().NoError(err)
+	finalizeRes, err = suite.wasmKeeper.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(signer, res.UploadId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(checksum[:], finalizeRes.CodeId)
+}
+
+func (suite *WasmTestSuite) TestPruneExpiredUploads() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	app := suite.chainA.App.(*simapp.SimApp)
+	checksum := sha256.Sum256([]byte("code"))
+
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(wasmtypes.StoreKey), signer, "", nil,
+		keeper.WithWasmEngine(wasmtesting.NewMockWasmEngine(nil)),
+	)
+	params := wasmtypes.DefaultParams()
+	params.UploadExpiryBlocks = 10
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	res, err := k.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(signer, checksum[:], 2))
+	suite.Require().NoError(err)
+	_, err = k.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 0, []byte{1}))
+	suite.Require().NoError(err)
+	otherRes, err := k.BeginUpload(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight()+1), wasmtypes.NewMsgBeginUpload(signer, checksum[:], 2))
+	suite.Require().NoError(err)
+
+	// the sessions are kept until their expiry height
+	k.PruneExpiredUploads(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 9))
+	_, found := k.GetUpload(suite.ctx, res.UploadId)
+	suite.Require().True(found)
+
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+	k.PruneExpiredUploads(ctx)
+	_, found = k.GetUpload(ctx, res.UploadId)
+	suite.Require().False(found)
+	suite.Require().False(ctx.KVStore(app.GetKey(wasmtypes.StoreKey)).Has(wasmtypes.UploadChunk(res.UploadId, 0)))
+	_, found = k.GetUpload(ctx, otherRes.UploadId)
+	suite.Require().True(found)
+
+	// expired sessions cannot be continued
+	_, err = k.UploadChunk(ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 1, []byte{1}))
+	suite.Require().ErrorIs(err, wasmtypes.ErrUploadNotFound)
+
+	// the upload expiry must be positive
+	params.UploadExpiryBlocks = 0
+	suite.Require().ErrorIs(k.SetParams(suite.ctx, params), wasmtypes.ErrInvalidParams)
+}
+
+func (suite *WasmTestSuite) TestUploadWithErrors() {
+	suite.SetupWithEmptyClient()
+	signer := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	uploader := suite.chainA.SenderAccount.GetAddress().String()
+
+	data, err := os.ReadFile("test_data/migrate_cw.wasm.gz")
+	suite.Require().NoError(err)
+	checksum := sha256.Sum256([]byte("code"))
+
+	// test code which exists or is not approved
+	_, err = suite.wasmKeeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(signer, suite.codeID, 1))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeExists)
+	_, err = suite.wasmKeeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(uploader, checksum[:], 1))
+	suite.Require().ErrorIs(err, wasmtypes.ErrChecksumNotApproved)
+
+	// test code exceeding the maximum size
+	_, err = suite.wasmKeeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(signer, checksum[:], wasmtypes.DefaultMaxWasmCodeSize+1))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmCodeTooLarge)
+
+	res, err := suite.wasmKeeper.BeginUpload(suite.ctx, wasmtypes.NewMsgBeginUpload(signer, checksum[:], uint64(len(data))))
+	suite.Require().NoError(err)
+
+	// test unknown session and other signer
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId+1, 0, data))
+	suite.Require().ErrorIs(err, wasmtypes.ErrUploadNotFound)
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(uploader, res.UploadId, 0, data))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.wasmKeeper.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(uploader, res.UploadId))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// test chunks out of order or exceeding the code size
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 1, data))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidUpload)
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 0, append(data, 1)))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidUpload)
+
+	// test incomplete session
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 0, data[:1]))
+	suite.Require().NoError(err)
+	_, err = suite.wasmKeeper.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(signer, res.UploadId))
+	suite.Require().ErrorIs(err, wasmtypes.ErrInvalidUpload)
+
+	// test code with another checksum
+	_, err = suite.wasmKeeper.UploadChunk(suite.ctx, wasmtypes.NewMsgUploadChunk(signer, res.UploadId, 1, data[1:]))
+	suite.Require().NoError(err)
+	_, err = suite.wasmKeeper.FinalizeUpload(suite.ctx, wasmtypes.NewMsgFinalizeUpload(signer, res.UploadId))
+	suite.Require().ErrorIs(err, wasmtypes.ErrWasmInvalidCodeID)
+
+	// test invalid query requests
+	_, err = suite.wasmKeeper.Upload(suite.ctx, nil)
+	suite.Require().Error(err)
+	_, err = suite.wasmKeeper.Upload(suite.ctx, &wasmtypes.UploadQuery{UploadId: res.UploadId + 1})
+	suite.Require().Error(err)
+
+	// test invalid messages
+	suite.Require().Error(wasmtypes.NewMsgBeginUpload(signer, []byte{1}, 1).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgBeginUpload(signer, checksum[:], 0).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgUploadChunk(signer, 1, 0, []byte{1}).ValidateBasic())
+	suite.Require().Error(wasmtypes.NewMsgUploadChunk(signer, 1, 0, nil).ValidateBasic())
+	suite.Require().NoError(wasmtypes.NewMsgFinalizeUpload(signer, 1).ValidateBasic())
+}
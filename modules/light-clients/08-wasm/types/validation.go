@@ -1,14 +1,186 @@
 package types
 
-const MaxWasmSize = 3 * 1024 * 1024
+import (
+	"strconv"
+	"strings"
 
-func ValidateWasmCode(code []byte) (bool, error) {
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	wasmMagic         = "\x00asm"
+	wasmVersion       = "\x01\x00\x00\x00"
+	wasmExportSection = 7
+	wasmFuncExport    = 0
+)
+
+// ValidateWasmCode checks that the code is not empty, does not exceed the given maximum size and
+// exports the entry points of a light client contract, as checked by ValidateContractExports.
+func ValidateWasmCode(code []byte, maxCodeSize uint64) (bool, error) {
 	if len(code) == 0 {
 		return false, ErrWasmEmptyCode
 	}
-	if len(code) > MaxWasmSize {
+	if uint64(len(code)) > maxCodeSize {
 		return false, ErrWasmCodeTooLarge
 	}
 
+	if _, err := ValidateContractExports(code); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
+
+// ValidateContractExports checks that the code exports the instantiate and query entry points,
+// and returns the ABI version the contract declares by exporting a function whose name is
+// ContractABIVersionExportPrefix followed by the version. Contracts declaring ABI version 2 or
+// later must export the sudo entry point. Contracts implementing version 1, which was defined
+// before contracts declared their version, must export the execute entry point and are assumed
+// to implement version 1 if they declare no version. Undeclared codes are accepted because the
+// contracts written before the ABI was versioned, like the tendermint and grandpa contracts used
+// in the tests of this module, export no version and could not be uploaded again otherwise. The
+// declared version is recorded with the code, and contracts reporting another version on
+// instantiation or migration are rejected.
+func ValidateContractExports(code []byte) (uint64, error) {
+	exports, err := wasmFuncExports(code)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrWasmInvalidCode, "cannot read exports: %s", err)
+	}
+
+	for _, entryPoint := range []string{"instantiate", "query"} {
+		if !exports[entryPoint] {
+			return 0, sdkerrors.Wrapf(ErrWasmInvalidCode, "missing %s entry point", entryPoint)
+		}
+	}
+
+	var versions []uint64
+	for name := range exports {
+		if !strings.HasPrefix(name, ContractABIVersionExportPrefix) {
+			continue
+		}
+		version, err := strconv.ParseUint(strings.TrimPrefix(name, ContractABIVersionExportPrefix), 10, 64)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "invalid ABI version export %s", name)
+		}
+		versions = append(versions, version)
+	}
+
+	switch {
+	case len(versions) > 1:
+		return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "contract declares %d ABI versions", len(versions))
+	case len(versions) == 0 && exports["execute"]:
+		return 1, nil
+	case len(versions) == 0:
+		return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "contract does not declare its ABI version with a %s<version> export", ContractABIVersionExportPrefix)
+	}
+
+	version := versions[0]
+	if version < 1 || version > ContractABIVersion {
+		return 0, sdkerrors.Wrapf(ErrUnsupportedABIVersion, "contract declares ABI version %d, expected at most %d", version, ContractABIVersion)
+	}
+
+	entryPoint := "sudo"
+	if version == 1 {
+		entryPoint = "execute"
+	}
+	if !exports[entryPoint] {
+		return 0, sdkerrors.Wrapf(ErrWasmInvalidCode, "missing %s entry point of ABI version %d", entryPoint, version)
+	}
+
+	return version, nil
+}
+
+// DeclaredABIVersion returns the ABI version declared by the code, as returned by
+// ValidateContractExports, or zero if the code does not export the entry points of a light client
+// contract, which codes stored before their exports were checked may not.
+func DeclaredABIVersion(code []byte) uint64 {
+	version, err := ValidateContractExports(code)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// wasmFuncExports returns the names of the functions exported by the wasm code, read from its
+// export section without compiling it.
+func wasmFuncExports(code []byte) (map[string]bool, error) {
+	r := &wasmReader{code: code}
+	if string(r.bytes(len(wasmMagic))) != wasmMagic || string(r.bytes(len(wasmVersion))) != wasmVersion {
+		return nil, r.fail("not a wasm module")
+	}
+
+	exports := make(map[string]bool)
+	for r.err == nil && r.offset < len(code) {
+		id := r.byte()
+		section := &wasmReader{code: r.bytes(int(r.uleb()))}
+		if r.err != nil || id != wasmExportSection {
+			continue
+		}
+
+		for count := section.uleb(); count > 0 && section.err == nil; count-- {
+			name := section.bytes(int(section.uleb()))
+			kind := section.byte()
+			section.uleb() // index
+			if kind == wasmFuncExport {
+				exports[string(name)] = true
+			}
+		}
+		if section.err != nil {
+			return nil, section.err
+		}
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	return exports, nil
+}
+
+// wasmReader reads the values of a wasm binary, recording the first error.
+type wasmReader struct {
+	code   []byte
+	offset int
+	err    error
+}
+
+func (r *wasmReader) fail(msg string) error {
+	if r.err == nil {
+		r.err = sdkerrors.Wrapf(ErrWasmInvalidCode, "%s at offset %d", msg, r.offset)
+	}
+	return r.err
+}
+
+func (r *wasmReader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || n > len(r.code)-r.offset {
+		r.fail("unexpected end of code")
+		return nil
+	}
+	b := r.code[r.offset : r.offset+n]
+	r.offset += n
+	return b
+}
+
+func (r *wasmReader) byte() byte {
+	b := r.bytes(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+// uleb reads an unsigned LEB128 integer of at most 32 bits.
+func (r *wasmReader) uleb() uint32 {
+	var value uint32
+	for shift := 0; shift < 35; shift += 7 {
+		b := r.byte()
+		if r.err != nil {
+			return 0
+		}
+		value |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value
+		}
+	}
+	r.fail("invalid integer")
+	return 0
+}
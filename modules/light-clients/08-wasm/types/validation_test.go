@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	wasmtesting "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
+)
+
+func (suite *WasmTestSuite) TestValidateWasmCode() {
+	testCases := []struct {
+		name   string
+		code   []byte
+		expErr error
+	}{
+		{
+			"success: contract implementing version 1 without declaring it",
+			wasmtesting.WasmModule("instantiate", "execute", "query"),
+			nil,
+		},
+		{
+			"success: contract declaring version 1",
+			wasmtesting.WasmModule("instantiate", "execute", "query", "ibc_wasm_abi_version_1"),
+			nil,
+		},
+		{
+			"success: contract declaring version 2",
+			wasmtesting.WasmModule("instantiate", "sudo", "query", "ibc_wasm_abi_version_2"),
+			nil,
+		},
+		{
+			"failure: empty code",
+			nil,
+			wasmtypes.ErrWasmEmptyCode,
+		},
+		{
+			"failure: code too large",
+			make([]byte, wasmtypes.DefaultMaxWasmCodeSize+1),
+			wasmtypes.ErrWasmCodeTooLarge,
+		},
+		{
+			"failure: not a wasm module",
+			[]byte("code"),
+			wasmtypes.ErrWasmInvalidCode,
+		},
+		{
+			"failure: truncated module",
+			wasmtesting.WasmModule("instantiate", "execute", "query")[:20],
+			wasmtypes.ErrWasmInvalidCode,
+		},
+		{
+			"failure: missing instantiate entry point",
+			wasmtesting.WasmModule("execute", "query"),
+			wasmtypes.ErrWasmInvalidCode,
+		},
+		{
+			"failure: missing query entry point",
+			wasmtesting.WasmModule("instantiate", "sudo", "ibc_wasm_abi_version_2"),
+			wasmtypes.ErrWasmInvalidCode,
+		},
+		{
+			"failure: version 2 contract without sudo entry point",
+			wasmtesting.WasmModule("instantiate", "execute", "query", "ibc_wasm_abi_version_2"),
+			wasmtypes.ErrWasmInvalidCode,
+		},
+		{
+			"failure: contract without execute entry point declaring no version",
+			wasmtesting.WasmModule("instantiate", "sudo", "query"),
+			wasmtypes.ErrUnsupportedABIVersion,
+		},
+		{
+			"failure: unsupported version",
+			wasmtesting.WasmModule("instantiate", "sudo", "query", "ibc_wasm_abi_version_3"),
+			wasmtypes.ErrUnsupportedABIVersion,
+		},
+		{
+			"failure: version zero",
+			wasmtesting.WasmModule("instantiate", "execute", "query", "ibc_wasm_abi_version_0"),
+			wasmtypes.ErrUnsupportedABIVersion,
+		},
+		{
+			"failure: invalid version",
+			wasmtesting.WasmModule("instantiate", "sudo", "query", "ibc_wasm_abi_version_two"),
+			wasmtypes.ErrUnsupportedABIVersion,
+		},
+		{
+			"failure: several versions",
+			wasmtesting.WasmModule("instantiate", "execute", "sudo", "query", "ibc_wasm_abi_version_1", "ibc_wasm_abi_version_2"),
+			wasmtypes.ErrUnsupportedABIVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			valid, err := wasmtypes.ValidateWasmCode(tc.code, wasmtypes.DefaultMaxWasmCodeSize)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(valid)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(valid)
+			}
+		})
+	}
+}
@@ -4,18 +4,12 @@ import (
 	cosmwasm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-var (
-	WasmVM        *cosmwasm.VM
-	VMGasRegister = NewDefaultWasmGasRegister()
-)
-
 type queryResponse struct {
-	Status          exported.Status               `json:"status,omitempty"`
-	GenesisMetadata []clienttypes.GenesisMetadata `json:"genesis_metadata,omitempty"`
+	Status          exported.Status           `json:"status,omitempty"`
+	GenesisMetadata []ContractGenesisMetadata `json:"genesis_metadata,omitempty"`
 }
 
 type ClientCreateRequest struct {
@@ -42,11 +36,15 @@ func (r contractResult) Error() string {
 	return r.ErrorMsg
 }
 
-// Calls vm.Init with appropriate arguments
-func initContract(codeID []byte, ctx sdk.Context, store sdk.KVStore) (*wasmvmtypes.Response, error) {
-	sdkGasMeter := ctx.GasMeter()
-	multipliedGasMeter := NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
+// newContractCall returns the engine carried by the context, the environment of the contract, the
+// gas meter charging the gas used by the VM to the context at the rate of the gas register, and the
+// querier answering the chain queries of the contract, which are shared by all contract calls
+func newContractCall(ctx sdk.Context, gasRegister WasmGasRegister) (WasmEngine, wasmvmtypes.Env, MultipliedGasMeter, cosmwasm.Querier, error) {
+	engine, err := WasmEngineFromContext(ctx)
+	if err != nil {
+		return nil, wasmvmtypes.Env{}, MultipliedGasMeter{}, nil, err
+	}
+
 	chainID := ctx.BlockHeader().ChainID
 	height := ctx.BlockHeader().Height
 	// safety checks before casting below
@@ -68,81 +66,91 @@ func initContract(codeID []byte, ctx sdk.Context, store sdk.KVStore) (*wasmvmtyp
 		},
 	}
 
+	return engine, env, NewMultipliedGasMeter(ctx.GasMeter(), gasRegister), querierFromContext(ctx), nil
+}
+
+// Calls the Instantiate method of the engine carried by the context with appropriate arguments
+func initContract(codeID []byte, ctx sdk.Context, store sdk.KVStore) (*wasmvmtypes.Response, error) {
+	gasRegister := paramsFromContext(ctx).GasRegister()
+	engine, env, gasMeter, querier, err := newContractCall(ctx, gasRegister)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := gasRegister.runtimeGasForContract(ctx)
+
 	msgInfo := wasmvmtypes.MessageInfo{
 		Sender: "",
 		Funds:  nil,
 	}
 
 	initMsg := []byte("{}")
-	ctx.GasMeter().ConsumeGas(VMGasRegister.NewContractInstanceCosts(len(initMsg)), "Loading CosmWasm module: instantiate")
-	response, gasUsed, err := WasmVM.Instantiate(codeID, env, msgInfo, initMsg, NewStoreAdapter(store), cosmwasm.GoAPI{}, nil, multipliedGasMeter, gasLimit, costJSONDeserialization)
-	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+	ctx.GasMeter().ConsumeGas(gasRegister.NewContractInstanceCosts(len(initMsg)), "Loading CosmWasm module: instantiate")
+	response, gasUsed, err := engine.Instantiate(codeID, env, msgInfo, initMsg, NewStoreAdapter(store), cosmwasmAPI, querier, gasMeter, gasLimit, gasRegister.deserializationCost())
+	gasRegister.consumeRuntimeGas(ctx, gasUsed)
 	return response, err
 }
 
-// Calls vm.Execute with internally constructed Gas meter and environment
-func callContract(codeID []byte, ctx sdk.Context, store sdk.KVStore, msg []byte) (*wasmvmtypes.Response, error) {
-	sdkGasMeter := ctx.GasMeter()
-	multipliedGasMeter := NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
-	chainID := ctx.BlockHeader().ChainID
-	height := ctx.BlockHeader().Height
-	// safety checks before casting below
-	if height < 0 {
-		panic("Block height must never be negative")
-	}
-	nsec := ctx.BlockTime().UnixNano()
-	if nsec < 0 {
-		panic("Block (unix) time must never be negative ")
-	}
-	env := wasmvmtypes.Env{
-		Block: wasmvmtypes.BlockInfo{
-			Height:  uint64(height),
-			Time:    uint64(nsec),
-			ChainID: chainID,
-		},
-		Contract: wasmvmtypes.ContractInfo{
-			Address: "",
-		},
+// Calls the Execute method of the engine carried by the context with internally constructed Gas meter and environment.
+// The store is passed through an adapter, so that the caller decides whether the contract can write to it
+func callContract(codeID []byte, ctx sdk.Context, store cosmwasm.KVStore, msg []byte) (*wasmvmtypes.Response, error) {
+	gasRegister := paramsFromContext(ctx).GasRegister()
+	engine, env, gasMeter, querier, err := newContractCall(ctx, gasRegister)
+	if err != nil {
+		return nil, err
 	}
+	gasLimit := gasRegister.runtimeGasForContract(ctx)
 
 	msgInfo := wasmvmtypes.MessageInfo{
 		Sender: "",
 		Funds:  nil,
 	}
-	ctx.GasMeter().ConsumeGas(VMGasRegister.InstantiateContractCosts(len(msg)), "Loading CosmWasm module: execute")
-	resp, gasUsed, err := WasmVM.Execute(codeID, env, msgInfo, msg, NewStoreAdapter(store), cosmwasm.GoAPI{}, nil, multipliedGasMeter, gasLimit, costJSONDeserialization)
-	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+	ctx.GasMeter().ConsumeGas(gasRegister.InstantiateContractCosts(len(msg)), "Loading CosmWasm module: execute")
+	resp, gasUsed, err := engine.Execute(codeID, env, msgInfo, msg, store, cosmwasmAPI, querier, gasMeter, gasLimit, gasRegister.deserializationCost())
+	gasRegister.consumeRuntimeGas(ctx, gasUsed)
 	return resp, err
 }
 
-// Call vm.Query
-func queryContractWithStore(codeID cosmwasm.Checksum, ctx sdk.Context, store sdk.KVStore, msg []byte) ([]byte, error) {
-	sdkGasMeter := ctx.GasMeter()
-	multipliedGasMeter := NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
-	chainID := ctx.BlockHeader().ChainID
-	height := ctx.BlockHeader().Height
-	// safety checks before casting below
-	if height < 0 {
-		panic("Block height must never be negative")
+// Calls the Sudo method of the engine carried by the context with internally constructed Gas meter and environment
+func sudoContract(codeID []byte, ctx sdk.Context, store sdk.KVStore, msg []byte) (*wasmvmtypes.Response, error) {
+	gasRegister := paramsFromContext(ctx).GasRegister()
+	engine, env, gasMeter, querier, err := newContractCall(ctx, gasRegister)
+	if err != nil {
+		return nil, err
 	}
-	nsec := ctx.BlockTime().UnixNano()
-	if nsec < 0 {
-		panic("Block (unix) time must never be negative ")
+	gasLimit := gasRegister.runtimeGasForContract(ctx)
+
+	ctx.GasMeter().ConsumeGas(gasRegister.InstantiateContractCosts(len(msg)), "Loading CosmWasm module: sudo")
+	resp, gasUsed, err := engine.Sudo(codeID, env, msg, NewStoreAdapter(store), cosmwasmAPI, querier, gasMeter, gasLimit, gasRegister.deserializationCost())
+	gasRegister.consumeRuntimeGas(ctx, gasUsed)
+	return resp, err
+}
+
+// Calls the Query method of the engine carried by the context. The contract cannot write to the store
+func queryContractWithStore(codeID cosmwasm.Checksum, ctx sdk.Context, store sdk.KVStore, msg []byte) ([]byte, error) {
+	gasRegister := paramsFromContext(ctx).GasRegister()
+	engine, env, gasMeter, querier, err := newContractCall(ctx, gasRegister)
+	if err != nil {
+		return nil, err
 	}
-	env := wasmvmtypes.Env{
-		Block: wasmvmtypes.BlockInfo{
-			Height:  uint64(height),
-			Time:    uint64(nsec),
-			ChainID: chainID,
-		},
-		Contract: wasmvmtypes.ContractInfo{
-			Address: "",
-		},
+	gasLimit := gasRegister.runtimeGasForContract(ctx)
+
+	ctx.GasMeter().ConsumeGas(gasRegister.InstantiateContractCosts(len(msg)), "Loading CosmWasm module: query")
+	resp, gasUsed, err := engine.Query(codeID, env, msg, NewReadOnlyStoreAdapter(store), cosmwasmAPI, querier, gasMeter, gasLimit, gasRegister.deserializationCost())
+	gasRegister.consumeRuntimeGas(ctx, gasUsed)
+	return resp, err
+}
+
+// Calls the Migrate method of the engine carried by the context with internally constructed Gas meter and environment
+func migrateContract(codeID []byte, ctx sdk.Context, store sdk.KVStore, msg []byte) (*wasmvmtypes.Response, error) {
+	gasRegister := paramsFromContext(ctx).GasRegister()
+	engine, env, gasMeter, querier, err := newContractCall(ctx, gasRegister)
+	if err != nil {
+		return nil, err
 	}
-	ctx.GasMeter().ConsumeGas(VMGasRegister.InstantiateContractCosts(len(msg)), "Loading CosmWasm module: query")
-	resp, gasUsed, err := WasmVM.Query(codeID, env, msg, NewStoreAdapter(store), cosmwasm.GoAPI{}, nil, multipliedGasMeter, gasLimit, costJSONDeserialization)
-	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+	gasLimit := gasRegister.runtimeGasForContract(ctx)
+
+	ctx.GasMeter().ConsumeGas(gasRegister.InstantiateContractCosts(len(msg)), "Loading CosmWasm module: migrate")
+	resp, gasUsed, err := engine.Migrate(codeID, env, msg, NewStoreAdapter(store), cosmwasmAPI, querier, gasMeter, gasLimit, gasRegister.deserializationCost())
+	gasRegister.consumeRuntimeGas(ctx, gasUsed)
 	return resp, err
 }
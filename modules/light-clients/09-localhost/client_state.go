@@ -174,7 +174,7 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 }
 
 // ExportMetadata is a no-op for the 09-localhost client.
-func (cs ClientState) ExportMetadata(_ sdk.KVStore) []exported.GenesisMetadata {
+func (cs ClientState) ExportMetadata(_ sdk.Context, _ sdk.KVStore) []exported.GenesisMetadata {
 	return nil
 }
 
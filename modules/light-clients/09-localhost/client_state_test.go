@@ -418,7 +418,7 @@ func (suite *LocalhostTestSuite) TestUpdateState() {
 
 func (suite *LocalhostTestSuite) TestExportMetadata() {
 	clientState := localhost.NewClientState(clienttypes.NewHeight(1, 10))
-	suite.Require().Nil(clientState.ExportMetadata(nil))
+	suite.Require().Nil(clientState.ExportMetadata(suite.chain.GetContext(), nil))
 }
 
 func (suite *LocalhostTestSuite) TestCheckSubstituteAndUpdateState() {
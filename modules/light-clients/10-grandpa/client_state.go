@@ -1,76 +1,271 @@
 package grandpa
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 var _ exported.ClientState = (*ClientState)(nil)
 
-// The implementation of ClientState is needed to register the protobuf type, but none of the functions is actually
-// used anywhere
-
-func (m *ClientState) ClientType() string {
-	panic("unimplemented")
+// ClientType is grandpa.
+func (cs *ClientState) ClientType() string {
+	return exported.Grandpa
 }
 
-func (m *ClientState) GetLatestHeight() exported.Height {
-	panic("unimplemented")
+// GetLatestHeight returns the latest parachain height tracked by the client. The
+// revision number of the height is the parachain id.
+func (cs *ClientState) GetLatestHeight() exported.Height {
+	return clienttypes.NewHeight(uint64(cs.ParaId), uint64(cs.LatestParaHeight))
 }
 
-func (m *ClientState) Validate() error {
-	panic("unimplemented")
+// IsFrozen returns true if the client has been frozen due to misbehaviour.
+func (cs *ClientState) IsFrozen() bool {
+	return cs.XFrozenHeight != nil
 }
 
-func (m *ClientState) Status(_ types.Context, _ types.KVStore, _ codec.BinaryCodec) exported.Status {
-	panic("unimplemented")
-}
+// Validate performs a basic validation of the client state fields.
+func (cs *ClientState) Validate() error {
+	if len(cs.LatestRelayHash) != hashLength {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "latest relay hash must be %d bytes, got %d", hashLength, len(cs.LatestRelayHash))
+	}
+	if _, ok := RelayChain_name[int32(cs.RelayChain)]; !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "unknown relay chain %d", cs.RelayChain)
+	}
+	if cs.ParaId == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "para id cannot be zero")
+	}
+	if cs.LatestParaHeight == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "latest para height cannot be zero")
+	}
 
-func (m *ClientState) ExportMetadata(_ types.KVStore) []exported.GenesisMetadata {
-	panic("unimplemented")
+	return validateAuthorities(cs.CurrentAuthorities)
 }
 
-func (m *ClientState) ZeroCustomFields() exported.ClientState {
-	panic("unimplemented")
-}
+// validateAuthorities checks that the authority set is not empty and only contains
+// distinct ed25519 public keys with a non zero weight.
+func validateAuthorities(authorities []*Authority) error {
+	if len(authorities) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAuthoritySet, "authority set cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(authorities))
+	for i, authority := range authorities {
+		if authority == nil {
+			return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "authority %d cannot be nil", i)
+		}
+		if len(authority.PublicKey) != publicKeyLength {
+			return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "public key of authority %d must be %d bytes, got %d", i, publicKeyLength, len(authority.PublicKey))
+		}
+		if authority.Weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "weight of authority %d cannot be zero", i)
+		}
+		if seen[string(authority.PublicKey)] {
+			return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "duplicate authority %X", authority.PublicKey)
+		}
+		seen[string(authority.PublicKey)] = true
+	}
 
-func (m *ClientState) GetTimestampAtHeight(_ types.Context, _ types.KVStore, _ codec.BinaryCodec, _ exported.Height) (uint64, error) {
-	panic("unimplemented")
+	return nil
 }
 
-func (m *ClientState) Initialize(_ types.Context, _ codec.BinaryCodec, _ types.KVStore, _ exported.ConsensusState) error {
-	panic("unimplemented")
+// Status returns the status of the grandpa client.
+// The client may be:
+// - Active: FrozenHeight is not set and a consensus state exists for the latest height
+// - Frozen: Frozen Height is set
+// - Expired: no consensus state exists for the latest height
+//
+// GRANDPA finality does not rely on a trusting period, so a client is never
+// considered expired as long as its latest consensus state is stored.
+func (cs *ClientState) Status(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+) exported.Status {
+	if cs.IsFrozen() {
+		return exported.Frozen
+	}
+
+	if _, found := GetConsensusState(clientStore, cdc, cs.GetLatestHeight()); !found {
+		// if the client state does not have an associated consensus state for its latest height
+		// then it must be expired
+		return exported.Expired
+	}
+
+	return exported.Active
 }
 
-func (m *ClientState) VerifyMembership(_ types.Context, _ types.KVStore, _ codec.BinaryCodec, _ exported.Height, _ uint64, _ uint64, _ []byte, _ exported.Path, _ []byte) error {
-	panic("unimplemented")
+// ZeroCustomFields is not implemented for grandpa
+func (cs *ClientState) ZeroCustomFields() exported.ClientState {
+	panic("ZeroCustomFields is not implemented as the grandpa implementation does not support upgrades.")
 }
 
-func (m *ClientState) VerifyNonMembership(_ types.Context, _ types.KVStore, _ codec.BinaryCodec, _ exported.Height, _ uint64, _ uint64, _ []byte, _ exported.Path) error {
-	panic("unimplemented")
+// GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the given height.
+func (cs *ClientState) GetTimestampAtHeight(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+) (uint64, error) {
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return 0, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+	return consState.GetTimestamp(), nil
 }
 
-func (m *ClientState) VerifyClientMessage(_ types.Context, _ codec.BinaryCodec, _ types.KVStore, _ exported.ClientMessage) error {
-	panic("unimplemented")
+// Initialize checks that the initial consensus state is a 10-grandpa consensus state and
+// sets the client state, consensus state and associated metadata in the provided client store.
+func (cs *ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	setClientState(clientStore, cdc, cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.GetLatestHeight())
+	setConsensusMetadata(ctx, clientStore, cs.GetLatestHeight())
+
+	return nil
 }
 
-func (m *ClientState) CheckForMisbehaviour(_ types.Context, _ codec.BinaryCodec, _ types.KVStore, _ exported.ClientMessage) bool {
-	panic("unimplemented")
+// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+// The proof is a SCALE encoded list of trie nodes proving the value of the path in the child trie
+// named after the commitment prefix, whose root is stored in the parachain state trie.
+func (cs *ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	storedValue, found, err := cs.readStorage(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "path does not exist in the parachain state")
+	}
+
+	if !bytes.Equal(storedValue, value) {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proven value does not match the expected value")
+	}
+
+	return nil
 }
 
-func (m *ClientState) UpdateStateOnMisbehaviour(_ types.Context, _ codec.BinaryCodec, _ types.KVStore, _ exported.ClientMessage) {
-	panic("unimplemented")
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
+// The proof is a SCALE encoded list of trie nodes proving the absence of the path in the child trie
+// named after the commitment prefix, whose root is stored in the parachain state trie.
+func (cs *ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	_, found, err := cs.readStorage(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "path exists in the parachain state")
+	}
+
+	return nil
 }
 
-func (m *ClientState) UpdateState(_ types.Context, _ codec.BinaryCodec, _ types.KVStore, _ exported.ClientMessage) []exported.Height {
-	panic("unimplemented")
+// readStorage performs the checks shared by membership and non-membership verification and
+// returns the value stored under the path according to the proof.
+func (cs *ClientState) readStorage(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) ([]byte, bool, error) {
+	if cs.GetLatestHeight().LT(height) {
+		return nil, false, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if err := VerifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return nil, false, err
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, false, sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return ReadParachainStorage(consensusState.Root, proof, path)
 }
 
-func (m *ClientState) CheckSubstituteAndUpdateState(_ types.Context, _ codec.BinaryCodec, _, _ types.KVStore, _ exported.ClientState) error {
-	panic("unimplemented")
+// VerifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
+// since consensus state was submitted before allowing verification to continue.
+func VerifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
+	if delayTimePeriod != 0 {
+		// check that executing chain's timestamp has passed consensusState's processed time + delay time period
+		processedTime, ok := GetProcessedTime(store, proofHeight)
+		if !ok {
+			return sdkerrors.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height: %s", proofHeight)
+		}
+
+		currentTimestamp := uint64(ctx.BlockTime().UnixNano())
+		validTime := processedTime + delayTimePeriod
+
+		// NOTE: delay time period is inclusive, so if currentTimestamp is validTime, then we return no error
+		if currentTimestamp < validTime {
+			return sdkerrors.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until time: %d, current time: %d",
+				validTime, currentTimestamp)
+		}
+	}
+
+	if delayBlockPeriod != 0 {
+		// check that executing chain's height has passed consensusState's processed height + delay block period
+		processedHeight, ok := GetProcessedHeight(store, proofHeight)
+		if !ok {
+			return sdkerrors.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height: %s", proofHeight)
+		}
+
+		currentHeight := clienttypes.GetSelfHeight(ctx)
+		validHeight := clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()+delayBlockPeriod)
+
+		// NOTE: delay block period is inclusive, so if currentHeight is validHeight, then we return no error
+		if currentHeight.LT(validHeight) {
+			return sdkerrors.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until height: %s, current height: %s",
+				validHeight, currentHeight)
+		}
+	}
+
+	return nil
 }
 
-func (m *ClientState) VerifyUpgradeAndUpdateState(_ types.Context, _ codec.BinaryCodec, _ types.KVStore, _ exported.ClientState, _ exported.ConsensusState, _, _ []byte) error {
-	panic("unimplemented")
+// VerifyUpgradeAndUpdateState returns an error since grandpa client does not support upgrades
+func (cs *ClientState) VerifyUpgradeAndUpdateState(
+	_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore,
+	_ exported.ClientState, _ exported.ConsensusState, _, _ []byte,
+) error {
+	return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade grandpa client")
 }
@@ -0,0 +1,358 @@
+package grandpa_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
+)
+
+const (
+	connectionID = "connection-0"
+	channelID    = "channel-0"
+	portID       = "transfer"
+	pathPrefix   = "ibc/"
+)
+
+func (suite *GrandpaTestSuite) TestValidate() {
+	var clientState *grandpa.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"valid client", func() {}, true},
+		{"invalid relay hash", func() { clientState.LatestRelayHash = []byte{1} }, false},
+		{"unknown relay chain", func() { clientState.RelayChain = 10 }, false},
+		{"zero para id", func() { clientState.ParaId = 0 }, false},
+		{"zero latest para height", func() { clientState.LatestParaHeight = 0 }, false},
+		{"empty authority set", func() { clientState.CurrentAuthorities = nil }, false},
+		{"invalid authority public key", func() { clientState.CurrentAuthorities[0].PublicKey = []byte{1} }, false},
+		{"zero authority weight", func() { clientState.CurrentAuthorities[0].Weight = 0 }, false},
+		{
+			"duplicate authority", func() {
+				clientState.CurrentAuthorities[1].PublicKey = clientState.CurrentAuthorities[0].PublicKey
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clientState = suite.clientState
+
+			tc.malleate()
+
+			err := clientState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *GrandpaTestSuite) TestStatus() {
+	suite.Require().Equal(exported.Grandpa, suite.clientState.ClientType())
+	suite.Require().Equal(clienttypes.NewHeight(paraID, 33), suite.clientState.GetLatestHeight())
+	suite.Require().Equal(exported.Active, suite.clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	clientState := *suite.clientState
+	clientState.LatestParaHeight = 34
+	suite.Require().Equal(exported.Expired, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	clientState.XFrozenHeight = &grandpa.ClientState_FrozenHeight{FrozenHeight: 101}
+	suite.Require().Equal(exported.Frozen, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+}
+
+func (suite *GrandpaTestSuite) TestGetTimestampAtHeight() {
+	timestamp, err := suite.clientState.GetTimestampAtHeight(suite.ctx, suite.store, suite.chainA.Codec, clienttypes.NewHeight(paraID, 11))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(time.Date(2023, 3, 13, 18, 31, 0, 23000000, time.UTC).UnixNano()), timestamp)
+
+	_, err = suite.clientState.GetTimestampAtHeight(suite.ctx, suite.store, suite.chainA.Codec, clienttypes.NewHeight(paraID, 12))
+	suite.Require().ErrorIs(err, clienttypes.ErrConsensusStateNotFound)
+}
+
+func (suite *GrandpaTestSuite) TestVerifyMembership() {
+	var (
+		height           exported.Height
+		path             exported.Path
+		proof            []byte
+		value            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful ClientState verification", func() {}, true,
+		},
+		{
+			"successful Connection verification", func() {
+				height = clienttypes.NewHeight(paraID, 11)
+				path = commitmenttypes.NewMerklePath(pathPrefix, host.ConnectionPath(connectionID))
+				proof = suite.decode(suite.testData.ConnectionProof)
+
+				var err error
+				value, err = suite.chainA.Codec.Marshal(&connectiontypes.ConnectionEnd{
+					ClientId: "07-tendermint-0",
+					Counterparty: connectiontypes.Counterparty{
+						ClientId:     "08-wasm-0",
+						ConnectionId: connectionID,
+						Prefix:       suite.chainA.GetPrefix(),
+					},
+					State:    connectiontypes.TRYOPEN,
+					Versions: []*connectiontypes.Version{connectiontypes.DefaultIBCVersion},
+				})
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"successful Channel verification", func() {
+				height = clienttypes.NewHeight(paraID, 20)
+				path = commitmenttypes.NewMerklePath(pathPrefix, host.ChannelPath(portID, channelID))
+				proof = suite.decode(suite.testData.ChannelProof)
+
+				var err error
+				value, err = suite.chainA.Codec.Marshal(&channeltypes.Channel{
+					State:    channeltypes.TRYOPEN,
+					Ordering: channeltypes.UNORDERED,
+					Counterparty: channeltypes.Counterparty{
+						PortId:    portID,
+						ChannelId: channelID,
+					},
+					ConnectionHops: []string{connectionID},
+					Version:        "ics20-1",
+				})
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"successful PacketCommitment verification", func() {
+				packet := channeltypes.NewPacket(
+					suite.decode(suite.testData.PacketCommitmentData),
+					1, portID, channelID, portID, channelID, clienttypes.NewHeight(0, 3000), 0,
+				)
+
+				height = clienttypes.NewHeight(paraID, 32)
+				path = commitmenttypes.NewMerklePath(pathPrefix, host.PacketCommitmentPath(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				proof = suite.decode(suite.testData.PacketCommitmentProof)
+				value = channeltypes.CommitPacket(suite.chainA.Codec, packet)
+			},
+			true,
+		},
+		{
+			"successful Acknowledgement verification", func() {
+				packet := channeltypes.NewPacket(
+					suite.decode(suite.testData.AckData),
+					1, portID, channelID, portID, channelID, clienttypes.NewHeight(paraID, 1022), 1678733040575532477,
+				)
+
+				height = clienttypes.NewHeight(paraID, 29)
+				path = commitmenttypes.NewMerklePath(pathPrefix, string(host.PacketAcknowledgementKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())))
+				proof = suite.decode(suite.testData.AckProof)
+				value = channeltypes.CommitAcknowledgement(suite.decode(suite.testData.Ack))
+			},
+			true,
+		},
+		{
+			"delay time period has passed", func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+			},
+			true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			false,
+		},
+		{
+			"delay block period has passed", func() {
+				delayBlockPeriod = 1
+				suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+			},
+			true,
+		},
+		{
+			"delay block period has not passed", func() {
+				delayBlockPeriod = 1000
+			},
+			false,
+		},
+		{
+			"latest client height < height", func() {
+				height = suite.clientState.GetLatestHeight().Increment()
+			},
+			false,
+		},
+		{
+			"invalid path type", func() {
+				path = ibcmock.KeyPath{}
+			},
+			false,
+		},
+		{
+			"path without prefix", func() {
+				path = commitmenttypes.NewMerklePath(string(host.FullClientStateKey("07-tendermint-0")))
+			},
+			false,
+		},
+		{
+			"failed to decode proof", func() {
+				proof = []byte("invalid proof")
+			},
+			false,
+		},
+		{
+			"proof is missing trie nodes", func() {
+				proof = suite.decode(suite.testData.ChannelProof)
+			},
+			false,
+		},
+		{
+			"consensus state not found", func() {
+				height = clienttypes.NewHeight(paraID, 12)
+			},
+			false,
+		},
+		{
+			"path does not exist", func() {
+				path = commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-1")))
+			},
+			false,
+		},
+		{
+			"proof verification failed", func() {
+				value = []byte("invalid value")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			height = clienttypes.NewHeight(paraID, 11)
+			path = commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-0")))
+			proof = suite.decode(suite.testData.ClientStateProof)
+
+			var err error
+			value, err = suite.chainA.Codec.MarshalInterface(&ibctm.ClientState{
+				ChainId:         "simd",
+				TrustLevel:      ibctm.Fraction{Numerator: 1, Denominator: 3},
+				TrustingPeriod:  time.Second * 64000,
+				UnbondingPeriod: time.Second * 1814400,
+				MaxClockDrift:   time.Second * 15,
+				LatestHeight:    clienttypes.NewHeight(0, 46),
+				ProofSpecs:      commitmenttypes.GetSDKSpecs(),
+				UpgradePath:     []string{"upgrade", "upgradedIBCState"},
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.clientState.VerifyMembership(
+				suite.ctx, suite.store, suite.chainA.Codec,
+				height, delayTimePeriod, delayBlockPeriod,
+				proof, path, value,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *GrandpaTestSuite) TestVerifyNonMembership() {
+	var (
+		height exported.Height
+		path   exported.Path
+		proof  []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful absence verification", func() {}, true,
+		},
+		{
+			"successful absence verification of a key sharing a prefix with an existing key", func() {
+				height = clienttypes.NewHeight(paraID, 11)
+				path = commitmenttypes.NewMerklePath(pathPrefix, host.ConnectionPath("connection-1"))
+				proof = suite.decode(suite.testData.ConnectionProof)
+			},
+			true,
+		},
+		{
+			"path exists", func() {
+				path = commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-0")))
+			},
+			false,
+		},
+		{
+			"failed to decode proof", func() {
+				proof = []byte("invalid proof")
+			},
+			false,
+		},
+		{
+			"consensus state not found", func() {
+				height = clienttypes.NewHeight(paraID, 12)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			height = clienttypes.NewHeight(paraID, 11)
+			path = commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-1")))
+			proof = suite.decode(suite.testData.ClientStateProof)
+
+			tc.malleate()
+
+			err := suite.clientState.VerifyNonMembership(
+				suite.ctx, suite.store, suite.chainA.Codec,
+				height, 0, 0, proof, path,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
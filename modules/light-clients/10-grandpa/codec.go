@@ -6,7 +6,7 @@ import (
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-// RegisterInterfaces registers the tendermint concrete client-related
+// RegisterInterfaces registers the grandpa concrete client-related
 // implementations and interfaces.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
@@ -17,4 +17,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*exported.ConsensusState)(nil),
 		&ConsensusState{},
 	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&Header{},
+		&Misbehaviour{},
+	)
 }
@@ -1,22 +1,41 @@
 package grandpa
 
 import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 var _ exported.ConsensusState = (*ConsensusState)(nil)
 
-// The implementation of ConsensusState is needed to register the protobuf type, but none of the functions is actually
-// used anywhere
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(timestamp time.Time, root []byte) *ConsensusState {
+	return &ConsensusState{
+		Timestamp: timestamp,
+		Root:      root,
+	}
+}
 
-func (m ConsensusState) ClientType() string {
-	panic("unimplemented")
+// ClientType returns Grandpa
+func (ConsensusState) ClientType() string {
+	return exported.Grandpa
 }
 
-func (m ConsensusState) GetTimestamp() uint64 {
-	panic("unimplemented")
+// GetTimestamp returns the timestamp in nanoseconds of the parachain block that created the consensus state
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return uint64(cs.Timestamp.UnixNano())
 }
 
-func (m ConsensusState) ValidateBasic() error {
-	panic("unimplemented")
+// ValidateBasic defines a basic validation for the grandpa consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if len(cs.Root) != hashLength {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "root must be %d bytes, got %d", hashLength, len(cs.Root))
+	}
+	if cs.Timestamp.Unix() <= 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp must be a positive Unix time")
+	}
+	return nil
 }
@@ -0,0 +1,6 @@
+/*
+Package grandpa implements a concrete `ClientState`, `ConsensusState`, `Header`
+and `Misbehaviour` types for a light client tracking a Substrate parachain whose
+relay chain is finalized by the GRANDPA finality gadget.
+*/
+package grandpa
@@ -0,0 +1,21 @@
+package grandpa
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC grandpa client sentinel errors
+var (
+	ErrInvalidScaleEncoding      = sdkerrors.Register(ModuleName, 2, "invalid SCALE encoding")
+	ErrInvalidAuthoritySet       = sdkerrors.Register(ModuleName, 3, "invalid authority set")
+	ErrInvalidJustification      = sdkerrors.Register(ModuleName, 4, "invalid GRANDPA justification")
+	ErrInvalidHeader             = sdkerrors.Register(ModuleName, 5, "invalid header")
+	ErrInvalidAuthoritySetChange = sdkerrors.Register(ModuleName, 6, "invalid authority set change")
+	ErrInvalidParachainHeader    = sdkerrors.Register(ModuleName, 7, "invalid parachain header")
+	ErrInvalidStorageProof       = sdkerrors.Register(ModuleName, 8, "invalid storage proof")
+	ErrInvalidTimestampExtrinsic = sdkerrors.Register(ModuleName, 9, "invalid timestamp extrinsic")
+	ErrProcessedTimeNotFound     = sdkerrors.Register(ModuleName, 10, "processed time not found")
+	ErrProcessedHeightNotFound   = sdkerrors.Register(ModuleName, 11, "processed height not found")
+	ErrDelayPeriodNotPassed      = sdkerrors.Register(ModuleName, 12, "packet-specified delay period has not been reached")
+	ErrInvalidMisbehaviour       = sdkerrors.Register(ModuleName, 13, "invalid misbehaviour")
+)
@@ -118,7 +118,6 @@ type ClientState struct {
 	// current grandpa authority set id
 	CurrentSetId uint64 `protobuf:"varint,3,opt,name=current_set_id,json=currentSetId,proto3" json:"current_set_id,omitempty"`
 	// Types that are valid to be assigned to XFrozenHeight:
-	//
 	//	*ClientState_FrozenHeight
 	XFrozenHeight isClientState_XFrozenHeight `protobuf_oneof:"_frozen_height"`
 	// Known relay chains
@@ -606,7 +605,6 @@ func (m *Misbehaviour) GetSecondFinalityProof() []byte {
 // ClientMessage for ics10-GRANDPA
 type ClientMessage struct {
 	// Types that are valid to be assigned to Message:
-	//
 	//	*ClientMessage_Header
 	//	*ClientMessage_Misbehaviour
 	Message isClientMessage_Message `protobuf_oneof:"message"`
@@ -708,60 +706,63 @@ func init() {
 }
 
 var fileDescriptor_cd16ccf01137b53b = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0x1b, 0x45,
-	0x18, 0xf7, 0xc6, 0xa9, 0x13, 0x7f, 0x5e, 0x3b, 0xce, 0x24, 0x85, 0x55, 0x68, 0x6d, 0x6b, 0xa9,
-	0xa8, 0x1b, 0xa1, 0x35, 0x35, 0x27, 0x0e, 0x1c, 0x6c, 0x23, 0xe4, 0x2a, 0x44, 0x0e, 0x9b, 0xa2,
-	0x9e, 0xd0, 0x6a, 0xbc, 0x1e, 0x7b, 0x87, 0x38, 0x3b, 0xab, 0x99, 0x71, 0x8a, 0xb9, 0x70, 0xe5,
-	0xd8, 0x07, 0xe0, 0x09, 0xb8, 0xf3, 0x0e, 0x3d, 0xf6, 0xc8, 0x09, 0x50, 0xf2, 0x1c, 0x48, 0x68,
-	0x67, 0xc6, 0x6b, 0x6f, 0xa8, 0x2c, 0x71, 0xfb, 0xe6, 0xf7, 0xfd, 0xfb, 0x7d, 0xff, 0x06, 0x9e,
-	0xd1, 0x71, 0xd8, 0x99, 0xd3, 0x59, 0x24, 0xc3, 0x39, 0x25, 0xb1, 0x14, 0x9d, 0x19, 0xc7, 0xf1,
-	0x24, 0xc1, 0x9d, 0x9b, 0xe7, 0x2b, 0xd1, 0x4b, 0x38, 0x93, 0x0c, 0x7d, 0x44, 0xc7, 0xa1, 0xb7,
-	0x69, 0xea, 0xad, 0xf4, 0x37, 0xcf, 0x4f, 0x9a, 0x33, 0xc6, 0x66, 0x73, 0xd2, 0x51, 0xa6, 0xe3,
-	0xc5, 0xb4, 0x23, 0xe9, 0x35, 0x11, 0x12, 0x5f, 0x27, 0xda, 0xfb, 0xe4, 0x78, 0xc6, 0x66, 0x4c,
-	0x89, 0x9d, 0x54, 0xd2, 0xa8, 0xdb, 0x87, 0x72, 0x6f, 0x21, 0x23, 0xc6, 0xa9, 0x5c, 0xa2, 0xc7,
-	0x00, 0xc9, 0x62, 0x3c, 0xa7, 0x61, 0x70, 0x45, 0x96, 0x8e, 0xd5, 0xb2, 0xda, 0xb6, 0x5f, 0xd6,
-	0xc8, 0x19, 0x59, 0xa2, 0x0f, 0xa0, 0xf4, 0x9a, 0xa4, 0xe9, 0x9d, 0x9d, 0x96, 0xd5, 0xde, 0xf5,
-	0xcd, 0xcb, 0xfd, 0xbd, 0x08, 0x95, 0x81, 0x62, 0x74, 0x29, 0xb1, 0x24, 0xe8, 0x14, 0x0e, 0xe7,
-	0x58, 0x12, 0x21, 0x03, 0x4e, 0xe6, 0x78, 0x19, 0x44, 0x58, 0x44, 0x26, 0xda, 0x81, 0x56, 0xf8,
-	0x29, 0x3e, 0xc4, 0x22, 0x42, 0x1e, 0x1c, 0xe5, 0x6d, 0xd7, 0x09, 0xaa, 0xfe, 0xe1, 0xa6, 0xb5,
-	0x52, 0xa0, 0x27, 0x50, 0x0b, 0x17, 0x9c, 0x93, 0x58, 0x06, 0x82, 0xc8, 0x80, 0x4e, 0x9c, 0xa2,
-	0xe2, 0x62, 0x1b, 0xf4, 0x92, 0xc8, 0x17, 0x13, 0xd4, 0x86, 0xea, 0x94, 0xb3, 0x9f, 0x48, 0xbc,
-	0x8a, 0xb7, 0x9b, 0x1a, 0x0d, 0x0b, 0xbe, 0xad, 0x61, 0x1d, 0xec, 0x17, 0xcb, 0x42, 0x43, 0xa8,
-	0xe8, 0xc4, 0x61, 0x84, 0x69, 0xec, 0x3c, 0x68, 0x59, 0xed, 0x5a, 0xf7, 0xa9, 0xb7, 0xa5, 0xd3,
-	0x9e, 0xa2, 0x33, 0x48, 0xcd, 0x7d, 0xe0, 0x99, 0x8c, 0x3e, 0x84, 0xbd, 0x04, 0x73, 0x9c, 0x52,
-	0x2a, 0x29, 0xf6, 0xa5, 0xf4, 0xf9, 0x62, 0x82, 0x3e, 0x05, 0x64, 0x4a, 0x54, 0x7a, 0xc3, 0x68,
-	0x4f, 0xd9, 0xd4, 0xb5, 0xe6, 0x02, 0x73, 0x6c, 0x0a, 0x7c, 0x05, 0x47, 0xab, 0x02, 0xb1, 0x19,
-	0x0c, 0x25, 0xc2, 0xd9, 0x6f, 0x15, 0xdb, 0x95, 0xee, 0x27, 0x5b, 0x89, 0x65, 0x83, 0xf4, 0x91,
-	0x09, 0xd1, 0x5b, 0x47, 0xe8, 0xd7, 0xa1, 0x16, 0xe4, 0x9a, 0xe2, 0xfe, 0x6a, 0xc1, 0xa3, 0x34,
-	0xb3, 0x2a, 0x7d, 0x48, 0xf0, 0x84, 0xf0, 0x57, 0x54, 0x46, 0xeb, 0xe1, 0x3c, 0x06, 0xf8, 0xcf,
-	0x04, 0xcb, 0x3c, 0x53, 0x7f, 0x0f, 0xf5, 0x64, 0xe5, 0x1e, 0x44, 0xca, 0x5f, 0x0d, 0xae, 0xd2,
-	0xed, 0x6e, 0xe5, 0x79, 0x2f, 0xe7, 0x05, 0x67, 0x6c, 0x2a, 0xfc, 0x83, 0x24, 0x0f, 0xbb, 0x12,
-	0xaa, 0x5f, 0xd3, 0x18, 0xcf, 0xa9, 0x5c, 0x2a, 0x13, 0x74, 0x0c, 0x0f, 0xc6, 0x73, 0x16, 0x5e,
-	0x19, 0x26, 0xfa, 0x81, 0x9e, 0x40, 0xf5, 0x87, 0x85, 0x90, 0x74, 0x4a, 0x43, 0x2c, 0x29, 0x8b,
-	0x15, 0x05, 0xdb, 0xcf, 0x83, 0xe8, 0x29, 0x1c, 0x2c, 0xe2, 0xab, 0x98, 0xbd, 0x5e, 0x31, 0x15,
-	0x4e, 0xb1, 0x55, 0x6c, 0xdb, 0x7e, 0xcd, 0xc0, 0x3a, 0xa9, 0x70, 0x7f, 0x86, 0x87, 0xef, 0xe5,
-	0x87, 0x9a, 0x50, 0x11, 0xe9, 0x7a, 0x07, 0x49, 0xfa, 0x76, 0x2c, 0xe5, 0x0d, 0x0a, 0xd2, 0xf4,
-	0x1e, 0x41, 0x99, 0xfc, 0x28, 0x39, 0x8d, 0x05, 0x0d, 0x0d, 0x89, 0x35, 0x90, 0x12, 0xc8, 0x1e,
-	0x26, 0x84, 0x21, 0x90, 0xc1, 0x2a, 0x8c, 0x1b, 0x41, 0x6d, 0xc0, 0x62, 0x41, 0x62, 0xb1, 0x10,
-	0xfa, 0x9e, 0xfa, 0x50, 0xce, 0x8e, 0x59, 0xd5, 0x5e, 0xe9, 0x9e, 0x78, 0xfa, 0xdc, 0xbd, 0xd5,
-	0xb9, 0x7b, 0x2f, 0x57, 0x16, 0xfd, 0xfd, 0xb7, 0x7f, 0x36, 0x0b, 0x6f, 0xfe, 0x6a, 0x5a, 0xfe,
-	0xda, 0x0d, 0x21, 0xd8, 0xe5, 0x8c, 0x49, 0xc3, 0x4b, 0xc9, 0xee, 0x3f, 0x16, 0x94, 0x74, 0x89,
-	0xe8, 0x5b, 0xa8, 0x4d, 0x4d, 0xaf, 0xb3, 0xfa, 0xd2, 0x3c, 0xa7, 0x5b, 0x07, 0x99, 0x1b, 0x8f,
-	0x5f, 0x9d, 0xe6, 0xa6, 0x35, 0x85, 0xc3, 0xfb, 0xdb, 0x21, 0x9c, 0x1d, 0xb5, 0xc6, 0x5f, 0xfc,
-	0x9f, 0xf5, 0xc8, 0xad, 0xa4, 0x5f, 0xbf, 0xb7, 0x25, 0x62, 0xf3, 0xee, 0x8a, 0xb9, 0xbb, 0x6b,
-	0x42, 0x65, 0xf3, 0xe0, 0x76, 0x95, 0x12, 0x92, 0xec, 0xd4, 0x5c, 0x09, 0xf6, 0x39, 0x15, 0x63,
-	0x12, 0xe1, 0x1b, 0xca, 0x16, 0x1c, 0x7d, 0x06, 0xc7, 0x53, 0xca, 0x85, 0x0c, 0xde, 0xd3, 0x0a,
-	0xdb, 0x47, 0x4a, 0x97, 0xdf, 0xc8, 0x2e, 0x3c, 0x14, 0x24, 0x64, 0xf1, 0xe4, 0xbe, 0x8b, 0x6e,
-	0xf3, 0x91, 0x56, 0xe6, 0x7c, 0xdc, 0xdf, 0x2c, 0xa8, 0xea, 0xdf, 0xf2, 0x9c, 0x08, 0x81, 0x67,
-	0x04, 0x7d, 0x09, 0x25, 0x73, 0x3d, 0xba, 0xe9, 0x1f, 0x6f, 0x6d, 0x8f, 0xae, 0x7b, 0x58, 0xf0,
-	0x8d, 0x13, 0x1a, 0x81, 0x7d, 0xbd, 0x51, 0x86, 0x39, 0xc1, 0x67, 0x5b, 0x83, 0x6c, 0xd6, 0x9d,
-	0x7e, 0x8b, 0x9b, 0x01, 0xfa, 0x65, 0xd8, 0xbb, 0xd6, 0xd4, 0x4e, 0xbb, 0x00, 0xeb, 0xef, 0x0e,
-	0xd9, 0xb0, 0x7f, 0x31, 0xfa, 0xe6, 0xac, 0xf7, 0xd5, 0xe8, 0x65, 0xbd, 0x80, 0x00, 0x4a, 0x67,
-	0xdf, 0x5d, 0xf6, 0xce, 0x7b, 0x75, 0x2b, 0x95, 0xfd, 0xd1, 0x60, 0x34, 0x18, 0xd5, 0x77, 0xfa,
-	0xce, 0xdb, 0xdb, 0x86, 0xf5, 0xee, 0xb6, 0x61, 0xfd, 0x7d, 0xdb, 0xb0, 0xde, 0xdc, 0x35, 0x0a,
-	0xef, 0xee, 0x1a, 0x85, 0x3f, 0xee, 0x1a, 0x85, 0x71, 0x49, 0x6d, 0xeb, 0xe7, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x24, 0xc2, 0xe8, 0x38, 0xf4, 0x06, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xc6, 0xa9, 0x13, 0xbf, 0xfe, 0x88, 0x33, 0x49, 0xc1, 0x0a, 0xad, 0x6d, 0x99, 0x8a,
+	0xba, 0x11, 0xd9, 0x6d, 0xcc, 0x01, 0x21, 0xc4, 0xc1, 0x76, 0x85, 0x5c, 0x85, 0xc8, 0x61, 0x53,
+	0x54, 0x09, 0x09, 0xac, 0xf1, 0x7a, 0xbc, 0x3b, 0x64, 0xbd, 0xb3, 0xda, 0x99, 0x4d, 0x31, 0x17,
+	0xae, 0x1c, 0xfb, 0x03, 0xf8, 0x05, 0xdc, 0xf9, 0x0f, 0x3d, 0xf6, 0xc8, 0x09, 0x50, 0xf2, 0x3b,
+	0x90, 0xd0, 0xce, 0xcc, 0xda, 0xde, 0x50, 0x59, 0xe2, 0xe4, 0x77, 0x9e, 0xf7, 0xeb, 0x79, 0xbf,
+	0xd6, 0xf0, 0x84, 0x4e, 0x1c, 0xcb, 0xa7, 0xae, 0x27, 0x1c, 0x9f, 0x92, 0x40, 0x70, 0xcb, 0x8d,
+	0x70, 0x30, 0x0d, 0xb1, 0x75, 0x7d, 0x9a, 0x8a, 0x66, 0x18, 0x31, 0xc1, 0xd0, 0x07, 0x74, 0xe2,
+	0x98, 0xeb, 0xa6, 0x66, 0xaa, 0xbf, 0x3e, 0x3d, 0x6a, 0xba, 0x8c, 0xb9, 0x3e, 0xb1, 0xa4, 0xe9,
+	0x24, 0x9e, 0x59, 0x82, 0xce, 0x09, 0x17, 0x78, 0x1e, 0x2a, 0xef, 0xa3, 0x43, 0x97, 0xb9, 0x4c,
+	0x8a, 0x56, 0x22, 0x29, 0xb4, 0xdd, 0x87, 0x62, 0x2f, 0x16, 0x1e, 0x8b, 0xa8, 0x58, 0xa0, 0x87,
+	0x00, 0x61, 0x3c, 0xf1, 0xa9, 0x33, 0xbe, 0x22, 0x8b, 0xba, 0xd1, 0x32, 0x3a, 0x65, 0xbb, 0xa8,
+	0x90, 0x33, 0xb2, 0x40, 0xef, 0x41, 0xe1, 0x15, 0x49, 0xd2, 0xd7, 0xb7, 0x5a, 0x46, 0x67, 0xdb,
+	0xd6, 0xaf, 0xf6, 0xef, 0x79, 0x28, 0x0d, 0x24, 0xa3, 0x4b, 0x81, 0x05, 0x41, 0xc7, 0xb0, 0xef,
+	0x63, 0x41, 0xb8, 0x18, 0x47, 0xc4, 0xc7, 0x8b, 0xb1, 0x87, 0xb9, 0xa7, 0xa3, 0xed, 0x29, 0x85,
+	0x9d, 0xe0, 0x43, 0xcc, 0x3d, 0x64, 0xc2, 0x41, 0xd6, 0x76, 0x95, 0xa0, 0x62, 0xef, 0xaf, 0x5b,
+	0x4b, 0x05, 0x7a, 0x04, 0x55, 0x27, 0x8e, 0x22, 0x12, 0x88, 0x31, 0x27, 0x62, 0x4c, 0xa7, 0xf5,
+	0xbc, 0xe4, 0x52, 0xd6, 0xe8, 0x25, 0x11, 0xcf, 0xa7, 0xa8, 0x03, 0x95, 0x59, 0xc4, 0x7e, 0x22,
+	0x41, 0x1a, 0x6f, 0x3b, 0x31, 0x1a, 0xe6, 0xec, 0xb2, 0x82, 0x55, 0xb0, 0x5f, 0x0c, 0x03, 0x0d,
+	0xa1, 0xa4, 0x12, 0x3b, 0x1e, 0xa6, 0x41, 0xfd, 0x5e, 0xcb, 0xe8, 0x54, 0xbb, 0x8f, 0xcd, 0x0d,
+	0x9d, 0x36, 0x25, 0x9d, 0x41, 0x62, 0x6e, 0x43, 0xb4, 0x94, 0xd1, 0xfb, 0xb0, 0x13, 0xe2, 0x08,
+	0x27, 0x94, 0x0a, 0x92, 0x7d, 0x21, 0x79, 0x3e, 0x9f, 0xa2, 0x8f, 0x01, 0xe9, 0x12, 0xa5, 0x5e,
+	0x33, 0xda, 0x91, 0x36, 0x35, 0xa5, 0xb9, 0xc0, 0x11, 0xd6, 0x05, 0xbe, 0x84, 0x83, 0xb4, 0x40,
+	0xac, 0x07, 0x43, 0x09, 0xaf, 0xef, 0xb6, 0xf2, 0x9d, 0x52, 0xf7, 0xa3, 0x8d, 0xc4, 0x96, 0x83,
+	0xb4, 0x91, 0x0e, 0xd1, 0x5b, 0x45, 0xe8, 0xd7, 0xa0, 0x3a, 0xce, 0x34, 0xa5, 0xfd, 0xab, 0x01,
+	0x0f, 0x92, 0xcc, 0xb2, 0xf4, 0x21, 0xc1, 0x53, 0x12, 0xbd, 0xa4, 0xc2, 0x5b, 0x0d, 0xe7, 0x21,
+	0xc0, 0x7f, 0x26, 0x58, 0x8c, 0x96, 0xea, 0xef, 0xa0, 0x16, 0xa6, 0xee, 0x63, 0x4f, 0xfa, 0xcb,
+	0xc1, 0x95, 0xba, 0xdd, 0x8d, 0x3c, 0xef, 0xe4, 0xbc, 0x88, 0x18, 0x9b, 0x71, 0x7b, 0x2f, 0xcc,
+	0xc2, 0x6d, 0x01, 0x95, 0x2f, 0x69, 0x80, 0x7d, 0x2a, 0x16, 0xd2, 0x04, 0x1d, 0xc2, 0xbd, 0x89,
+	0xcf, 0x9c, 0x2b, 0xcd, 0x44, 0x3d, 0xd0, 0x23, 0xa8, 0xfc, 0x10, 0x73, 0x41, 0x67, 0xd4, 0xc1,
+	0x82, 0xb2, 0x40, 0x52, 0x28, 0xdb, 0x59, 0x10, 0x3d, 0x86, 0xbd, 0x38, 0xb8, 0x0a, 0xd8, 0xab,
+	0x94, 0x29, 0xaf, 0xe7, 0x5b, 0xf9, 0x4e, 0xd9, 0xae, 0x6a, 0x58, 0x25, 0xe5, 0xed, 0x9f, 0xe1,
+	0xfe, 0x3b, 0xf9, 0xa1, 0x26, 0x94, 0x78, 0xb2, 0xde, 0xe3, 0x30, 0x79, 0xd7, 0x0d, 0xe9, 0x0d,
+	0x12, 0x52, 0xf4, 0x1e, 0x40, 0x91, 0xfc, 0x28, 0x22, 0x1a, 0x70, 0xea, 0x68, 0x12, 0x2b, 0x20,
+	0x21, 0xb0, 0x7c, 0xe8, 0x10, 0x9a, 0xc0, 0x12, 0x96, 0x61, 0xda, 0x1e, 0x54, 0x07, 0x2c, 0xe0,
+	0x24, 0xe0, 0x31, 0x57, 0xf7, 0xd4, 0x87, 0xe2, 0xf2, 0x98, 0x65, 0xed, 0xa5, 0xee, 0x91, 0xa9,
+	0xce, 0xdd, 0x4c, 0xcf, 0xdd, 0x7c, 0x91, 0x5a, 0xf4, 0x77, 0xdf, 0xfc, 0xd9, 0xcc, 0xbd, 0xfe,
+	0xab, 0x69, 0xd8, 0x2b, 0x37, 0x84, 0x60, 0x3b, 0x62, 0x4c, 0x68, 0x5e, 0x52, 0x6e, 0xff, 0x63,
+	0x40, 0x41, 0x95, 0x88, 0xbe, 0x86, 0xea, 0x4c, 0xf7, 0x7a, 0x59, 0x5f, 0x92, 0xe7, 0x78, 0xe3,
+	0x20, 0x33, 0xe3, 0xb1, 0x2b, 0xb3, 0xcc, 0xb4, 0x66, 0xb0, 0x7f, 0x77, 0x3b, 0x78, 0x7d, 0x4b,
+	0xae, 0xf1, 0x67, 0xff, 0x67, 0x3d, 0x32, 0x2b, 0x69, 0xd7, 0xee, 0x6c, 0x09, 0x5f, 0xbf, 0xbb,
+	0x7c, 0xe6, 0xee, 0x9a, 0x50, 0x5a, 0x3f, 0xb8, 0x6d, 0xa9, 0x84, 0x70, 0x79, 0x6a, 0x6d, 0x01,
+	0xe5, 0x73, 0xca, 0x27, 0xc4, 0xc3, 0xd7, 0x94, 0xc5, 0x11, 0x7a, 0x0a, 0x87, 0x33, 0x1a, 0x71,
+	0x31, 0x7e, 0x47, 0x2b, 0xca, 0x36, 0x92, 0xba, 0xec, 0x46, 0x76, 0xe1, 0x3e, 0x27, 0x0e, 0x0b,
+	0xa6, 0x77, 0x5d, 0x54, 0x9b, 0x0f, 0x94, 0x32, 0xe3, 0xd3, 0xfe, 0xcd, 0x80, 0x8a, 0xfa, 0x5a,
+	0x9e, 0x13, 0xce, 0xb1, 0x4b, 0xd0, 0x17, 0x50, 0xd0, 0xd7, 0xa3, 0x9a, 0xfe, 0xe1, 0xc6, 0xf6,
+	0xa8, 0xba, 0x87, 0x39, 0x5b, 0x3b, 0xa1, 0x11, 0x94, 0xe7, 0x6b, 0x65, 0xe8, 0x13, 0x7c, 0xb2,
+	0x31, 0xc8, 0x7a, 0xdd, 0xc9, 0x67, 0x71, 0x3d, 0x40, 0xbf, 0x08, 0x3b, 0x73, 0x45, 0xed, 0xb8,
+	0x0b, 0xb0, 0xfa, 0xdc, 0xa1, 0x32, 0xec, 0x5e, 0x8c, 0xbe, 0x3a, 0xeb, 0x3d, 0x1b, 0xbd, 0xa8,
+	0xe5, 0x10, 0x40, 0xe1, 0xec, 0x9b, 0xcb, 0xde, 0x79, 0xaf, 0x66, 0x24, 0xb2, 0x3d, 0x1a, 0x8c,
+	0x06, 0xa3, 0xda, 0x56, 0xff, 0xfb, 0x37, 0x37, 0x0d, 0xe3, 0xed, 0x4d, 0xc3, 0xf8, 0xfb, 0xa6,
+	0x61, 0xbc, 0xbe, 0x6d, 0xe4, 0xde, 0xde, 0x36, 0x72, 0x7f, 0xdc, 0x36, 0x72, 0xdf, 0x3e, 0x73,
+	0xa9, 0xf0, 0xe2, 0x89, 0xe9, 0xb0, 0xb9, 0xe5, 0x30, 0x3e, 0x67, 0xdc, 0xa2, 0x13, 0xe7, 0xc4,
+	0x65, 0xd6, 0xf5, 0xa7, 0xd6, 0x9c, 0x4d, 0x63, 0x9f, 0x70, 0xf5, 0x5f, 0x78, 0x92, 0xfe, 0x19,
+	0x9e, 0x3e, 0x3d, 0xd1, 0xb4, 0x3f, 0xd7, 0xbf, 0x93, 0x82, 0xdc, 0xf9, 0x4f, 0xfe, 0x1d, 0x00,
+	0xb1, 0x34, 0x63, 0x3a, 0x3a, 0x07, 0x00, 0x00,
 }
 
 func (m *Authority) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,110 @@
+package grandpa_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+const (
+	clientID = "10-grandpa-0"
+	paraID   = 2000
+)
+
+// testData holds fixtures captured from a Rococo local testnet running parachain 2000.
+// The client state and consensus states are the ones stored by a relayer after tracking
+// the parachain up to height 33, the header finalizes relay chain block 101. The misbehaviour
+// finalizes relay chain block 101 and block 93 of a fork, both descending from block 89.
+type testData struct {
+	ClientState           string            `json:"client_state"`
+	ConsensusStates       map[string]string `json:"consensus_states"`
+	Header                string            `json:"header"`
+	HeaderOld             string            `json:"header_old"`
+	Misbehaviour          string            `json:"misbehaviour"`
+	ClientStateProof      string            `json:"client_state_proof"`
+	ConnectionProof       string            `json:"connection_proof_try"`
+	ChannelProof          string            `json:"channel_proof_try"`
+	PacketCommitmentProof string            `json:"packet_commitment_proof"`
+	PacketCommitmentData  string            `json:"packet_commitment_data"`
+	AckProof              string            `json:"ack_proof"`
+	AckData               string            `json:"ack_data"`
+	Ack                   string            `json:"ack"`
+}
+
+type GrandpaTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	ctx         sdk.Context
+	store       sdk.KVStore
+	clientState *grandpa.ClientState
+	testData    testData
+}
+
+func (suite *GrandpaTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	bz, err := os.ReadFile("test_data/data.json")
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(bz, &suite.testData))
+
+	suite.ctx = suite.chainA.GetContext()
+	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.ctx, clientID)
+
+	suite.clientState = &grandpa.ClientState{}
+	suite.Require().NoError(suite.clientState.Unmarshal(suite.decode(suite.testData.ClientState)))
+
+	latestHeight := suite.clientState.GetLatestHeight()
+	for revisionHeight, encoded := range suite.testData.ConsensusStates {
+		consensusState := &grandpa.ConsensusState{}
+		suite.Require().NoError(consensusState.Unmarshal(suite.decode(encoded)))
+
+		number, err := strconv.ParseUint(revisionHeight, 10, 64)
+		suite.Require().NoError(err)
+
+		height := clienttypes.NewHeight(paraID, number)
+		if height.EQ(latestHeight) {
+			suite.Require().NoError(suite.clientState.Initialize(suite.ctx, suite.chainA.Codec, suite.store, consensusState))
+			continue
+		}
+
+		suite.store.Set(host.ConsensusStateKey(height), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, consensusState))
+		grandpa.SetProcessedTime(suite.store, height, uint64(suite.ctx.BlockTime().UnixNano()))
+		grandpa.SetProcessedHeight(suite.store, height, clienttypes.GetSelfHeight(suite.ctx))
+	}
+}
+
+func (suite *GrandpaTestSuite) decode(encoded string) []byte {
+	bz, err := base64.StdEncoding.DecodeString(encoded)
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *GrandpaTestSuite) header(encoded string) *grandpa.Header {
+	header := &grandpa.Header{}
+	suite.Require().NoError(header.Unmarshal(suite.decode(encoded)))
+	return header
+}
+
+func (suite *GrandpaTestSuite) misbehaviour(encoded string) *grandpa.Misbehaviour {
+	misbehaviour := &grandpa.Misbehaviour{}
+	suite.Require().NoError(misbehaviour.Unmarshal(suite.decode(encoded)))
+	return misbehaviour
+}
+
+func TestGrandpaTestSuite(t *testing.T) {
+	suite.Run(t, new(GrandpaTestSuite))
+}
@@ -0,0 +1,51 @@
+package grandpa
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientMessage = &Header{}
+
+// ClientType defines that the Header is a GRANDPA finalized parachain header
+func (Header) ClientType() string {
+	return exported.Grandpa
+}
+
+// GetHeight returns the height of the latest parachain header included in the Header.
+func (h Header) GetHeight() exported.Height {
+	return clienttypes.NewHeight(uint64(h.ParaId), uint64(h.ParaHeight))
+}
+
+// ValidateBasic checks that the finality proof is present and that every parachain
+// header comes with the proofs required to verify it.
+func (h Header) ValidateBasic() error {
+	if h.FinalityProof == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "finality proof cannot be nil")
+	}
+	if len(h.FinalityProof.Block) != hashLength {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "finality proof block hash must be %d bytes, got %d", hashLength, len(h.FinalityProof.Block))
+	}
+	if len(h.FinalityProof.Justification) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "justification cannot be empty")
+	}
+
+	for i, parachainHeader := range h.ParachainHeaders {
+		if len(parachainHeader.RelayHash) != hashLength {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "relay hash of parachain header %d must be %d bytes, got %d", i, hashLength, len(parachainHeader.RelayHash))
+		}
+		if parachainHeader.ParachainHeader == nil {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "proofs of parachain header %d cannot be nil", i)
+		}
+		if len(parachainHeader.ParachainHeader.StateProof) == 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "state proof of parachain header %d cannot be empty", i)
+		}
+		if len(parachainHeader.ParachainHeader.Extrinsic) == 0 || len(parachainHeader.ParachainHeader.ExtrinsicProof) == 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "timestamp extrinsic and proof of parachain header %d cannot be empty", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package grandpa
+
+import (
+	"bytes"
+	"crypto/ed25519"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+// decodeJustification decodes a SCALE encoded GRANDPA justification.
+func decodeJustification(bz []byte) (*scale.Justification, error) {
+	j, err := scale.DecodeJustification(bz)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidJustification, err.Error())
+	}
+
+	return j, nil
+}
+
+// verifyJustification checks that the justification is signed by a supermajority of the
+// given authority set. Every precommit must be signed by a distinct authority of the set
+// and vote for the commit target or one of its descendants, whose ancestry must be
+// proven by the votes ancestries included in the justification.
+func verifyJustification(j *scale.Justification, authorities []*Authority, setID uint64) error {
+	weights := make(map[string]uint64, len(authorities))
+	var totalWeight uint64
+	for _, authority := range authorities {
+		weights[string(authority.PublicKey)] = authority.Weight
+		totalWeight += authority.Weight
+	}
+
+	ancestry := make(map[scale.Hash]*scale.Header, len(j.VotesAncestries))
+	for _, header := range j.VotesAncestries {
+		ancestry[header.Hash()] = header
+	}
+
+	var (
+		signedWeight     uint64
+		seen             = make(map[string]bool, len(j.Commit.Precommits))
+		visitedAncestors = make(map[scale.Hash]bool, len(j.VotesAncestries))
+	)
+	for _, precommit := range j.Commit.Precommits {
+		authorityID := string(precommit.ID[:])
+		weight, ok := weights[authorityID]
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalidJustification, "precommit signed by unknown authority %s", precommit.ID)
+		}
+
+		if seen[authorityID] {
+			return sdkerrors.Wrapf(ErrInvalidJustification, "duplicate precommit from authority %s", precommit.ID)
+		}
+		seen[authorityID] = true
+
+		payload := precommit.Precommit.SigningPayload(j.Round, setID)
+		if !ed25519.Verify(precommit.ID[:], payload, precommit.Signature[:]) {
+			return sdkerrors.Wrapf(ErrInvalidJustification, "invalid precommit signature of authority %s", precommit.ID)
+		}
+
+		if err := checkDescendant(j.Commit, precommit.Precommit, ancestry, visitedAncestors); err != nil {
+			return err
+		}
+
+		signedWeight += weight
+	}
+
+	if len(visitedAncestors) != len(ancestry) {
+		return sdkerrors.Wrap(ErrInvalidJustification, "votes ancestries contain headers not referenced by any precommit")
+	}
+
+	// a supermajority is reached when strictly more than two thirds of the weight signed
+	threshold := totalWeight - (totalWeight-1)/3
+	if totalWeight == 0 || signedWeight < threshold {
+		return sdkerrors.Wrapf(ErrInvalidJustification, "insufficient precommit weight: got %d, need %d", signedWeight, threshold)
+	}
+
+	return nil
+}
+
+// checkDescendant checks that the precommit targets the commit target or one of
+// its descendants by walking back the votes ancestries.
+func checkDescendant(
+	commit scale.Commit,
+	precommit scale.Precommit,
+	ancestry map[scale.Hash]*scale.Header,
+	visited map[scale.Hash]bool,
+) error {
+	hash, number := precommit.TargetHash, precommit.TargetNumber
+	for number > commit.TargetNumber {
+		header, ok := ancestry[hash]
+		if !ok || header.Number != number {
+			return sdkerrors.Wrapf(ErrInvalidJustification, "missing ancestry for precommit target %s", precommit.TargetHash)
+		}
+
+		visited[hash] = true
+		hash, number = header.ParentHash, number-1
+	}
+
+	if number != commit.TargetNumber || !bytes.Equal(hash[:], commit.TargetHash[:]) {
+		return sdkerrors.Wrapf(ErrInvalidJustification, "precommit target %s is not a descendant of the commit target", precommit.TargetHash)
+	}
+
+	return nil
+}
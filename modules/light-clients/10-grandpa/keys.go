@@ -0,0 +1,5 @@
+package grandpa
+
+const (
+	ModuleName = "10-grandpa"
+)
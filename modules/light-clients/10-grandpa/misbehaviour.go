@@ -0,0 +1,26 @@
+package grandpa
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientMessage = &Misbehaviour{}
+
+// ClientType is Grandpa light client
+func (Misbehaviour) ClientType() string {
+	return exported.Grandpa
+}
+
+// ValidateBasic implements Misbehaviour interface
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if len(misbehaviour.FirstFinalityProof) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "first finality proof cannot be empty")
+	}
+	if len(misbehaviour.SecondFinalityProof) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "second finality proof cannot be empty")
+	}
+	return nil
+}
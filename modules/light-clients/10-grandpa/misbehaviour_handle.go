@@ -0,0 +1,158 @@
+package grandpa
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+// CheckForMisbehaviour detects duplicate finalization and conflicting parachain headers. A
+// verified Misbehaviour message is always misbehaviour: the current authority set finalized
+// two blocks on different forks of the relay chain. A Header is considered misbehaviour if it
+// proves a parachain header at a height for which the client already stores a different
+// consensus state.
+func (cs *ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, msg exported.ClientMessage) bool {
+	switch msg := msg.(type) {
+	case *Misbehaviour:
+		// the misbehaviour has already been verified in VerifyClientMessage
+		return true
+	case *Header:
+		return cs.checkHeaderForMisbehaviour(cdc, clientStore, msg)
+	default:
+		return false
+	}
+}
+
+func (cs *ClientState) checkHeaderForMisbehaviour(cdc codec.BinaryCodec, clientStore sdk.KVStore, header *Header) bool {
+	// the header has already been verified, decoding it again cannot fail
+	update, err := cs.processHeader(header)
+	if err != nil {
+		return false
+	}
+
+	for _, height := range update.heights {
+		existingConsState, _ := GetConsensusState(clientStore, cdc, height)
+		if existingConsState == nil {
+			continue
+		}
+
+		// A consensus state already exists for this height, but it does not match the provided parachain header.
+		if !reflect.DeepEqual(existingConsState, update.consensusStates[height]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// finalizedFork is a relay chain block finalized by a finality proof of a Misbehaviour,
+// together with the hashes of the blocks between it and the latest relay chain block
+// known to the client.
+type finalizedFork struct {
+	justification *scale.Justification
+	ancestry      map[scale.Hash]bool
+}
+
+// verifyMisbehaviour returns an error if:
+// - the misbehaviour does not pass basic validation
+// - either finality proof does not finalize a block descending from the latest relay chain block known to the client
+// - both finality proofs finalize the same block, or blocks of the same chain
+// - either justification is not signed by a supermajority of the current authority set
+//
+// Two valid justifications for blocks of different forks prove that at least a third of the
+// current authorities equivocated, since two conflicting blocks can only be finalized if the
+// same authorities voted on both of them.
+func (cs *ClientState) verifyMisbehaviour(misbehaviour *Misbehaviour) error {
+	if err := misbehaviour.ValidateBasic(); err != nil {
+		return err
+	}
+
+	first, err := cs.decodeFinalizedFork(misbehaviour.FirstFinalityProof)
+	if err != nil {
+		return sdkerrors.Wrap(err, "first finality proof")
+	}
+
+	second, err := cs.decodeFinalizedFork(misbehaviour.SecondFinalityProof)
+	if err != nil {
+		return sdkerrors.Wrap(err, "second finality proof")
+	}
+
+	firstBlock, secondBlock := first.justification.Commit.TargetHash, second.justification.Commit.TargetHash
+	if firstBlock == secondBlock {
+		return sdkerrors.Wrapf(ErrInvalidMisbehaviour, "both finality proofs finalize relay block %s", firstBlock)
+	}
+
+	if first.ancestry[secondBlock] || second.ancestry[firstBlock] {
+		return sdkerrors.Wrapf(ErrInvalidMisbehaviour, "finalized relay blocks %s and %s are not conflicting", firstBlock, secondBlock)
+	}
+
+	if err := verifyJustification(first.justification, cs.CurrentAuthorities, cs.CurrentSetId); err != nil {
+		return sdkerrors.Wrap(err, "first finality proof")
+	}
+
+	if err := verifyJustification(second.justification, cs.CurrentAuthorities, cs.CurrentSetId); err != nil {
+		return sdkerrors.Wrap(err, "second finality proof")
+	}
+
+	return nil
+}
+
+// decodeFinalizedFork decodes a SCALE encoded finality proof and links the finalized block
+// to the latest relay chain block known to the client using the unknown headers. The
+// headers are linked by their parent hash only, as they may belong to a fork which does not
+// follow the block numbering of the canonical chain.
+func (cs *ClientState) decodeFinalizedFork(bz []byte) (*finalizedFork, error) {
+	proof, err := scale.DecodeFinalityProof(bz)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidScaleEncoding, "failed to decode finality proof: %s", err)
+	}
+
+	j, err := decodeJustification(proof.Justification)
+	if err != nil {
+		return nil, err
+	}
+
+	if j.Commit.TargetHash != proof.Block {
+		return nil, sdkerrors.Wrapf(ErrInvalidJustification, "justification target %s does not match finalized block %s", j.Commit.TargetHash, proof.Block)
+	}
+
+	if j.Commit.TargetNumber <= cs.LatestRelayHeight {
+		return nil, sdkerrors.Wrapf(ErrInvalidMisbehaviour, "finalized relay block %d is not newer than the latest relay block %d", j.Commit.TargetNumber, cs.LatestRelayHeight)
+	}
+
+	headers := make(map[scale.Hash]*scale.Header, len(proof.UnknownHeaders))
+	for _, header := range proof.UnknownHeaders {
+		headers[header.Hash()] = header
+	}
+
+	var (
+		fork   = &finalizedFork{justification: j, ancestry: make(map[scale.Hash]bool)}
+		hash   = j.Commit.TargetHash
+		number = j.Commit.TargetNumber + 1
+	)
+	for !bytes.Equal(hash[:], cs.LatestRelayHash) {
+		header, ok := headers[hash]
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidHeader, "missing relay chain header %s, finalized relay block does not descend from the latest relay block %X", hash, cs.LatestRelayHash)
+		}
+
+		if header.Number >= number || header.Number <= cs.LatestRelayHeight {
+			return nil, sdkerrors.Wrapf(ErrInvalidHeader, "relay chain header %s has unexpected number %d", hash, header.Number)
+		}
+
+		if hash == j.Commit.TargetHash && header.Number != j.Commit.TargetNumber {
+			return nil, sdkerrors.Wrapf(ErrInvalidHeader, "finalized relay block has number %d, expected %d", header.Number, j.Commit.TargetNumber)
+		}
+
+		fork.ancestry[hash] = true
+		hash, number = header.ParentHash, header.Number
+	}
+
+	return fork, nil
+}
@@ -0,0 +1,168 @@
+package grandpa_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+func (suite *GrandpaTestSuite) TestCheckForMisbehaviour() {
+	var clientMsg exported.ClientMessage
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"no consensus states stored for the header heights", func() {}, false,
+		},
+		{
+			"consensus state already stored and identical", func() {
+				header := clientMsg.(*grandpa.Header)
+				suite.clientState.UpdateState(suite.ctx, suite.chainA.Codec, suite.store, header)
+				// reset the client to its state before the update to process the header again
+				suite.clientState.Reset()
+				suite.Require().NoError(suite.clientState.Unmarshal(suite.decode(suite.testData.ClientState)))
+			},
+			false,
+		},
+		{
+			"conflicting consensus state at the same height", func() {
+				consensusState := grandpa.NewConsensusState(time.Unix(1, 0), make([]byte, 32))
+				suite.store.Set(
+					host.ConsensusStateKey(clienttypes.NewHeight(paraID, 34)),
+					clienttypes.MustMarshalConsensusState(suite.chainA.Codec, consensusState),
+				)
+			},
+			true,
+		},
+		{
+			"misbehaviour message", func() {
+				clientMsg = suite.misbehaviour(suite.testData.Misbehaviour)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clientMsg = suite.header(suite.testData.Header)
+
+			tc.malleate()
+
+			foundMisbehaviour := suite.clientState.CheckForMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, clientMsg)
+			suite.Require().Equal(tc.expPass, foundMisbehaviour)
+		})
+	}
+}
+
+func (suite *GrandpaTestSuite) TestVerifyMisbehaviour() {
+	var misbehaviour *grandpa.Misbehaviour
+
+	// modifyFinalityProof decodes a finality proof of the misbehaviour and re-encodes it after applying modify
+	modifyFinalityProof := func(bz *[]byte, modify func(*scale.FinalityProof, *scale.Justification)) {
+		proof, err := scale.DecodeFinalityProof(*bz)
+		suite.Require().NoError(err)
+		j, err := scale.DecodeJustification(proof.Justification)
+		suite.Require().NoError(err)
+
+		modify(proof, j)
+
+		proof.Justification = j.Encode()
+		*bz = proof.Encode()
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid misbehaviour", func() {}, true,
+		},
+		{
+			"valid misbehaviour with swapped finality proofs", func() {
+				misbehaviour.FirstFinalityProof, misbehaviour.SecondFinalityProof = misbehaviour.SecondFinalityProof, misbehaviour.FirstFinalityProof
+			},
+			true,
+		},
+		{
+			"empty finality proof", func() {
+				misbehaviour.SecondFinalityProof = nil
+			},
+			false,
+		},
+		{
+			"failed to decode finality proof", func() {
+				misbehaviour.FirstFinalityProof = []byte("invalid finality proof")
+			},
+			false,
+		},
+		{
+			"both finality proofs finalize the same block", func() {
+				misbehaviour.SecondFinalityProof = misbehaviour.FirstFinalityProof
+			},
+			false,
+		},
+		{
+			"justification target does not match finalized block", func() {
+				modifyFinalityProof(&misbehaviour.SecondFinalityProof, func(proof *scale.FinalityProof, _ *scale.Justification) {
+					proof.Block[0] ^= 0xff
+				})
+			},
+			false,
+		},
+		{
+			"finalized block does not descend from the latest relay block", func() {
+				modifyFinalityProof(&misbehaviour.SecondFinalityProof, func(proof *scale.FinalityProof, _ *scale.Justification) {
+					proof.UnknownHeaders = proof.UnknownHeaders[1:]
+				})
+			},
+			false,
+		},
+		{
+			"finalized blocks are not newer than the latest relay block", func() {
+				suite.clientState.LatestRelayHeight = 101
+			},
+			false,
+		},
+		{
+			"invalid precommit signature", func() {
+				modifyFinalityProof(&misbehaviour.SecondFinalityProof, func(_ *scale.FinalityProof, j *scale.Justification) {
+					j.Commit.Precommits[0].Signature[0] ^= 0xff
+				})
+			},
+			false,
+		},
+		{
+			"justifications are not signed by the current authority set", func() {
+				suite.clientState.CurrentSetId++
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			misbehaviour = suite.misbehaviour(suite.testData.Misbehaviour)
+
+			tc.malleate()
+
+			err := suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, misbehaviour)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
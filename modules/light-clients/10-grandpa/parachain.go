@@ -0,0 +1,129 @@
+package grandpa
+
+import (
+	"encoding/binary"
+	"net/url"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+// parasHeadsPrefix is the storage prefix of the `Paras::Heads` map of the relay
+// chain, i.e. twox128("Paras") ++ twox128("Heads").
+var parasHeadsPrefix = []byte{
+	0xcd, 0x71, 0x0b, 0x30, 0xbd, 0x2e, 0xab, 0x03, 0x52, 0xdd, 0xcc, 0x26, 0x41, 0x7a, 0xa1, 0x94,
+	0x1b, 0x3c, 0x25, 0x2f, 0xcb, 0x29, 0xd8, 0x8e, 0xff, 0x4f, 0x3d, 0xe5, 0xde, 0x44, 0x76, 0xc3,
+}
+
+// maxTimestampMillis is the latest timestamp, in milliseconds since the Unix epoch,
+// representable by a protobuf timestamp (9999-12-31T23:59:59.999Z).
+const maxTimestampMillis = 253402300799999
+
+// timestampExtrinsicKey is the key of the first extrinsic of a block in the extrinsics
+// trie, which is always the `timestamp.set` inherent.
+var timestampExtrinsicKey = scale.EncodeCompact(0)
+
+// parasHeadsKey returns the relay chain storage key of the head of the given parachain.
+func parasHeadsKey(paraID uint32) []byte {
+	encodedID := binary.LittleEndian.AppendUint32(nil, paraID)
+
+	key := append([]byte(nil), parasHeadsPrefix...)
+	key = binary.LittleEndian.AppendUint64(key, xxhash.Sum64(encodedID))
+	return append(key, encodedID...)
+}
+
+// VerifyParachainHeader reads the head of the parachain from the relay chain state committed
+// to by relayStateRoot and proves the timestamp of the parachain block against its extrinsics
+// root. It returns the height and consensus state of the parachain block.
+func VerifyParachainHeader(paraID uint32, relayStateRoot []byte, proofs *ParachainHeaderProofs) (clienttypes.Height, *ConsensusState, error) {
+	stateRoot := commitmenttypes.NewMerkleRoot(relayStateRoot)
+	headsPath := commitmenttypes.NewMerklePath(url.PathEscape(string(parasHeadsKey(paraID))))
+	headData, found, err := commitmenttypes.NewSubstrateProof(proofs.StateProof).GetValue(stateRoot, headsPath)
+	if err != nil {
+		return clienttypes.Height{}, nil, sdkerrors.Wrap(ErrInvalidStorageProof, err.Error())
+	}
+
+	if !found {
+		return clienttypes.Height{}, nil, sdkerrors.Wrapf(ErrInvalidParachainHeader, "parachain %d has no head in relay chain state %X", paraID, relayStateRoot)
+	}
+
+	// the head data of a parachain is its SCALE encoded header wrapped in a byte vector
+	encodedHeader, err := scale.DecodeVec(headData)
+	if err != nil {
+		return clienttypes.Height{}, nil, sdkerrors.Wrap(ErrInvalidParachainHeader, err.Error())
+	}
+
+	paraHeader, err := decodeSubstrateHeader(encodedHeader)
+	if err != nil {
+		return clienttypes.Height{}, nil, sdkerrors.Wrap(ErrInvalidParachainHeader, err.Error())
+	}
+
+	extrinsicsRoot := commitmenttypes.NewMerkleRoot(paraHeader.ExtrinsicsRoot[:])
+	if err := commitmenttypes.VerifySubstrateCompactProof(extrinsicsRoot, proofs.ExtrinsicProof, timestampExtrinsicKey, proofs.Extrinsic); err != nil {
+		return clienttypes.Height{}, nil, sdkerrors.Wrap(ErrInvalidTimestampExtrinsic, err.Error())
+	}
+
+	timestamp, err := decodeTimestampExtrinsic(proofs.Extrinsic)
+	if err != nil {
+		return clienttypes.Height{}, nil, sdkerrors.Wrap(ErrInvalidTimestampExtrinsic, err.Error())
+	}
+
+	if timestamp > maxTimestampMillis {
+		return clienttypes.Height{}, nil, sdkerrors.Wrapf(ErrInvalidTimestampExtrinsic, "timestamp %d out of range", timestamp)
+	}
+
+	height := clienttypes.NewHeight(uint64(paraID), uint64(paraHeader.Number))
+	consensusState := NewConsensusState(time.UnixMilli(int64(timestamp)).UTC(), append([]byte(nil), paraHeader.StateRoot[:]...))
+	if err := consensusState.ValidateBasic(); err != nil {
+		return clienttypes.Height{}, nil, err
+	}
+
+	return height, consensusState, nil
+}
+
+// ReadParachainStorage returns the value stored under the path in the parachain state committed
+// to by root. The proof is a SCALE encoded list of trie nodes proving the value of the path in the
+// child trie named after the commitment prefix, whose root is stored in the parachain state trie.
+// A false boolean is returned if the proof shows that no value is stored under the path.
+func ReadParachainStorage(root []byte, proof []byte, path exported.Path) ([]byte, bool, error) {
+	nodes, err := scale.DecodeVecOfVec(proof)
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to decode proof into SCALE encoded trie nodes")
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	if len(merklePath.KeyPath) != 2 {
+		return nil, false, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "path must consist of a prefix and a key, got %d elements", len(merklePath.KeyPath))
+	}
+
+	// the child trie is named after the commitment prefix and its keys include the prefix
+	prefix := merklePath.KeyPath[0]
+	storagePath := commitmenttypes.NewMerklePath(prefix, prefix+merklePath.KeyPath[1])
+	trieRoot := commitmenttypes.NewMerkleRoot(root)
+	encodedValue, found, err := commitmenttypes.NewSubstrateProof(nodes).GetValue(trieRoot, storagePath)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	// values are stored SCALE encoded as byte vectors
+	value, err := scale.DecodeVec(encodedValue)
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, err.Error())
+	}
+
+	return value, true, nil
+}
@@ -0,0 +1,70 @@
+package grandpa
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState will try to update the client with the state of the
+// substitute.
+//
+// The following must always be true:
+//   - The substitute client is the same type as the subject client
+//   - The subject and substitute client states track the same parachain of the same relay chain
+//
+// The subject client is unfrozen and takes over the relay chain block, authority set and
+// latest consensus state of the substitute.
+func (cs *ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+) error {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
+	}
+
+	if cs.ParaId != substituteClientState.ParaId || cs.RelayChain != substituteClientState.RelayChain {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	// copy the latest consensus state and processed time from substitute to subject
+	height := substituteClientState.GetLatestHeight()
+
+	consensusState, found := GetConsensusState(substituteClientStore, cdc, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	setConsensusState(subjectClientStore, cdc, consensusState, height)
+
+	// set metadata stored for the substitute consensus state
+	processedHeight, found := GetProcessedHeight(substituteClientStore, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client latest height")
+	}
+
+	processedTime, found := GetProcessedTime(substituteClientStore, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client latest height")
+	}
+
+	SetProcessedHeight(subjectClientStore, height, processedHeight)
+	SetProcessedTime(subjectClientStore, height, processedTime)
+
+	cs.XFrozenHeight = nil
+	cs.LatestRelayHash = substituteClientState.LatestRelayHash
+	cs.LatestRelayHeight = substituteClientState.LatestRelayHeight
+	cs.CurrentSetId = substituteClientState.CurrentSetId
+	cs.CurrentAuthorities = substituteClientState.CurrentAuthorities
+	cs.LatestParaHeight = substituteClientState.LatestParaHeight
+
+	// no validation is necessary since the substitute is verified to be Active
+	// in 02-client.
+	setClientState(subjectClientStore, cdc, cs)
+
+	return nil
+}
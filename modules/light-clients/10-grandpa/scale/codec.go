@@ -0,0 +1,288 @@
+package scale
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ErrInvalidEncoding is returned when the input is not a valid canonical SCALE encoding.
+var ErrInvalidEncoding = errors.New("invalid SCALE encoding")
+
+// Decoder reads SCALE encoded values from a byte slice.
+type Decoder struct {
+	data   []byte
+	offset int
+}
+
+// NewDecoder returns a Decoder reading from data.
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// Remaining returns the number of bytes that have not been read yet.
+func (d *Decoder) Remaining() int {
+	return len(d.data) - d.offset
+}
+
+// Finish returns an error if the input of the named value was not fully consumed.
+func (d *Decoder) Finish(name string) error {
+	if d.Remaining() != 0 {
+		return fmt.Errorf("%w: %d trailing bytes after %s", ErrInvalidEncoding, d.Remaining(), name)
+	}
+	return nil
+}
+
+// ReadBytes reads n raw bytes. The returned slice aliases the input.
+func (d *Decoder) ReadBytes(n int) ([]byte, error) {
+	if n < 0 || d.Remaining() < n {
+		return nil, fmt.Errorf("%w: unexpected end of input: need %d bytes, have %d", ErrInvalidEncoding, n, d.Remaining())
+	}
+
+	bz := d.data[d.offset : d.offset+n]
+	d.offset += n
+	return bz, nil
+}
+
+// ReadU8 reads a single byte.
+func (d *Decoder) ReadU8() (uint8, error) {
+	bz, err := d.ReadBytes(1)
+	if err != nil {
+		return 0, err
+	}
+
+	return bz[0], nil
+}
+
+// ReadU32 reads a little endian uint32.
+func (d *Decoder) ReadU32() (uint32, error) {
+	bz, err := d.ReadBytes(4)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint32(bz), nil
+}
+
+// ReadU64 reads a little endian uint64.
+func (d *Decoder) ReadU64() (uint64, error) {
+	bz, err := d.ReadBytes(8)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint64(bz), nil
+}
+
+// ReadHash reads a 32 byte hash.
+func (d *Decoder) ReadHash() (Hash, error) {
+	var hash Hash
+	bz, err := d.ReadBytes(HashLength)
+	if err != nil {
+		return hash, err
+	}
+
+	copy(hash[:], bz)
+	return hash, nil
+}
+
+// ReadCompact reads a compact encoded unsigned integer. Values that do not fit
+// into 64 bits are rejected.
+func (d *Decoder) ReadCompact() (uint64, error) {
+	first, err := d.ReadU8()
+	if err != nil {
+		return 0, err
+	}
+
+	switch first & 0b11 {
+	case 0b00:
+		return uint64(first >> 2), nil
+	case 0b01:
+		second, err := d.ReadU8()
+		if err != nil {
+			return 0, err
+		}
+
+		value := uint64(binary.LittleEndian.Uint16([]byte{first, second})) >> 2
+		if value <= 0b0011_1111 {
+			return 0, fmt.Errorf("%w: compact integer is not canonically encoded", ErrInvalidEncoding)
+		}
+		return value, nil
+	case 0b10:
+		rest, err := d.ReadBytes(3)
+		if err != nil {
+			return 0, err
+		}
+
+		value := uint64(binary.LittleEndian.Uint32([]byte{first, rest[0], rest[1], rest[2]})) >> 2
+		if value <= 0b0011_1111_1111_1111 {
+			return 0, fmt.Errorf("%w: compact integer is not canonically encoded", ErrInvalidEncoding)
+		}
+		return value, nil
+	default:
+		length := int(first>>2) + 4
+		if length > 8 {
+			return 0, fmt.Errorf("%w: compact integer of %d bytes overflows uint64", ErrInvalidEncoding, length)
+		}
+
+		bz, err := d.ReadBytes(length)
+		if err != nil {
+			return 0, err
+		}
+
+		var buf [8]byte
+		copy(buf[:], bz)
+		value := binary.LittleEndian.Uint64(buf[:])
+		if bits.Len64(value) <= 30 || bz[length-1] == 0 {
+			return 0, fmt.Errorf("%w: compact integer is not canonically encoded", ErrInvalidEncoding)
+		}
+		return value, nil
+	}
+}
+
+// ReadCompactU32 reads a compact encoded unsigned integer that must fit into 32 bits,
+// such as a block number.
+func (d *Decoder) ReadCompactU32() (uint32, error) {
+	value, err := d.ReadCompact()
+	if err != nil {
+		return 0, err
+	}
+
+	if value > uint64(^uint32(0)) {
+		return 0, fmt.Errorf("%w: compact integer %d overflows uint32", ErrInvalidEncoding, value)
+	}
+
+	return uint32(value), nil
+}
+
+// ReadLength reads a compact encoded collection length and checks that it does
+// not exceed the number of bytes left in the input.
+func (d *Decoder) ReadLength() (int, error) {
+	length, err := d.ReadCompact()
+	if err != nil {
+		return 0, err
+	}
+
+	if length > uint64(d.Remaining()) {
+		return 0, fmt.Errorf("%w: length %d exceeds remaining input %d", ErrInvalidEncoding, length, d.Remaining())
+	}
+
+	return int(length), nil
+}
+
+// ReadVec reads a compact length prefixed byte vector. The returned slice aliases the input.
+func (d *Decoder) ReadVec() ([]byte, error) {
+	length, err := d.ReadLength()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ReadBytes(length)
+}
+
+// DecodeVec decodes a byte vector, failing on trailing bytes.
+func DecodeVec(bz []byte) ([]byte, error) {
+	d := NewDecoder(bz)
+	vec, err := d.ReadVec()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Finish("byte vector"); err != nil {
+		return nil, err
+	}
+
+	return vec, nil
+}
+
+// DecodeVecOfVec decodes a Vec<Vec<u8>>, the encoding used for Substrate storage proofs.
+func DecodeVecOfVec(bz []byte) ([][]byte, error) {
+	d := NewDecoder(bz)
+	length, err := d.ReadLength()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([][]byte, length)
+	for i := range items {
+		if items[i], err = d.ReadVec(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := d.Finish("vector of byte vectors"); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// Encoder writes SCALE encoded values.
+type Encoder struct {
+	buf bytes.Buffer
+}
+
+// Bytes returns the encoded bytes.
+func (e *Encoder) Bytes() []byte {
+	return e.buf.Bytes()
+}
+
+// WriteBytes writes raw bytes.
+func (e *Encoder) WriteBytes(bz []byte) {
+	e.buf.Write(bz)
+}
+
+// WriteU8 writes a single byte.
+func (e *Encoder) WriteU8(value uint8) {
+	e.buf.WriteByte(value)
+}
+
+// WriteU32 writes a little endian uint32.
+func (e *Encoder) WriteU32(value uint32) {
+	e.buf.Write(binary.LittleEndian.AppendUint32(nil, value))
+}
+
+// WriteU64 writes a little endian uint64.
+func (e *Encoder) WriteU64(value uint64) {
+	e.buf.Write(binary.LittleEndian.AppendUint64(nil, value))
+}
+
+// WriteCompact writes a compact encoded unsigned integer.
+func (e *Encoder) WriteCompact(value uint64) {
+	e.buf.Write(EncodeCompact(value))
+}
+
+// WriteVec writes a compact length prefixed byte vector.
+func (e *Encoder) WriteVec(bz []byte) {
+	e.WriteCompact(uint64(len(bz)))
+	e.buf.Write(bz)
+}
+
+// EncodeCompact returns the compact encoding of value.
+func EncodeCompact(value uint64) []byte {
+	switch {
+	case value <= 0b0011_1111:
+		return []byte{byte(value << 2)}
+	case value <= 0b0011_1111_1111_1111:
+		bz := make([]byte, 2)
+		binary.LittleEndian.PutUint16(bz, uint16(value<<2)|0b01)
+		return bz
+	case value <= 0b0011_1111_1111_1111_1111_1111_1111_1111:
+		bz := make([]byte, 4)
+		binary.LittleEndian.PutUint32(bz, uint32(value<<2)|0b10)
+		return bz
+	default:
+		length := (bits.Len64(value) + 7) / 8
+		bz := make([]byte, 9)
+		binary.LittleEndian.PutUint64(bz[1:], value)
+		bz[0] = byte(length-4)<<2 | 0b11
+		return bz[:length+1]
+	}
+}
+
+// EncodeVec returns the encoding of a byte vector.
+func EncodeVec(bz []byte) []byte {
+	return append(EncodeCompact(uint64(len(bz))), bz...)
+}
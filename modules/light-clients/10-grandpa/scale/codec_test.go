@@ -0,0 +1,124 @@
+package scale_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+func TestCompact(t *testing.T) {
+	testCases := []struct {
+		value   uint64
+		encoded string
+	}{
+		{0, "00"},
+		{1, "04"},
+		{63, "fc"},
+		{64, "0101"},
+		{16383, "fdff"},
+		{16384, "02000100"},
+		{1073741823, "feffffff"},
+		{1073741824, "0300000040"},
+		{1<<32 - 1, "03ffffffff"},
+		{1 << 32, "070000000001"},
+		{1<<64 - 1, "13ffffffffffffffff"},
+	}
+
+	for _, tc := range testCases {
+		encoded := scale.EncodeCompact(tc.value)
+		require.Equal(t, tc.encoded, hex.EncodeToString(encoded), tc.value)
+
+		d := scale.NewDecoder(encoded)
+		value, err := d.ReadCompact()
+		require.NoError(t, err)
+		require.Equal(t, tc.value, value)
+		require.NoError(t, d.Finish("compact"))
+	}
+}
+
+func TestCompactInvalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded string
+	}{
+		{"empty input", ""},
+		{"truncated two byte mode", "01"},
+		{"truncated four byte mode", "020001"},
+		{"truncated big integer mode", "03000000"},
+		{"non-canonical two byte mode", "0100"},
+		{"non-canonical four byte mode", "02000000"},
+		{"non-canonical big integer mode", "03ffffff3f"},
+		{"big integer with trailing zero byte", "0b00000000ff00"},
+		{"overflows uint64", "17000000000000000001"},
+	}
+
+	for _, tc := range testCases {
+		bz, err := hex.DecodeString(tc.encoded)
+		require.NoError(t, err)
+
+		_, err = scale.NewDecoder(bz).ReadCompact()
+		require.ErrorIs(t, err, scale.ErrInvalidEncoding, tc.name)
+	}
+}
+
+func TestVec(t *testing.T) {
+	items := [][]byte{{}, {1}, make([]byte, 100)}
+
+	e := &scale.Encoder{}
+	e.WriteCompact(uint64(len(items)))
+	for _, item := range items {
+		e.WriteVec(item)
+	}
+
+	decoded, err := scale.DecodeVecOfVec(e.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, items, decoded)
+
+	vec, err := scale.DecodeVec(scale.EncodeVec([]byte("value")))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), vec)
+
+	_, err = scale.DecodeVecOfVec(append(e.Bytes(), 0))
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+
+	// the length prefix exceeds the input
+	_, err = scale.DecodeVec([]byte{0x08, 1})
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+
+	_, err = scale.DecodeVec(append(scale.EncodeVec([]byte("value")), 0))
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+}
+
+func TestIntegers(t *testing.T) {
+	e := &scale.Encoder{}
+	e.WriteU8(1)
+	e.WriteU32(2)
+	e.WriteU64(3)
+	e.WriteBytes([]byte{4})
+	require.Equal(t, "01"+"02000000"+"0300000000000000"+"04", hex.EncodeToString(e.Bytes()))
+
+	d := scale.NewDecoder(e.Bytes())
+	u8, err := d.ReadU8()
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), u8)
+
+	u32, err := d.ReadU32()
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), u32)
+
+	u64, err := d.ReadU64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), u64)
+
+	require.Equal(t, 1, d.Remaining())
+	require.ErrorIs(t, d.Finish("integers"), scale.ErrInvalidEncoding)
+
+	_, err = d.ReadU32()
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+
+	_, err = scale.NewDecoder(scale.EncodeCompact(1 << 32)).ReadCompactU32()
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+}
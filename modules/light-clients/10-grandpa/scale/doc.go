@@ -0,0 +1,11 @@
+/*
+Package scale implements the SCALE codec used by Substrate chains together with
+typed representations of the GRANDPA data relayed to the 10-grandpa light client:
+block headers and their digests, GRANDPA consensus logs, justifications, commits,
+precommits and finality proofs.
+
+Decoding only accepts canonical encodings, so that encoding a decoded value yields
+the original bytes. Hashes, public keys and signatures are marshalled to JSON as
+0x prefixed hex strings to make the decoded values human readable.
+*/
+package scale
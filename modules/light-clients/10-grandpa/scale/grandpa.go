@@ -0,0 +1,298 @@
+package scale
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// ConsensusLogKind is the variant of a GRANDPA consensus log.
+type ConsensusLogKind uint8
+
+// GRANDPA consensus log variants
+const (
+	ScheduledChangeLog ConsensusLogKind = 1
+	ForcedChangeLog    ConsensusLogKind = 2
+	OnDisabledLog      ConsensusLogKind = 3
+	PauseLog           ConsensusLogKind = 4
+	ResumeLog          ConsensusLogKind = 5
+)
+
+// precommitMessageVariant is the index of the Precommit variant of the GRANDPA
+// Message enum. It prefixes the payload signed by the authorities.
+const precommitMessageVariant = 1
+
+// Authority is a GRANDPA authority and its voting weight.
+type Authority struct {
+	ID     PublicKey `json:"id"`
+	Weight uint64    `json:"weight"`
+}
+
+// ScheduledChange is an authority set change that is enacted once the block
+// announcing it is finalized and delay further blocks have been built on top of it.
+type ScheduledChange struct {
+	NextAuthorities []Authority `json:"next_authorities"`
+	Delay           uint32      `json:"delay"`
+}
+
+// ConsensusLog is a GRANDPA consensus log stored in a header digest. Only the fields
+// of the log kind are set: Change for scheduled and forced changes, Median for forced
+// changes, AuthorityIndex for disabled authorities and Delay for pauses and resumes.
+type ConsensusLog struct {
+	Kind           ConsensusLogKind `json:"kind"`
+	Change         *ScheduledChange `json:"change,omitempty"`
+	Median         uint32           `json:"median,omitempty"`
+	AuthorityIndex uint64           `json:"authority_index,omitempty"`
+	Delay          uint32           `json:"delay,omitempty"`
+}
+
+// DecodeConsensusLog decodes a GRANDPA consensus log, failing on trailing bytes.
+func DecodeConsensusLog(bz []byte) (*ConsensusLog, error) {
+	d := NewDecoder(bz)
+	kind, err := d.ReadU8()
+	if err != nil {
+		return nil, err
+	}
+
+	log := &ConsensusLog{Kind: ConsensusLogKind(kind)}
+	switch log.Kind {
+	case ScheduledChangeLog:
+		log.Change, err = d.readScheduledChange()
+	case ForcedChangeLog:
+		if log.Median, err = d.ReadU32(); err != nil {
+			return nil, err
+		}
+		log.Change, err = d.readScheduledChange()
+	case OnDisabledLog:
+		log.AuthorityIndex, err = d.ReadU64()
+	case PauseLog, ResumeLog:
+		log.Delay, err = d.ReadU32()
+	default:
+		return nil, fmt.Errorf("%w: unknown GRANDPA consensus log variant %d", ErrInvalidEncoding, kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Finish("consensus log"); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+func (d *Decoder) readScheduledChange() (*ScheduledChange, error) {
+	length, err := d.ReadLength()
+	if err != nil {
+		return nil, err
+	}
+
+	change := &ScheduledChange{NextAuthorities: make([]Authority, length)}
+	for i := range change.NextAuthorities {
+		if change.NextAuthorities[i].ID, err = d.readPublicKey(); err != nil {
+			return nil, err
+		}
+
+		if change.NextAuthorities[i].Weight, err = d.ReadU64(); err != nil {
+			return nil, err
+		}
+	}
+
+	if change.Delay, err = d.ReadU32(); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// Precommit is a GRANDPA vote for a block and all of its ancestors.
+type Precommit struct {
+	TargetHash   Hash   `json:"target_hash"`
+	TargetNumber uint32 `json:"target_number"`
+}
+
+// SigningPayload returns the message signed by an authority casting the precommit
+// in the given round of the given authority set.
+func (p Precommit) SigningPayload(round, setID uint64) []byte {
+	payload := make([]byte, 0, 1+HashLength+4+8+8)
+	payload = append(payload, precommitMessageVariant)
+	payload = append(payload, p.TargetHash[:]...)
+	payload = binary.LittleEndian.AppendUint32(payload, p.TargetNumber)
+	payload = binary.LittleEndian.AppendUint64(payload, round)
+	payload = binary.LittleEndian.AppendUint64(payload, setID)
+	return payload
+}
+
+// SignedPrecommit is a precommit signed by a GRANDPA authority.
+type SignedPrecommit struct {
+	Precommit Precommit `json:"precommit"`
+	Signature Signature `json:"signature"`
+	ID        PublicKey `json:"id"`
+}
+
+// Commit is a set of precommits finalizing the commit target.
+type Commit struct {
+	TargetHash   Hash              `json:"target_hash"`
+	TargetNumber uint32            `json:"target_number"`
+	Precommits   []SignedPrecommit `json:"precommits"`
+}
+
+// Justification proves the finality of the commit target. The votes ancestries
+// link the targets of the precommits to the commit target.
+type Justification struct {
+	Round           uint64    `json:"round"`
+	Commit          Commit    `json:"commit"`
+	VotesAncestries []*Header `json:"votes_ancestries"`
+}
+
+// DecodeJustification decodes a GRANDPA justification, failing on trailing bytes.
+func DecodeJustification(bz []byte) (*Justification, error) {
+	var (
+		d   = NewDecoder(bz)
+		j   = &Justification{}
+		err error
+	)
+
+	if j.Round, err = d.ReadU64(); err != nil {
+		return nil, err
+	}
+
+	if j.Commit, err = d.readCommit(); err != nil {
+		return nil, err
+	}
+
+	length, err := d.ReadLength()
+	if err != nil {
+		return nil, err
+	}
+
+	j.VotesAncestries = make([]*Header, length)
+	for i := range j.VotesAncestries {
+		if j.VotesAncestries[i], err = d.ReadHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := d.Finish("justification"); err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (d *Decoder) readCommit() (Commit, error) {
+	var (
+		commit Commit
+		err    error
+	)
+
+	if commit.TargetHash, err = d.ReadHash(); err != nil {
+		return commit, err
+	}
+
+	if commit.TargetNumber, err = d.ReadU32(); err != nil {
+		return commit, err
+	}
+
+	length, err := d.ReadLength()
+	if err != nil {
+		return commit, err
+	}
+
+	commit.Precommits = make([]SignedPrecommit, length)
+	for i := range commit.Precommits {
+		precommit := &commit.Precommits[i]
+		if precommit.Precommit.TargetHash, err = d.ReadHash(); err != nil {
+			return commit, err
+		}
+
+		if precommit.Precommit.TargetNumber, err = d.ReadU32(); err != nil {
+			return commit, err
+		}
+
+		if precommit.Signature, err = d.readSignature(); err != nil {
+			return commit, err
+		}
+
+		if precommit.ID, err = d.readPublicKey(); err != nil {
+			return commit, err
+		}
+	}
+
+	return commit, nil
+}
+
+// Encode returns the encoding of the justification.
+func (j *Justification) Encode() []byte {
+	e := &Encoder{}
+	e.WriteU64(j.Round)
+	e.WriteBytes(j.Commit.TargetHash[:])
+	e.WriteU32(j.Commit.TargetNumber)
+	e.WriteCompact(uint64(len(j.Commit.Precommits)))
+	for _, precommit := range j.Commit.Precommits {
+		e.WriteBytes(precommit.Precommit.TargetHash[:])
+		e.WriteU32(precommit.Precommit.TargetNumber)
+		e.WriteBytes(precommit.Signature[:])
+		e.WriteBytes(precommit.ID[:])
+	}
+	e.WriteCompact(uint64(len(j.VotesAncestries)))
+	for _, header := range j.VotesAncestries {
+		header.encode(e)
+	}
+	return e.Bytes()
+}
+
+// FinalityProof proves the finality of a block. It carries the justification of
+// the block and the headers linking the block to the latest block known to the
+// recipient of the proof.
+type FinalityProof struct {
+	Block          Hash      `json:"block"`
+	Justification  []byte    `json:"justification"`
+	UnknownHeaders []*Header `json:"unknown_headers"`
+}
+
+// DecodeFinalityProof decodes a finality proof, failing on trailing bytes.
+func DecodeFinalityProof(bz []byte) (*FinalityProof, error) {
+	var (
+		d     = NewDecoder(bz)
+		proof = &FinalityProof{}
+		err   error
+	)
+
+	if proof.Block, err = d.ReadHash(); err != nil {
+		return nil, err
+	}
+
+	if proof.Justification, err = d.ReadVec(); err != nil {
+		return nil, err
+	}
+
+	length, err := d.ReadLength()
+	if err != nil {
+		return nil, err
+	}
+
+	proof.UnknownHeaders = make([]*Header, length)
+	for i := range proof.UnknownHeaders {
+		if proof.UnknownHeaders[i], err = d.ReadHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := d.Finish("finality proof"); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// Encode returns the encoding of the finality proof.
+func (p *FinalityProof) Encode() []byte {
+	e := &Encoder{}
+	e.WriteBytes(p.Block[:])
+	e.WriteVec(p.Justification)
+	e.WriteCompact(uint64(len(p.UnknownHeaders)))
+	for _, header := range p.UnknownHeaders {
+		header.encode(e)
+	}
+	return e.Bytes()
+}
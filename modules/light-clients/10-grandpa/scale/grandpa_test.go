@@ -0,0 +1,198 @@
+package scale_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+const (
+	// finalizedHash is the hash of relay chain block 101 finalized by the header fixture
+	finalizedHash = "0xb48736c9c573706b3b6da3e7380af27975a3f63c9c88b289ddb3949374302561"
+	// parentHash is the hash of relay chain block 100
+	parentHash = "0xc14369da27b595c5ce9768362ee8e498de699d55e7c5f234d81eca07fc25b37d"
+	// setID is the id of the authority set that signed the justification of the header fixture
+	setID = 3
+)
+
+// loadFixture returns the given field of the 10-grandpa test data.
+func loadFixture(t *testing.T, field string) []byte {
+	bz, err := os.ReadFile("../test_data/data.json")
+	require.NoError(t, err)
+
+	var data map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &data))
+
+	var encoded string
+	require.NoError(t, json.Unmarshal(data[field], &encoded))
+
+	bz, err = base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	return bz
+}
+
+func loadHeader(t *testing.T) *grandpa.Header {
+	header := &grandpa.Header{}
+	require.NoError(t, header.Unmarshal(loadFixture(t, "header")))
+	return header
+}
+
+func hash(t *testing.T, text string) scale.Hash {
+	var h scale.Hash
+	require.NoError(t, h.UnmarshalText([]byte(text)))
+	return h
+}
+
+func TestDecodeHeader(t *testing.T) {
+	unknownHeaders := loadHeader(t).FinalityProof.UnknownHeaders
+	encoded := unknownHeaders[len(unknownHeaders)-1]
+
+	header, err := scale.DecodeHeader(encoded)
+	require.NoError(t, err)
+	require.Equal(t, uint32(101), header.Number)
+	require.Equal(t, hash(t, parentHash), header.ParentHash)
+	require.Equal(t, hash(t, finalizedHash), header.Hash())
+	require.Equal(t, encoded, header.Encode())
+	require.Len(t, header.Digest, 6)
+
+	logs, err := header.GrandpaConsensusLogs()
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, scale.ScheduledChangeLog, logs[0].Kind)
+	require.Equal(t, uint32(0), logs[0].Change.Delay)
+	require.Len(t, logs[0].Change.NextAuthorities, 5)
+	for _, authority := range logs[0].Change.NextAuthorities {
+		require.Equal(t, uint64(1), authority.Weight)
+	}
+
+	// every header of the fixture links to its predecessor
+	for i := 1; i < len(unknownHeaders); i++ {
+		parent, err := scale.DecodeHeader(unknownHeaders[i-1])
+		require.NoError(t, err)
+
+		child, err := scale.DecodeHeader(unknownHeaders[i])
+		require.NoError(t, err)
+		require.Equal(t, parent.Hash(), child.ParentHash)
+		require.Equal(t, parent.Number+1, child.Number)
+	}
+
+	_, err = scale.DecodeHeader(append(encoded, 0))
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+
+	_, err = scale.DecodeHeader(encoded[:len(encoded)-1])
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+}
+
+func TestDecodeJustification(t *testing.T) {
+	encoded := loadHeader(t).FinalityProof.Justification
+
+	justification, err := scale.DecodeJustification(encoded)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), justification.Round)
+	require.Equal(t, hash(t, finalizedHash), justification.Commit.TargetHash)
+	require.Equal(t, uint32(101), justification.Commit.TargetNumber)
+	require.Len(t, justification.Commit.Precommits, 4)
+	require.Empty(t, justification.VotesAncestries)
+	require.Equal(t, encoded, justification.Encode())
+
+	for _, precommit := range justification.Commit.Precommits {
+		require.Equal(t, justification.Commit.TargetHash, precommit.Precommit.TargetHash)
+
+		payload := precommit.Precommit.SigningPayload(justification.Round, setID)
+		require.True(t, ed25519.Verify(precommit.ID[:], payload, precommit.Signature[:]))
+		require.False(t, ed25519.Verify(precommit.ID[:], precommit.Precommit.SigningPayload(justification.Round, setID+1), precommit.Signature[:]))
+	}
+
+	_, err = scale.DecodeJustification(append(encoded, 0))
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+
+	_, err = scale.DecodeJustification(encoded[:len(encoded)-1])
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+}
+
+func TestDecodeFinalityProof(t *testing.T) {
+	misbehaviour := &grandpa.Misbehaviour{}
+	require.NoError(t, misbehaviour.Unmarshal(loadFixture(t, "misbehaviour")))
+
+	for _, encoded := range [][]byte{misbehaviour.FirstFinalityProof, misbehaviour.SecondFinalityProof} {
+		proof, err := scale.DecodeFinalityProof(encoded)
+		require.NoError(t, err)
+		require.Equal(t, encoded, proof.Encode())
+
+		justification, err := scale.DecodeJustification(proof.Justification)
+		require.NoError(t, err)
+		require.Equal(t, proof.Block, justification.Commit.TargetHash)
+	}
+
+	_, err := scale.DecodeFinalityProof([]byte{1, 2, 3})
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+}
+
+func TestDecodeConsensusLog(t *testing.T) {
+	e := &scale.Encoder{}
+	e.WriteU8(uint8(scale.ForcedChangeLog))
+	e.WriteU32(7)
+	e.WriteCompact(1)
+	e.WriteBytes(make([]byte, scale.PublicKeyLength))
+	e.WriteU64(10)
+	e.WriteU32(3)
+
+	log, err := scale.DecodeConsensusLog(e.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, &scale.ConsensusLog{
+		Kind:   scale.ForcedChangeLog,
+		Median: 7,
+		Change: &scale.ScheduledChange{
+			NextAuthorities: []scale.Authority{{Weight: 10}},
+			Delay:           3,
+		},
+	}, log)
+
+	log, err = scale.DecodeConsensusLog([]byte{uint8(scale.OnDisabledLog), 2, 0, 0, 0, 0, 0, 0, 0})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), log.AuthorityIndex)
+
+	log, err = scale.DecodeConsensusLog([]byte{uint8(scale.PauseLog), 5, 0, 0, 0})
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), log.Delay)
+
+	_, err = scale.DecodeConsensusLog([]byte{6})
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+
+	_, err = scale.DecodeConsensusLog([]byte{uint8(scale.ResumeLog), 5, 0, 0, 0, 0})
+	require.ErrorIs(t, err, scale.ErrInvalidEncoding)
+}
+
+func TestJSON(t *testing.T) {
+	justification, err := scale.DecodeJustification(loadHeader(t).FinalityProof.Justification)
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(justification)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"target_hash":"`+finalizedHash+`"`)
+
+	var decoded scale.Justification
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, justification.Encode(), decoded.Encode())
+
+	header, err := scale.DecodeHeader(loadHeader(t).FinalityProof.UnknownHeaders[0])
+	require.NoError(t, err)
+
+	bz, err = json.Marshal(header)
+	require.NoError(t, err)
+
+	var decodedHeader scale.Header
+	require.NoError(t, json.Unmarshal(bz, &decodedHeader))
+	require.Equal(t, header.Hash(), decodedHeader.Hash())
+
+	var h scale.Hash
+	require.Error(t, h.UnmarshalText([]byte("0x01")))
+	require.Error(t, h.UnmarshalText([]byte("zz")))
+}
@@ -0,0 +1,189 @@
+package scale
+
+import (
+	"fmt"
+)
+
+// DigestItemKind is the variant of a header digest item.
+type DigestItemKind uint8
+
+// digest item variants
+const (
+	DigestOther                     DigestItemKind = 0
+	DigestConsensus                 DigestItemKind = 4
+	DigestSeal                      DigestItemKind = 5
+	DigestPreRuntime                DigestItemKind = 6
+	DigestRuntimeEnvironmentUpdated DigestItemKind = 8
+)
+
+// ConsensusEngineID identifies the consensus engine a digest item belongs to.
+type ConsensusEngineID [4]byte
+
+// GrandpaEngineID is the consensus engine id of GRANDPA digest items.
+var GrandpaEngineID = ConsensusEngineID{'F', 'R', 'N', 'K'}
+
+// String returns the engine id as text, e.g. FRNK.
+func (id ConsensusEngineID) String() string { return string(id[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (id ConsensusEngineID) MarshalText() ([]byte, error) { return id[:], nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (id *ConsensusEngineID) UnmarshalText(text []byte) error {
+	if len(text) != len(id) {
+		return fmt.Errorf("expected %d bytes, got %d", len(id), len(text))
+	}
+
+	copy(id[:], text)
+	return nil
+}
+
+// Header is a Substrate block header.
+type Header struct {
+	ParentHash     Hash         `json:"parent_hash"`
+	Number         uint32       `json:"number"`
+	StateRoot      Hash         `json:"state_root"`
+	ExtrinsicsRoot Hash         `json:"extrinsics_root"`
+	Digest         []DigestItem `json:"digest"`
+}
+
+// DigestItem is a single entry of a header digest. The engine id is only set for
+// consensus, seal and pre-runtime items.
+type DigestItem struct {
+	Kind     DigestItemKind    `json:"kind"`
+	EngineID ConsensusEngineID `json:"engine_id"`
+	Data     []byte            `json:"data"`
+}
+
+// DecodeHeader decodes a header, failing on trailing bytes.
+func DecodeHeader(bz []byte) (*Header, error) {
+	d := NewDecoder(bz)
+	header, err := d.ReadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Finish("header"); err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// ReadHeader reads a header.
+func (d *Decoder) ReadHeader() (*Header, error) {
+	var (
+		header = &Header{}
+		err    error
+	)
+
+	if header.ParentHash, err = d.ReadHash(); err != nil {
+		return nil, err
+	}
+
+	if header.Number, err = d.ReadCompactU32(); err != nil {
+		return nil, err
+	}
+
+	if header.StateRoot, err = d.ReadHash(); err != nil {
+		return nil, err
+	}
+
+	if header.ExtrinsicsRoot, err = d.ReadHash(); err != nil {
+		return nil, err
+	}
+
+	length, err := d.ReadLength()
+	if err != nil {
+		return nil, err
+	}
+
+	header.Digest = make([]DigestItem, length)
+	for i := range header.Digest {
+		if header.Digest[i], err = d.readDigestItem(); err != nil {
+			return nil, err
+		}
+	}
+
+	return header, nil
+}
+
+func (d *Decoder) readDigestItem() (DigestItem, error) {
+	var item DigestItem
+
+	kind, err := d.ReadU8()
+	if err != nil {
+		return item, err
+	}
+	item.Kind = DigestItemKind(kind)
+
+	switch item.Kind {
+	case DigestConsensus, DigestSeal, DigestPreRuntime:
+		engineID, err := d.ReadBytes(len(item.EngineID))
+		if err != nil {
+			return item, err
+		}
+		copy(item.EngineID[:], engineID)
+
+		if item.Data, err = d.ReadVec(); err != nil {
+			return item, err
+		}
+	case DigestOther:
+		if item.Data, err = d.ReadVec(); err != nil {
+			return item, err
+		}
+	case DigestRuntimeEnvironmentUpdated:
+	default:
+		return item, fmt.Errorf("%w: unknown digest item variant %d", ErrInvalidEncoding, kind)
+	}
+
+	return item, nil
+}
+
+// Encode returns the encoding of the header.
+func (h *Header) Encode() []byte {
+	e := &Encoder{}
+	h.encode(e)
+	return e.Bytes()
+}
+
+func (h *Header) encode(e *Encoder) {
+	e.WriteBytes(h.ParentHash[:])
+	e.WriteCompact(uint64(h.Number))
+	e.WriteBytes(h.StateRoot[:])
+	e.WriteBytes(h.ExtrinsicsRoot[:])
+	e.WriteCompact(uint64(len(h.Digest)))
+	for _, item := range h.Digest {
+		e.WriteU8(uint8(item.Kind))
+		switch item.Kind {
+		case DigestConsensus, DigestSeal, DigestPreRuntime:
+			e.WriteBytes(item.EngineID[:])
+			e.WriteVec(item.Data)
+		case DigestOther:
+			e.WriteVec(item.Data)
+		}
+	}
+}
+
+// Hash returns the block hash, i.e. the BlakeTwo256 hash of the encoded header.
+func (h *Header) Hash() Hash {
+	return Blake2b256(h.Encode())
+}
+
+// GrandpaConsensusLogs decodes the GRANDPA consensus logs of the header digest.
+func (h *Header) GrandpaConsensusLogs() ([]*ConsensusLog, error) {
+	var logs []*ConsensusLog
+	for _, item := range h.Digest {
+		if item.Kind != DigestConsensus || item.EngineID != GrandpaEngineID {
+			continue
+		}
+
+		log, err := DecodeConsensusLog(item.Data)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,99 @@
+package scale
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// HashLength is the length of a BlakeTwo256 hash as used by Substrate chains.
+	HashLength = 32
+	// PublicKeyLength is the length of an ed25519 GRANDPA authority public key.
+	PublicKeyLength = 32
+	// SignatureLength is the length of an ed25519 signature.
+	SignatureLength = 64
+)
+
+// Hash is a BlakeTwo256 hash.
+type Hash [HashLength]byte
+
+// PublicKey is the ed25519 public key identifying a GRANDPA authority.
+type PublicKey [PublicKeyLength]byte
+
+// Signature is an ed25519 signature.
+type Signature [SignatureLength]byte
+
+// Blake2b256 returns the BlakeTwo256 hash of bz.
+func Blake2b256(bz []byte) Hash {
+	return blake2b.Sum256(bz)
+}
+
+// String returns the 0x prefixed hex encoding of the hash.
+func (h Hash) String() string { return encodeHex(h[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (h Hash) MarshalText() ([]byte, error) { return []byte(h.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *Hash) UnmarshalText(text []byte) error { return decodeHex(text, h[:]) }
+
+// String returns the 0x prefixed hex encoding of the public key.
+func (pk PublicKey) String() string { return encodeHex(pk[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (pk PublicKey) MarshalText() ([]byte, error) { return []byte(pk.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (pk *PublicKey) UnmarshalText(text []byte) error { return decodeHex(text, pk[:]) }
+
+// String returns the 0x prefixed hex encoding of the signature.
+func (s Signature) String() string { return encodeHex(s[:]) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Signature) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Signature) UnmarshalText(text []byte) error { return decodeHex(text, s[:]) }
+
+func encodeHex(bz []byte) string {
+	return "0x" + hex.EncodeToString(bz)
+}
+
+func decodeHex(text, out []byte) error {
+	bz, err := hex.DecodeString(strings.TrimPrefix(string(text), "0x"))
+	if err != nil {
+		return err
+	}
+
+	if len(bz) != len(out) {
+		return fmt.Errorf("expected %d bytes, got %d", len(out), len(bz))
+	}
+
+	copy(out, bz)
+	return nil
+}
+
+func (d *Decoder) readPublicKey() (PublicKey, error) {
+	var pk PublicKey
+	bz, err := d.ReadBytes(PublicKeyLength)
+	if err != nil {
+		return pk, err
+	}
+
+	copy(pk[:], bz)
+	return pk, nil
+}
+
+func (d *Decoder) readSignature() (Signature, error) {
+	var sig Signature
+	bz, err := d.ReadBytes(SignatureLength)
+	if err != nil {
+		return sig, err
+	}
+
+	copy(sig[:], bz)
+	return sig, nil
+}
@@ -0,0 +1,130 @@
+package grandpa
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var (
+	// KeyProcessedTime is appended to consensus state key to store the processed time
+	KeyProcessedTime = []byte("/processedTime")
+	// KeyProcessedHeight is appended to consensus state key to store the processed height
+	KeyProcessedHeight = []byte("/processedHeight")
+)
+
+// setClientState stores the client state
+func setClientState(clientStore sdk.KVStore, cdc codec.BinaryCodec, clientState *ClientState) {
+	key := host.ClientStateKey()
+	val := clienttypes.MustMarshalClientState(cdc, clientState)
+	clientStore.Set(key, val)
+}
+
+// setConsensusState stores the consensus state at the given height.
+func setConsensusState(clientStore sdk.KVStore, cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height) {
+	key := host.ConsensusStateKey(height)
+	val := clienttypes.MustMarshalConsensusState(cdc, consensusState)
+	clientStore.Set(key, val)
+}
+
+// GetConsensusState retrieves the consensus state from the client prefixed store.
+// If the ConsensusState does not exist in state for the provided height a nil value and false boolean flag is returned
+func GetConsensusState(store sdk.KVStore, cdc codec.BinaryCodec, height exported.Height) (*ConsensusState, bool) {
+	bz := store.Get(host.ConsensusStateKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	consensusStateI := clienttypes.MustUnmarshalConsensusState(cdc, bz)
+	consensusState, ok := consensusStateI.(*ConsensusState)
+	if !ok {
+		return nil, false
+	}
+
+	return consensusState, true
+}
+
+// IterateConsensusMetadata iterates through the prefix store and applies the callback.
+// If the cb returns true, then iterator will close and stop.
+func IterateConsensusMetadata(store sdk.KVStore, cb func(key, val []byte) bool) {
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyConsensusStatePrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// processed time key in prefix store has format: "consensusState/<height>/processedTime"
+		if len(keySplit) != 3 {
+			// ignore all consensus state keys
+			continue
+		}
+
+		if keySplit[2] != "processedTime" && keySplit[2] != "processedHeight" {
+			// only perform callback on consensus metadata
+			continue
+		}
+
+		if cb(iterator.Key(), iterator.Value()) {
+			break
+		}
+	}
+}
+
+// ProcessedTimeKey returns the key under which the processed time will be stored in the client store.
+func ProcessedTimeKey(height exported.Height) []byte {
+	return append(host.ConsensusStateKey(height), KeyProcessedTime...)
+}
+
+// SetProcessedTime stores the time at which a header was processed and the corresponding consensus state was created.
+func SetProcessedTime(clientStore sdk.KVStore, height exported.Height, timeNs uint64) {
+	key := ProcessedTimeKey(height)
+	val := sdk.Uint64ToBigEndian(timeNs)
+	clientStore.Set(key, val)
+}
+
+// GetProcessedTime gets the time (in nanoseconds) at which this chain received and processed a parachain header.
+func GetProcessedTime(clientStore sdk.KVStore, height exported.Height) (uint64, bool) {
+	key := ProcessedTimeKey(height)
+	bz := clientStore.Get(key)
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// ProcessedHeightKey returns the key under which the processed height will be stored in the client store.
+func ProcessedHeightKey(height exported.Height) []byte {
+	return append(host.ConsensusStateKey(height), KeyProcessedHeight...)
+}
+
+// SetProcessedHeight stores the height at which a header was processed and the corresponding consensus state was created.
+func SetProcessedHeight(clientStore sdk.KVStore, consHeight, processedHeight exported.Height) {
+	key := ProcessedHeightKey(consHeight)
+	val := []byte(processedHeight.String())
+	clientStore.Set(key, val)
+}
+
+// GetProcessedHeight gets the height at which this chain received and processed a parachain header.
+func GetProcessedHeight(clientStore sdk.KVStore, height exported.Height) (exported.Height, bool) {
+	key := ProcessedHeightKey(height)
+	bz := clientStore.Get(key)
+	if len(bz) == 0 {
+		return nil, false
+	}
+	processedHeight, err := clienttypes.ParseHeight(string(bz))
+	if err != nil {
+		return nil, false
+	}
+	return processedHeight, true
+}
+
+// setConsensusMetadata sets context time as processed time and set context height as processed height
+// for the consensus state at the given height.
+func setConsensusMetadata(ctx sdk.Context, clientStore sdk.KVStore, height exported.Height) {
+	SetProcessedTime(clientStore, height, uint64(ctx.BlockTime().UnixNano()))
+	SetProcessedHeight(clientStore, height, clienttypes.GetSelfHeight(ctx))
+}
@@ -0,0 +1,113 @@
+package grandpa
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+const (
+	// hashLength is the length of a BlakeTwo256 hash as used by Substrate chains.
+	hashLength = scale.HashLength
+	// publicKeyLength is the length of an ed25519 GRANDPA authority public key.
+	publicKeyLength = scale.PublicKeyLength
+)
+
+// substrateHeader is a decoded Substrate block header together with its block hash.
+type substrateHeader struct {
+	*scale.Header
+	hash scale.Hash
+}
+
+// scheduledChange is a GRANDPA authority set change announced in a header digest.
+type scheduledChange struct {
+	authorities []*Authority
+	delay       uint32
+}
+
+// decodeSubstrateHeader decodes a SCALE encoded Substrate header. As only canonical
+// encodings are accepted, the block hash is the hash of the given bytes.
+func decodeSubstrateHeader(bz []byte) (*substrateHeader, error) {
+	header, err := scale.DecodeHeader(bz)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	return &substrateHeader{
+		Header: header,
+		hash:   scale.Blake2b256(bz),
+	}, nil
+}
+
+// grandpaAuthoritySetChange returns the GRANDPA authority set change announced
+// in the header digest, if any. Forced changes are reported through the forced
+// return value since they cannot be verified by a light client.
+func (h *substrateHeader) grandpaAuthoritySetChange() (change *scheduledChange, forced bool, err error) {
+	logs, err := h.GrandpaConsensusLogs()
+	if err != nil {
+		return nil, false, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	for _, log := range logs {
+		switch log.Kind {
+		case scale.ScheduledChangeLog:
+			if change != nil {
+				return nil, false, sdkerrors.Wrapf(ErrInvalidAuthoritySetChange, "multiple scheduled changes in block %d", h.Number)
+			}
+
+			change = &scheduledChange{
+				authorities: make([]*Authority, len(log.Change.NextAuthorities)),
+				delay:       log.Change.Delay,
+			}
+			for i, authority := range log.Change.NextAuthorities {
+				change.authorities[i] = &Authority{
+					PublicKey: append([]byte(nil), authority.ID[:]...),
+					Weight:    authority.Weight,
+				}
+			}
+		case scale.ForcedChangeLog:
+			forced = true
+		default:
+			// the remaining signals do not affect the authority set tracked by the light client
+		}
+	}
+
+	return change, forced, nil
+}
+
+// decodeTimestampExtrinsic decodes the `timestamp.set` inherent of a parachain
+// block and returns the timestamp in milliseconds. The extrinsic is an unsigned
+// version 4 extrinsic whose call arguments consist of a single compact moment.
+func decodeTimestampExtrinsic(extrinsic []byte) (uint64, error) {
+	encoded, err := scale.DecodeVec(extrinsic)
+	if err != nil {
+		return 0, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	d := scale.NewDecoder(encoded)
+	version, err := d.ReadU8()
+	if err != nil {
+		return 0, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	// the most significant bit is set for signed extrinsics
+	if version != 0x04 {
+		return 0, sdkerrors.Wrapf(ErrInvalidTimestampExtrinsic, "expected unsigned version 4 extrinsic, got version byte %#x", version)
+	}
+
+	// skip the pallet and call indices
+	if _, err := d.ReadBytes(2); err != nil {
+		return 0, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	moment, err := d.ReadCompact()
+	if err != nil {
+		return 0, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	if err := d.Finish("timestamp call"); err != nil {
+		return 0, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	return moment, nil
+}
@@ -0,0 +1,284 @@
+package grandpa
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+// headerUpdate holds the state changes resulting from a verified Header.
+type headerUpdate struct {
+	justification   *scale.Justification
+	relayHash       scale.Hash
+	relayHeight     uint32
+	setChange       *scheduledChange
+	heights         []clienttypes.Height
+	consensusStates map[clienttypes.Height]*ConsensusState
+}
+
+// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+func (cs *ClientState) VerifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	clientMsg exported.ClientMessage,
+) error {
+	switch msg := clientMsg.(type) {
+	case *Header:
+		return cs.verifyHeader(msg)
+	case *Misbehaviour:
+		return cs.verifyMisbehaviour(msg)
+	default:
+		return clienttypes.ErrInvalidClientType
+	}
+}
+
+// verifyHeader returns an error if:
+// - the header does not pass basic validation
+// - the finalized relay chain block does not descend from the latest relay chain block known to the client
+// - the justification is not signed by a supermajority of the current authority set
+// - an authority set change is not enacted by the finalized relay chain block
+// - any parachain header is not included in the newly finalized relay chain blocks
+func (cs *ClientState) verifyHeader(header *Header) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	update, err := cs.processHeader(header)
+	if err != nil {
+		return err
+	}
+
+	return verifyJustification(update.justification, cs.CurrentAuthorities, cs.CurrentSetId)
+}
+
+// processHeader decodes the header and checks that it extends the relay chain tracked
+// by the client. It does not verify the justification signatures.
+func (cs *ClientState) processHeader(header *Header) (*headerUpdate, error) {
+	if header.ParaId != cs.ParaId {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "header para id %d does not match client para id %d", header.ParaId, cs.ParaId)
+	}
+
+	j, err := decodeJustification(header.FinalityProof.Justification)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(j.Commit.TargetHash[:], header.FinalityProof.Block) {
+		return nil, sdkerrors.Wrapf(ErrInvalidJustification, "justification target %s does not match finalized block %X", j.Commit.TargetHash, header.FinalityProof.Block)
+	}
+
+	if j.Commit.TargetNumber <= cs.LatestRelayHeight {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "finalized relay block %d is not newer than the latest relay block %d", j.Commit.TargetNumber, cs.LatestRelayHeight)
+	}
+
+	ancestry, err := cs.relayChainAncestry(j, header.FinalityProof.UnknownHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &headerUpdate{
+		justification:   j,
+		relayHash:       j.Commit.TargetHash,
+		relayHeight:     j.Commit.TargetNumber,
+		consensusStates: make(map[clienttypes.Height]*ConsensusState),
+	}
+
+	relayHeaders := make(map[string]*substrateHeader, len(ancestry))
+	for _, relayHeader := range ancestry {
+		relayHeaders[string(relayHeader.hash[:])] = relayHeader
+
+		change, forced, err := relayHeader.grandpaAuthoritySetChange()
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidAuthoritySetChange, "relay block %d: %s", relayHeader.Number, err)
+		}
+
+		if forced {
+			return nil, sdkerrors.Wrapf(ErrInvalidAuthoritySetChange, "forced authority set change in relay block %d is not supported", relayHeader.Number)
+		}
+
+		if change == nil {
+			continue
+		}
+
+		// a scheduled change is enacted once the block at number + delay is finalized,
+		// blocks after it are finalized by the new authority set
+		if uint64(relayHeader.Number)+uint64(change.delay) != uint64(j.Commit.TargetNumber) {
+			return nil, sdkerrors.Wrapf(
+				ErrInvalidAuthoritySetChange,
+				"authority set change scheduled in relay block %d with delay %d must be enacted by the finalized block %d",
+				relayHeader.Number, change.delay, j.Commit.TargetNumber,
+			)
+		}
+
+		if update.setChange != nil {
+			return nil, sdkerrors.Wrap(ErrInvalidAuthoritySetChange, "multiple authority set changes enacted by the finalized block")
+		}
+
+		if err := validateAuthorities(change.authorities); err != nil {
+			return nil, err
+		}
+
+		update.setChange = change
+	}
+
+	var latestParaHeight uint32
+	for i, parachainHeader := range header.ParachainHeaders {
+		relayHeader, ok := relayHeaders[string(parachainHeader.RelayHash)]
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidParachainHeader, "relay block %X of parachain header %d is not newly finalized", parachainHeader.RelayHash, i)
+		}
+
+		height, consensusState, err := VerifyParachainHeader(cs.ParaId, relayHeader.StateRoot[:], parachainHeader.ParachainHeader)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "parachain header %d", i)
+		}
+
+		if existing, ok := update.consensusStates[height]; ok {
+			if !bytes.Equal(existing.Root, consensusState.Root) {
+				return nil, sdkerrors.Wrapf(ErrInvalidParachainHeader, "conflicting parachain headers at height %s", height)
+			}
+			continue
+		}
+
+		update.heights = append(update.heights, height)
+		update.consensusStates[height] = consensusState
+		if uint32(height.RevisionHeight) > latestParaHeight {
+			latestParaHeight = uint32(height.RevisionHeight)
+		}
+	}
+
+	if len(header.ParachainHeaders) != 0 && header.ParaHeight != latestParaHeight {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "header para height %d does not match latest parachain header height %d", header.ParaHeight, latestParaHeight)
+	}
+
+	sort.Slice(update.heights, func(i, j int) bool {
+		return update.heights[i].LT(update.heights[j])
+	})
+
+	return update, nil
+}
+
+// relayChainAncestry links the finalized relay chain block to the latest relay chain block
+// known to the client using the unknown headers of the finality proof. It returns the
+// newly finalized headers, starting from the finalized block.
+func (cs *ClientState) relayChainAncestry(j *scale.Justification, unknownHeaders [][]byte) ([]*substrateHeader, error) {
+	headers := make(map[scale.Hash]*substrateHeader, len(unknownHeaders))
+	for i, bz := range unknownHeaders {
+		header, err := decodeSubstrateHeader(bz)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidHeader, "unknown header %d: %s", i, err)
+		}
+		headers[header.hash] = header
+	}
+
+	var (
+		ancestry []*substrateHeader
+		hash     = j.Commit.TargetHash
+		number   = j.Commit.TargetNumber
+	)
+	for number > cs.LatestRelayHeight {
+		header, ok := headers[hash]
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidHeader, "missing relay chain header %s at height %d", hash, number)
+		}
+
+		if header.Number != number {
+			return nil, sdkerrors.Wrapf(ErrInvalidHeader, "relay chain header %s has number %d, expected %d", hash, header.Number, number)
+		}
+
+		ancestry = append(ancestry, header)
+		hash, number = header.ParentHash, number-1
+	}
+
+	if !bytes.Equal(hash[:], cs.LatestRelayHash) {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "finalized relay block does not descend from the latest relay block %X", cs.LatestRelayHash)
+	}
+
+	return ancestry, nil
+}
+
+// UpdateState may be used to either create a consensus state for:
+// - a future height greater than the latest client state height
+// - a past height that was skipped during bisection
+// A consensus state is created for every parachain header included in the Header and the
+// relay chain block and authority set tracked by the client are advanced to the finalized block.
+// A list containing the heights of the stored consensus states is returned.
+func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
+	}
+
+	update, err := cs.processHeader(header)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "header must be verified before updating state"))
+	}
+
+	cs.LatestRelayHash = update.relayHash[:]
+	cs.LatestRelayHeight = update.relayHeight
+	if update.setChange != nil {
+		cs.CurrentSetId++
+		cs.CurrentAuthorities = update.setChange.authorities
+	}
+
+	heights := make([]exported.Height, 0, len(update.heights))
+	for _, height := range update.heights {
+		heights = append(heights, height)
+
+		// check for duplicate update
+		if consensusState, _ := GetConsensusState(clientStore, cdc, height); consensusState != nil {
+			continue
+		}
+
+		setConsensusState(clientStore, cdc, update.consensusStates[height], height)
+		setConsensusMetadata(ctx, clientStore, height)
+
+		if uint32(height.RevisionHeight) > cs.LatestParaHeight {
+			cs.LatestParaHeight = uint32(height.RevisionHeight)
+		}
+	}
+
+	setClientState(clientStore, cdc, cs)
+
+	return heights
+}
+
+// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState at the
+// relay chain height at which misbehaviour was detected. For a Misbehaviour message this is
+// the lower of the two conflicting finalized blocks, as the relay chain has no canonical block
+// from that height on. This method should only be called when misbehaviour is detected as it
+// does not perform any misbehaviour checks.
+func (cs *ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) {
+	frozenHeight := uint64(cs.LatestRelayHeight)
+	switch msg := clientMsg.(type) {
+	case *Header:
+		if j, err := decodeJustification(msg.FinalityProof.Justification); err == nil {
+			frozenHeight = uint64(j.Commit.TargetNumber)
+		}
+	case *Misbehaviour:
+		first, err := cs.decodeFinalizedFork(msg.FirstFinalityProof)
+		if err != nil {
+			break
+		}
+		second, err := cs.decodeFinalizedFork(msg.SecondFinalityProof)
+		if err != nil {
+			break
+		}
+
+		frozenHeight = uint64(first.justification.Commit.TargetNumber)
+		if second.justification.Commit.TargetNumber < first.justification.Commit.TargetNumber {
+			frozenHeight = uint64(second.justification.Commit.TargetNumber)
+		}
+	}
+
+	cs.XFrozenHeight = &ClientState_FrozenHeight{FrozenHeight: frozenHeight}
+
+	setClientState(clientStore, cdc, cs)
+}
@@ -0,0 +1,181 @@
+package grandpa_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+)
+
+// signatureOffset is the offset of the first precommit signature in the SCALE encoded justification:
+// round (8) + target hash (32) + target number (4) + precommits length (1) + hash (32) + number (4).
+const signatureOffset = 81
+
+func (suite *GrandpaTestSuite) TestVerifyHeader() {
+	var clientMsg exported.ClientMessage
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verify header", func() {}, true,
+		},
+		{
+			"header does not advance the relay chain", func() {
+				clientMsg = suite.header(suite.testData.HeaderOld)
+			},
+			false,
+		},
+		{
+			"invalid client message type", func() {
+				clientMsg = &ibctm.Header{}
+			},
+			false,
+		},
+		{
+			"para id does not match", func() {
+				clientMsg.(*grandpa.Header).ParaId++
+			},
+			false,
+		},
+		{
+			"finalized block does not match justification target", func() {
+				clientMsg.(*grandpa.Header).FinalityProof.Block = make([]byte, 32)
+			},
+			false,
+		},
+		{
+			"invalid justification encoding", func() {
+				clientMsg.(*grandpa.Header).FinalityProof.Justification = []byte{1, 2, 3}
+			},
+			false,
+		},
+		{
+			"invalid precommit signature", func() {
+				clientMsg.(*grandpa.Header).FinalityProof.Justification[signatureOffset] ^= 0xff
+			},
+			false,
+		},
+		{
+			"justification signed by a different authority set", func() {
+				suite.clientState.CurrentSetId++
+			},
+			false,
+		},
+		{
+			"missing relay chain ancestry", func() {
+				header := clientMsg.(*grandpa.Header)
+				header.FinalityProof.UnknownHeaders = header.FinalityProof.UnknownHeaders[:len(header.FinalityProof.UnknownHeaders)-1]
+			},
+			false,
+		},
+		{
+			"parachain header included in unknown relay block", func() {
+				clientMsg.(*grandpa.Header).ParachainHeaders[0].RelayHash = make([]byte, 32)
+			},
+			false,
+		},
+		{
+			"invalid parachain state proof", func() {
+				clientMsg.(*grandpa.Header).ParachainHeaders[0].ParachainHeader.StateProof = nil
+			},
+			false,
+		},
+		{
+			"invalid timestamp extrinsic proof", func() {
+				clientMsg.(*grandpa.Header).ParachainHeaders[0].ParachainHeader.ExtrinsicProof = nil
+			},
+			false,
+		},
+		{
+			"para height does not match latest parachain header", func() {
+				clientMsg.(*grandpa.Header).ParaHeight++
+			},
+			false,
+		},
+		{
+			"invalid misbehaviour", func() {
+				clientMsg = &grandpa.Misbehaviour{FirstFinalityProof: []byte{1}, SecondFinalityProof: []byte{2}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clientMsg = suite.header(suite.testData.Header)
+
+			tc.malleate()
+
+			err := suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, clientMsg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *GrandpaTestSuite) TestUpdateState() {
+	header := suite.header(suite.testData.Header)
+	suite.Require().NoError(suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header))
+
+	heights := suite.clientState.UpdateState(suite.ctx, suite.chainA.Codec, suite.store, header)
+	suite.Require().Equal([]exported.Height{
+		clienttypes.NewHeight(paraID, 34),
+		clienttypes.NewHeight(paraID, 37),
+		clienttypes.NewHeight(paraID, 39),
+	}, heights)
+
+	suite.Require().Equal(uint32(101), suite.clientState.LatestRelayHeight)
+	suite.Require().Equal(header.FinalityProof.Block, suite.clientState.LatestRelayHash)
+	suite.Require().Equal(uint64(4), suite.clientState.CurrentSetId)
+	suite.Require().Equal(clienttypes.NewHeight(paraID, 39), suite.clientState.GetLatestHeight())
+
+	for _, height := range heights {
+		consensusState, found := grandpa.GetConsensusState(suite.store, suite.chainA.Codec, height)
+		suite.Require().True(found)
+		suite.Require().NoError(consensusState.ValidateBasic())
+
+		_, found = grandpa.GetProcessedTime(suite.store, height)
+		suite.Require().True(found)
+		_, found = grandpa.GetProcessedHeight(suite.store, height)
+		suite.Require().True(found)
+	}
+
+	clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.clientState, clientState)
+	suite.Require().Equal(exported.Active, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	// the same header cannot be applied twice
+	err := suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header)
+	suite.Require().Error(err)
+}
+
+func (suite *GrandpaTestSuite) TestUpdateStateOnMisbehaviour() {
+	header := suite.header(suite.testData.Header)
+
+	suite.clientState.UpdateStateOnMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, header)
+
+	clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().True(clientState.(*grandpa.ClientState).IsFrozen())
+	suite.Require().Equal(&grandpa.ClientState_FrozenHeight{FrozenHeight: 101}, clientState.(*grandpa.ClientState).XFrozenHeight)
+	suite.Require().Equal(exported.Frozen, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	// misbehaviour freezes the client at the lower of the conflicting finalized blocks
+	suite.SetupTest()
+	misbehaviour := suite.misbehaviour(suite.testData.Misbehaviour)
+	suite.Require().NoError(suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, misbehaviour))
+	suite.Require().True(suite.clientState.CheckForMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, misbehaviour))
+
+	suite.clientState.UpdateStateOnMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, misbehaviour)
+	suite.Require().Equal(&grandpa.ClientState_FrozenHeight{FrozenHeight: 93}, suite.clientState.XFrozenHeight)
+	suite.Require().Equal(exported.Frozen, suite.clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+}
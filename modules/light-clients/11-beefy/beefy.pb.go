@@ -0,0 +1,3251 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/beefy/v1/beefy.proto
+
+package beefy
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_10_grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BeefyAuthoritySet is a BEEFY authority set as committed to in MMR leaves.
+type BeefyAuthoritySet struct {
+	// id of the authority set, incremented on every authority set change
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// number of authorities in the set
+	Len uint32 `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+	// keccak256 binary merkle root of the ethereum addresses of the authorities
+	Root []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *BeefyAuthoritySet) Reset()         { *m = BeefyAuthoritySet{} }
+func (m *BeefyAuthoritySet) String() string { return proto.CompactTextString(m) }
+func (*BeefyAuthoritySet) ProtoMessage()    {}
+func (*BeefyAuthoritySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{0}
+}
+func (m *BeefyAuthoritySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeefyAuthoritySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeefyAuthoritySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeefyAuthoritySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeefyAuthoritySet.Merge(m, src)
+}
+func (m *BeefyAuthoritySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeefyAuthoritySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeefyAuthoritySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeefyAuthoritySet proto.InternalMessageInfo
+
+func (m *BeefyAuthoritySet) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BeefyAuthoritySet) GetLen() uint32 {
+	if m != nil {
+		return m.Len
+	}
+	return 0
+}
+
+func (m *BeefyAuthoritySet) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+// ClientState for the beefy client
+type ClientState struct {
+	// Latest MMR root signed by the BEEFY authorities
+	MmrRootHash []byte `protobuf:"bytes,1,opt,name=mmr_root_hash,json=mmrRootHash,proto3" json:"mmr_root_hash,omitempty"`
+	// Relay chain block number of the latest MMR root
+	LatestBeefyHeight uint32 `protobuf:"varint,2,opt,name=latest_beefy_height,json=latestBeefyHeight,proto3" json:"latest_beefy_height,omitempty"`
+	// Types that are valid to be assigned to XFrozenHeight:
+	//	*ClientState_FrozenHeight
+	XFrozenHeight isClientState_XFrozenHeight `protobuf_oneof:"_frozen_height"`
+	// Relay chain block number of the first MMR leaf
+	BeefyActivationBlock uint32 `protobuf:"varint,4,opt,name=beefy_activation_block,json=beefyActivationBlock,proto3" json:"beefy_activation_block,omitempty"`
+	// Authority set signing the commitments of the current session
+	Authority *BeefyAuthoritySet `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Authority set signing the commitments of the next session
+	NextAuthoritySet *BeefyAuthoritySet `protobuf:"bytes,6,opt,name=next_authority_set,json=nextAuthoritySet,proto3" json:"next_authority_set,omitempty"`
+	// Known relay chains
+	RelayChain _10_grandpa.RelayChain `protobuf:"varint,7,opt,name=relay_chain,json=relayChain,proto3,enum=ibc.lightclients.grandpa.v1.RelayChain" json:"relay_chain,omitempty"`
+	// ParaId of associated parachain
+	ParaId uint32 `protobuf:"varint,8,opt,name=para_id,json=paraId,proto3" json:"para_id,omitempty"`
+	// latest parachain height
+	LatestParaHeight uint32 `protobuf:"varint,9,opt,name=latest_para_height,json=latestParaHeight,proto3" json:"latest_para_height,omitempty"`
+}
+
+func (m *ClientState) Reset()         { *m = ClientState{} }
+func (m *ClientState) String() string { return proto.CompactTextString(m) }
+func (*ClientState) ProtoMessage()    {}
+func (*ClientState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{1}
+}
+func (m *ClientState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientState.Merge(m, src)
+}
+func (m *ClientState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientState proto.InternalMessageInfo
+
+type isClientState_XFrozenHeight interface {
+	isClientState_XFrozenHeight()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ClientState_FrozenHeight struct {
+	FrozenHeight uint64 `protobuf:"varint,3,opt,name=frozen_height,json=frozenHeight,proto3,oneof" json:"frozen_height,omitempty"`
+}
+
+func (*ClientState_FrozenHeight) isClientState_XFrozenHeight() {}
+
+func (m *ClientState) GetXFrozenHeight() isClientState_XFrozenHeight {
+	if m != nil {
+		return m.XFrozenHeight
+	}
+	return nil
+}
+
+func (m *ClientState) GetMmrRootHash() []byte {
+	if m != nil {
+		return m.MmrRootHash
+	}
+	return nil
+}
+
+func (m *ClientState) GetLatestBeefyHeight() uint32 {
+	if m != nil {
+		return m.LatestBeefyHeight
+	}
+	return 0
+}
+
+func (m *ClientState) GetFrozenHeight() uint64 {
+	if x, ok := m.GetXFrozenHeight().(*ClientState_FrozenHeight); ok {
+		return x.FrozenHeight
+	}
+	return 0
+}
+
+func (m *ClientState) GetBeefyActivationBlock() uint32 {
+	if m != nil {
+		return m.BeefyActivationBlock
+	}
+	return 0
+}
+
+func (m *ClientState) GetAuthority() *BeefyAuthoritySet {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *ClientState) GetNextAuthoritySet() *BeefyAuthoritySet {
+	if m != nil {
+		return m.NextAuthoritySet
+	}
+	return nil
+}
+
+func (m *ClientState) GetRelayChain() _10_grandpa.RelayChain {
+	if m != nil {
+		return m.RelayChain
+	}
+	return _10_grandpa.RelayChain_POLKADOT
+}
+
+func (m *ClientState) GetParaId() uint32 {
+	if m != nil {
+		return m.ParaId
+	}
+	return 0
+}
+
+func (m *ClientState) GetLatestParaHeight() uint32 {
+	if m != nil {
+		return m.LatestParaHeight
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ClientState) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ClientState_FrozenHeight)(nil),
+	}
+}
+
+// ConsensusState of a parachain tracked through BEEFY. It shares its fields with the
+// consensus state of the grandpa client.
+type ConsensusState struct {
+	_10_grandpa.ConsensusState `protobuf:"bytes,1,opt,name=parachain_consensus_state,json=parachainConsensusState,proto3,embedded=parachain_consensus_state" json:"parachain_consensus_state"`
+}
+
+func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
+func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
+func (*ConsensusState) ProtoMessage()    {}
+func (*ConsensusState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{2}
+}
+func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusState.Merge(m, src)
+}
+func (m *ConsensusState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
+
+// PayloadItem is an entry of a BEEFY commitment payload.
+type PayloadItem struct {
+	// 2 byte payload identifier, "mh" for the MMR root
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// SCALE encoded payload data
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PayloadItem) Reset()         { *m = PayloadItem{} }
+func (m *PayloadItem) String() string { return proto.CompactTextString(m) }
+func (*PayloadItem) ProtoMessage()    {}
+func (*PayloadItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{3}
+}
+func (m *PayloadItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadItem.Merge(m, src)
+}
+func (m *PayloadItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadItem proto.InternalMessageInfo
+
+func (m *PayloadItem) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *PayloadItem) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Commitment is the BEEFY commitment signed by the authorities.
+type Commitment struct {
+	// payload items sorted by identifier
+	Payload []*PayloadItem `protobuf:"bytes,1,rep,name=payload,proto3" json:"payload,omitempty"`
+	// relay chain block number of the commitment
+	BlockNumber uint32 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// id of the authority set signing the commitment
+	ValidatorSetId uint64 `protobuf:"varint,3,opt,name=validator_set_id,json=validatorSetId,proto3" json:"validator_set_id,omitempty"`
+}
+
+func (m *Commitment) Reset()         { *m = Commitment{} }
+func (m *Commitment) String() string { return proto.CompactTextString(m) }
+func (*Commitment) ProtoMessage()    {}
+func (*Commitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{4}
+}
+func (m *Commitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Commitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Commitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Commitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commitment.Merge(m, src)
+}
+func (m *Commitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Commitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Commitment proto.InternalMessageInfo
+
+func (m *Commitment) GetPayload() []*PayloadItem {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Commitment) GetBlockNumber() uint32 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Commitment) GetValidatorSetId() uint64 {
+	if m != nil {
+		return m.ValidatorSetId
+	}
+	return 0
+}
+
+// CommitmentSignature is the signature of an authority over a BEEFY commitment.
+type CommitmentSignature struct {
+	// 65 byte recoverable secp256k1 signature
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// index of the signing authority in the authority set
+	AuthorityIndex uint32 `protobuf:"varint,2,opt,name=authority_index,json=authorityIndex,proto3" json:"authority_index,omitempty"`
+	// merkle proof of the ethereum address of the authority against the authority set root
+	AuthorityProof [][]byte `protobuf:"bytes,3,rep,name=authority_proof,json=authorityProof,proto3" json:"authority_proof,omitempty"`
+}
+
+func (m *CommitmentSignature) Reset()         { *m = CommitmentSignature{} }
+func (m *CommitmentSignature) String() string { return proto.CompactTextString(m) }
+func (*CommitmentSignature) ProtoMessage()    {}
+func (*CommitmentSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{5}
+}
+func (m *CommitmentSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitmentSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitmentSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitmentSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitmentSignature.Merge(m, src)
+}
+func (m *CommitmentSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitmentSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitmentSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitmentSignature proto.InternalMessageInfo
+
+func (m *CommitmentSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *CommitmentSignature) GetAuthorityIndex() uint32 {
+	if m != nil {
+		return m.AuthorityIndex
+	}
+	return 0
+}
+
+func (m *CommitmentSignature) GetAuthorityProof() [][]byte {
+	if m != nil {
+		return m.AuthorityProof
+	}
+	return nil
+}
+
+// SignedCommitment is a BEEFY commitment together with the signatures of the authorities.
+type SignedCommitment struct {
+	Commitment *Commitment            `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Signatures []*CommitmentSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *SignedCommitment) Reset()         { *m = SignedCommitment{} }
+func (m *SignedCommitment) String() string { return proto.CompactTextString(m) }
+func (*SignedCommitment) ProtoMessage()    {}
+func (*SignedCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{6}
+}
+func (m *SignedCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedCommitment.Merge(m, src)
+}
+func (m *SignedCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedCommitment proto.InternalMessageInfo
+
+func (m *SignedCommitment) GetCommitment() *Commitment {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *SignedCommitment) GetSignatures() []*CommitmentSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MmrLeafProof proves a MMR leaf against the MMR root tracked by the client. The index of
+// the leaf is derived from its parent block number.
+type MmrLeafProof struct {
+	// SCALE encoded MMR leaf
+	Leaf []byte `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// MMR proof items: the sibling hashes up to the leaf's peak followed by the other peaks
+	Items [][]byte `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (m *MmrLeafProof) Reset()         { *m = MmrLeafProof{} }
+func (m *MmrLeafProof) String() string { return proto.CompactTextString(m) }
+func (*MmrLeafProof) ProtoMessage()    {}
+func (*MmrLeafProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{7}
+}
+func (m *MmrLeafProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MmrLeafProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MmrLeafProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MmrLeafProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MmrLeafProof.Merge(m, src)
+}
+func (m *MmrLeafProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MmrLeafProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MmrLeafProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MmrLeafProof proto.InternalMessageInfo
+
+func (m *MmrLeafProof) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+func (m *MmrLeafProof) GetItems() [][]byte {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// MmrUpdateProof updates the MMR root tracked by the client.
+type MmrUpdateProof struct {
+	// signed commitment over the new MMR root
+	SignedCommitment *SignedCommitment `protobuf:"bytes,1,opt,name=signed_commitment,json=signedCommitment,proto3" json:"signed_commitment,omitempty"`
+	// MMR leaf of the commitment block, proven against the new MMR root
+	LatestMmrLeaf *MmrLeafProof `protobuf:"bytes,2,opt,name=latest_mmr_leaf,json=latestMmrLeaf,proto3" json:"latest_mmr_leaf,omitempty"`
+}
+
+func (m *MmrUpdateProof) Reset()         { *m = MmrUpdateProof{} }
+func (m *MmrUpdateProof) String() string { return proto.CompactTextString(m) }
+func (*MmrUpdateProof) ProtoMessage()    {}
+func (*MmrUpdateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{8}
+}
+func (m *MmrUpdateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MmrUpdateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MmrUpdateProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MmrUpdateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MmrUpdateProof.Merge(m, src)
+}
+func (m *MmrUpdateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MmrUpdateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MmrUpdateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MmrUpdateProof proto.InternalMessageInfo
+
+func (m *MmrUpdateProof) GetSignedCommitment() *SignedCommitment {
+	if m != nil {
+		return m.SignedCommitment
+	}
+	return nil
+}
+
+func (m *MmrUpdateProof) GetLatestMmrLeaf() *MmrLeafProof {
+	if m != nil {
+		return m.LatestMmrLeaf
+	}
+	return nil
+}
+
+// ParachainHeader proves a parachain header against the MMR root tracked by the client.
+type ParachainHeader struct {
+	// SCALE encoded relay chain header containing the parachain head
+	RelayHeader []byte `protobuf:"bytes,1,opt,name=relay_header,json=relayHeader,proto3" json:"relay_header,omitempty"`
+	// MMR leaf of the child of the relay chain block, committing to the relay chain block hash
+	MmrLeafProof *MmrLeafProof `protobuf:"bytes,2,opt,name=mmr_leaf_proof,json=mmrLeafProof,proto3" json:"mmr_leaf_proof,omitempty"`
+	// parachain header proofs against the relay chain state root
+	ParachainHeader *_10_grandpa.ParachainHeaderProofs `protobuf:"bytes,3,opt,name=parachain_header,json=parachainHeader,proto3" json:"parachain_header,omitempty"`
+}
+
+func (m *ParachainHeader) Reset()         { *m = ParachainHeader{} }
+func (m *ParachainHeader) String() string { return proto.CompactTextString(m) }
+func (*ParachainHeader) ProtoMessage()    {}
+func (*ParachainHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{9}
+}
+func (m *ParachainHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParachainHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParachainHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParachainHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParachainHeader.Merge(m, src)
+}
+func (m *ParachainHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParachainHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParachainHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParachainHeader proto.InternalMessageInfo
+
+func (m *ParachainHeader) GetRelayHeader() []byte {
+	if m != nil {
+		return m.RelayHeader
+	}
+	return nil
+}
+
+func (m *ParachainHeader) GetMmrLeafProof() *MmrLeafProof {
+	if m != nil {
+		return m.MmrLeafProof
+	}
+	return nil
+}
+
+func (m *ParachainHeader) GetParachainHeader() *_10_grandpa.ParachainHeaderProofs {
+	if m != nil {
+		return m.ParachainHeader
+	}
+	return nil
+}
+
+// BEEFY MMR update and parachain headers
+type Header struct {
+	// optional MMR root update, applied before verifying the parachain headers
+	MmrUpdateProof *MmrUpdateProof `protobuf:"bytes,1,opt,name=mmr_update_proof,json=mmrUpdateProof,proto3" json:"mmr_update_proof,omitempty"`
+	// parachain headers included in relay chain blocks committed to by the MMR
+	ParachainHeaders []*ParachainHeader `protobuf:"bytes,2,rep,name=parachain_headers,json=parachainHeaders,proto3" json:"parachain_headers,omitempty"`
+	ParaId           uint32             `protobuf:"varint,3,opt,name=para_id,json=paraId,proto3" json:"para_id,omitempty"`
+	ParaHeight       uint32             `protobuf:"varint,4,opt,name=para_height,json=paraHeight,proto3" json:"para_height,omitempty"`
+}
+
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43205c4bfbe9a422, []int{10}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Header) GetMmrUpdateProof() *MmrUpdateProof {
+	if m != nil {
+		return m.MmrUpdateProof
+	}
+	return nil
+}
+
+func (m *Header) GetParachainHeaders() []*ParachainHeader {
+	if m != nil {
+		return m.ParachainHeaders
+	}
+	return nil
+}
+
+func (m *Header) GetParaId() uint32 {
+	if m != nil {
+		return m.ParaId
+	}
+	return 0
+}
+
+func (m *Header) GetParaHeight() uint32 {
+	if m != nil {
+		return m.ParaHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BeefyAuthoritySet)(nil), "ibc.lightclients.beefy.v1.BeefyAuthoritySet")
+	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.beefy.v1.ClientState")
+	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.beefy.v1.ConsensusState")
+	proto.RegisterType((*PayloadItem)(nil), "ibc.lightclients.beefy.v1.PayloadItem")
+	proto.RegisterType((*Commitment)(nil), "ibc.lightclients.beefy.v1.Commitment")
+	proto.RegisterType((*CommitmentSignature)(nil), "ibc.lightclients.beefy.v1.CommitmentSignature")
+	proto.RegisterType((*SignedCommitment)(nil), "ibc.lightclients.beefy.v1.SignedCommitment")
+	proto.RegisterType((*MmrLeafProof)(nil), "ibc.lightclients.beefy.v1.MmrLeafProof")
+	proto.RegisterType((*MmrUpdateProof)(nil), "ibc.lightclients.beefy.v1.MmrUpdateProof")
+	proto.RegisterType((*ParachainHeader)(nil), "ibc.lightclients.beefy.v1.ParachainHeader")
+	proto.RegisterType((*Header)(nil), "ibc.lightclients.beefy.v1.Header")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/beefy/v1/beefy.proto", fileDescriptor_43205c4bfbe9a422)
+}
+
+var fileDescriptor_43205c4bfbe9a422 = []byte{
+	// 968 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd8, 0x6e, 0xda, 0x3c, 0x6f, 0x9c, 0xcd, 0x34, 0xa2, 0xdb, 0x0a, 0x39, 0x66, 0xa5,
+	0x12, 0x97, 0x36, 0xb6, 0x6c, 0x90, 0x40, 0xe2, 0xd2, 0x26, 0x42, 0xb2, 0x11, 0x29, 0xd1, 0x58,
+	0x08, 0x14, 0x09, 0xad, 0xc6, 0xbb, 0x63, 0x7b, 0xc5, 0xee, 0x8e, 0xd9, 0x19, 0x5b, 0x0d, 0x57,
+	0x84, 0xc4, 0x81, 0x03, 0x77, 0x7e, 0x01, 0xbf, 0x81, 0x3f, 0xd0, 0x63, 0x8e, 0x9c, 0x2a, 0x94,
+	0xfc, 0x01, 0x7e, 0x02, 0x9a, 0x99, 0x5d, 0x7b, 0xed, 0x08, 0x0b, 0x7a, 0xb1, 0xde, 0xbc, 0xf7,
+	0xe6, 0x9b, 0x6f, 0xbe, 0xf7, 0xe6, 0x79, 0xe1, 0x71, 0x38, 0xf4, 0xdb, 0x51, 0x38, 0x9e, 0x48,
+	0x3f, 0x0a, 0x59, 0x22, 0x45, 0x7b, 0xc8, 0xd8, 0xe8, 0xb2, 0x3d, 0xef, 0x18, 0xa3, 0x35, 0x4d,
+	0xb9, 0xe4, 0xf8, 0x61, 0x38, 0xf4, 0x5b, 0xc5, 0xb4, 0x96, 0x89, 0xce, 0x3b, 0x8f, 0x0e, 0xc6,
+	0x7c, 0xcc, 0x75, 0x56, 0x5b, 0x59, 0x66, 0xc3, 0xa3, 0x27, 0xb7, 0x70, 0xc7, 0x29, 0x4d, 0x82,
+	0x29, 0x55, 0xc8, 0x99, 0x69, 0x52, 0xdd, 0x3e, 0xec, 0x9f, 0x28, 0xb0, 0x17, 0x33, 0x39, 0xe1,
+	0x69, 0x28, 0x2f, 0x07, 0x4c, 0xe2, 0x1a, 0x94, 0xc2, 0xc0, 0x41, 0x0d, 0xd4, 0xac, 0x90, 0x52,
+	0x18, 0x60, 0x1b, 0xca, 0x11, 0x4b, 0x9c, 0x52, 0x03, 0x35, 0x77, 0x89, 0x32, 0x31, 0x86, 0x4a,
+	0xca, 0xb9, 0x74, 0xca, 0x0d, 0xd4, 0xb4, 0x88, 0xb6, 0xdd, 0x5f, 0x2a, 0x50, 0x3d, 0xd5, 0xe7,
+	0x0d, 0x24, 0x95, 0x0c, 0xbb, 0xb0, 0x1b, 0xc7, 0xa9, 0xa7, 0x62, 0xde, 0x84, 0x8a, 0x89, 0x06,
+	0xb4, 0x48, 0x35, 0x8e, 0x53, 0xc2, 0xb9, 0xec, 0x51, 0x31, 0xc1, 0x2d, 0xb8, 0x1f, 0x51, 0xc9,
+	0x84, 0xf4, 0xf4, 0x95, 0xbc, 0x09, 0x53, 0xac, 0xb3, 0x93, 0xf6, 0x4d, 0x48, 0xf3, 0xeb, 0xe9,
+	0x00, 0x6e, 0xc2, 0xee, 0x28, 0xe5, 0x3f, 0xb0, 0x24, 0xcf, 0x54, 0x04, 0x2a, 0xbd, 0x2d, 0x62,
+	0x19, 0xb7, 0x49, 0xfb, 0x19, 0x21, 0xfc, 0x11, 0xbc, 0x63, 0x20, 0xa9, 0x2f, 0xc3, 0x39, 0x95,
+	0x21, 0x4f, 0xbc, 0x61, 0xc4, 0xfd, 0xef, 0x9c, 0x8a, 0x06, 0x3f, 0xd0, 0xd1, 0x17, 0x8b, 0xe0,
+	0x89, 0x8a, 0xe1, 0xcf, 0x61, 0x87, 0xe6, 0x4a, 0x38, 0x77, 0x1a, 0xa8, 0x59, 0xed, 0x3e, 0x6b,
+	0xfd, 0xab, 0xfc, 0xad, 0x5b, 0xd2, 0x91, 0xe5, 0x76, 0x7c, 0x01, 0x38, 0x61, 0xaf, 0xa4, 0xb7,
+	0xf0, 0x78, 0x82, 0x49, 0x67, 0xfb, 0x2d, 0x40, 0x6d, 0x85, 0xb3, 0x52, 0xa1, 0x1e, 0x54, 0x53,
+	0x16, 0xd1, 0x4b, 0xcf, 0x9f, 0xd0, 0x30, 0x71, 0xee, 0x36, 0x50, 0xb3, 0xd6, 0x3d, 0xba, 0x0d,
+	0x9a, 0x17, 0x7b, 0xde, 0x69, 0x11, 0x95, 0x7f, 0xaa, 0xd2, 0x09, 0xa4, 0x0b, 0x1b, 0x3f, 0x80,
+	0xbb, 0x53, 0x9a, 0x52, 0x2f, 0x0c, 0x9c, 0x7b, 0x5a, 0x98, 0x6d, 0xb5, 0xec, 0x07, 0xf8, 0x19,
+	0xe0, 0xac, 0x34, 0x3a, 0x9e, 0xe9, 0xbd, 0xa3, 0x73, 0x6c, 0x13, 0x39, 0xa7, 0x29, 0x35, 0x8a,
+	0x9f, 0xd8, 0x50, 0xf3, 0x56, 0x2a, 0xe3, 0xfe, 0x88, 0xa0, 0x76, 0xca, 0x13, 0xc1, 0x12, 0x31,
+	0x13, 0xa6, 0x23, 0xbe, 0x87, 0x87, 0x0a, 0x4b, 0x73, 0xf6, 0xfc, 0x3c, 0xe6, 0x09, 0x15, 0xd4,
+	0xdd, 0x51, 0xed, 0x3e, 0xdd, 0x78, 0x87, 0x55, 0xbc, 0x93, 0x7b, 0xaf, 0xdf, 0x1c, 0x6e, 0x5d,
+	0xbd, 0x39, 0x44, 0xe4, 0xc1, 0x02, 0x77, 0x35, 0xc5, 0xed, 0x40, 0xf5, 0x9c, 0x5e, 0x46, 0x9c,
+	0x06, 0x7d, 0xc9, 0xe2, 0x42, 0x67, 0x5b, 0xba, 0xb3, 0x31, 0x54, 0x02, 0x2a, 0xa9, 0x6e, 0x38,
+	0x8b, 0x68, 0xdb, 0xfd, 0x0d, 0x01, 0x9c, 0xf2, 0x38, 0x0e, 0x65, 0xcc, 0x12, 0x89, 0x9f, 0x2b,
+	0x81, 0x34, 0x82, 0x83, 0x1a, 0xe5, 0x66, 0xb5, 0xfb, 0xfe, 0x86, 0xda, 0x15, 0xce, 0x22, 0xf9,
+	0x36, 0xfc, 0x1e, 0x58, 0xba, 0xf3, 0xbc, 0x64, 0x16, 0x0f, 0x59, 0x9a, 0x75, 0x77, 0x55, 0xfb,
+	0x5e, 0x6a, 0x17, 0x6e, 0x82, 0x3d, 0xa7, 0x51, 0x18, 0x50, 0xc9, 0x53, 0xd5, 0x26, 0xaa, 0x1c,
+	0xba, 0xb5, 0x49, 0x6d, 0xe1, 0x1f, 0x30, 0xd9, 0x0f, 0xdc, 0x9f, 0x10, 0xdc, 0x5f, 0xb2, 0x1b,
+	0x84, 0xe3, 0x84, 0xca, 0x59, 0xca, 0xf0, 0xbb, 0xb0, 0x23, 0xf2, 0x45, 0x76, 0xc1, 0xa5, 0x03,
+	0x1f, 0xc1, 0xde, 0xb2, 0x0d, 0xc3, 0x24, 0x60, 0xaf, 0x32, 0x16, 0xb5, 0x85, 0xbb, 0xaf, 0xbc,
+	0xab, 0x89, 0xd3, 0x94, 0xf3, 0x91, 0x53, 0x6e, 0x94, 0x9b, 0x56, 0x21, 0xf1, 0x5c, 0x79, 0xdd,
+	0xdf, 0x11, 0xd8, 0xea, 0x74, 0x16, 0x14, 0xb4, 0xfa, 0x0c, 0xc0, 0x5f, 0xac, 0xb2, 0x8a, 0x3e,
+	0xde, 0x20, 0xd7, 0x72, 0x2b, 0x29, 0x6c, 0xc4, 0x2f, 0x01, 0x16, 0xd4, 0x85, 0x53, 0xd2, 0xaa,
+	0xb7, 0xfe, 0x13, 0xcc, 0x42, 0x0f, 0x52, 0x40, 0x70, 0x3f, 0x01, 0xeb, 0x2c, 0x4e, 0xbf, 0x60,
+	0x74, 0xa4, 0xb9, 0xab, 0xaa, 0x47, 0x8c, 0x8e, 0x32, 0x99, 0xb4, 0x8d, 0x0f, 0xe0, 0x4e, 0x28,
+	0x59, 0x6c, 0x8e, 0xb3, 0x88, 0x59, 0xb8, 0x7f, 0x20, 0xa8, 0x9d, 0xc5, 0xe9, 0x57, 0xd3, 0x80,
+	0x4a, 0x66, 0x36, 0x7f, 0x03, 0xfb, 0x42, 0xdf, 0xdb, 0xbb, 0x75, 0xd5, 0xa7, 0x1b, 0x38, 0xae,
+	0x6b, 0x45, 0x6c, 0xb1, 0xae, 0xde, 0x97, 0xb0, 0x97, 0xbd, 0x38, 0x35, 0x37, 0x35, 0xc3, 0x92,
+	0xc6, 0x3d, 0xda, 0x80, 0x5b, 0xbc, 0x18, 0xd9, 0x35, 0xfb, 0x33, 0x9f, 0x7b, 0x8d, 0x60, 0xef,
+	0x3c, 0x7f, 0x18, 0x3d, 0x46, 0x03, 0x96, 0xaa, 0x66, 0x34, 0x93, 0x63, 0xa2, 0xd7, 0xf9, 0x50,
+	0xd6, 0xbe, 0x2c, 0xe5, 0x0c, 0x6a, 0x39, 0x81, 0xac, 0x05, 0xfe, 0x27, 0x0d, 0x2b, 0x2e, 0xaa,
+	0xfd, 0x2d, 0xd8, 0xcb, 0x57, 0x9f, 0x9d, 0x5a, 0xd6, 0x80, 0xdd, 0x8d, 0x8f, 0x7d, 0x8d, 0xb9,
+	0x06, 0x13, 0x64, 0x6f, 0xba, 0xea, 0x76, 0xff, 0x46, 0xb0, 0x9d, 0x11, 0x1f, 0x80, 0xad, 0x88,
+	0xcf, 0x74, 0xb5, 0x32, 0xea, 0xa6, 0x32, 0x4f, 0x36, 0x53, 0x2f, 0xd4, 0x97, 0xd4, 0xe2, 0x95,
+	0x35, 0xfe, 0x1a, 0xf6, 0xd7, 0xe9, 0xe7, 0x3d, 0xf9, 0xc1, 0xc6, 0x49, 0xb0, 0x42, 0x93, 0xd8,
+	0x6b, 0xbc, 0x45, 0x71, 0xf2, 0x96, 0x57, 0x26, 0xef, 0x21, 0x54, 0x8b, 0x23, 0xd7, 0xfc, 0x5f,
+	0xc1, 0x74, 0x39, 0x6c, 0x2f, 0x5e, 0x5f, 0xd7, 0xd1, 0xd5, 0x75, 0x1d, 0xfd, 0x75, 0x5d, 0x47,
+	0xbf, 0xde, 0xd4, 0xb7, 0xae, 0x6e, 0xea, 0x5b, 0x7f, 0xde, 0xd4, 0xb7, 0x2e, 0x9e, 0x8f, 0x43,
+	0x39, 0x99, 0x0d, 0x5b, 0x3e, 0x8f, 0xdb, 0x3e, 0x17, 0x31, 0x17, 0xed, 0x70, 0xe8, 0x1f, 0x8f,
+	0x79, 0x7b, 0xfe, 0x71, 0x3b, 0xe6, 0xc1, 0x2c, 0x62, 0xc2, 0x7c, 0x19, 0x1c, 0xe7, 0x9f, 0x06,
+	0x9d, 0xce, 0xb1, 0x26, 0xfd, 0xa9, 0xfe, 0x1d, 0x6e, 0xeb, 0xef, 0x82, 0x0f, 0xff, 0x19, 0x00,
+	0x75, 0x82, 0x88, 0x5c, 0x9c, 0x08, 0x00, 0x00,
+}
+
+func (m *BeefyAuthoritySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeefyAuthoritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeefyAuthoritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Len != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.Len))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestParaHeight != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.LatestParaHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ParaId != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.ParaId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RelayChain != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.RelayChain))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextAuthoritySet != nil {
+		{
+			size, err := m.NextAuthoritySet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Authority != nil {
+		{
+			size, err := m.Authority.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BeefyActivationBlock != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.BeefyActivationBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.XFrozenHeight != nil {
+		{
+			size := m.XFrozenHeight.Size()
+			i -= size
+			if _, err := m.XFrozenHeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.LatestBeefyHeight != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.LatestBeefyHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MmrRootHash) > 0 {
+		i -= len(m.MmrRootHash)
+		copy(dAtA[i:], m.MmrRootHash)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.MmrRootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientState_FrozenHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientState_FrozenHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintBeefy(dAtA, i, uint64(m.FrozenHeight))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBeefy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PayloadItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayloadItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Commitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Commitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSetId != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.ValidatorSetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeefy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitmentSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitmentSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitmentSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorityProof) > 0 {
+		for iNdEx := len(m.AuthorityProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorityProof[iNdEx])
+			copy(dAtA[i:], m.AuthorityProof[iNdEx])
+			i = encodeVarintBeefy(dAtA, i, uint64(len(m.AuthorityProof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AuthorityIndex != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.AuthorityIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeefy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Commitment != nil {
+		{
+			size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MmrLeafProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MmrLeafProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MmrLeafProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Items[iNdEx])
+			copy(dAtA[i:], m.Items[iNdEx])
+			i = encodeVarintBeefy(dAtA, i, uint64(len(m.Items[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MmrUpdateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MmrUpdateProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MmrUpdateProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestMmrLeaf != nil {
+		{
+			size, err := m.LatestMmrLeaf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignedCommitment != nil {
+		{
+			size, err := m.SignedCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParachainHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParachainHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParachainHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParachainHeader != nil {
+		{
+			size, err := m.ParachainHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MmrLeafProof != nil {
+		{
+			size, err := m.MmrLeafProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RelayHeader) > 0 {
+		i -= len(m.RelayHeader)
+		copy(dAtA[i:], m.RelayHeader)
+		i = encodeVarintBeefy(dAtA, i, uint64(len(m.RelayHeader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParaHeight != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.ParaHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParaId != 0 {
+		i = encodeVarintBeefy(dAtA, i, uint64(m.ParaId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParachainHeaders) > 0 {
+		for iNdEx := len(m.ParachainHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParachainHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBeefy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MmrUpdateProof != nil {
+		{
+			size, err := m.MmrUpdateProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBeefy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBeefy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBeefy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BeefyAuthoritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBeefy(uint64(m.Id))
+	}
+	if m.Len != 0 {
+		n += 1 + sovBeefy(uint64(m.Len))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	return n
+}
+
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MmrRootHash)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.LatestBeefyHeight != 0 {
+		n += 1 + sovBeefy(uint64(m.LatestBeefyHeight))
+	}
+	if m.XFrozenHeight != nil {
+		n += m.XFrozenHeight.Size()
+	}
+	if m.BeefyActivationBlock != 0 {
+		n += 1 + sovBeefy(uint64(m.BeefyActivationBlock))
+	}
+	if m.Authority != nil {
+		l = m.Authority.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.NextAuthoritySet != nil {
+		l = m.NextAuthoritySet.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.RelayChain != 0 {
+		n += 1 + sovBeefy(uint64(m.RelayChain))
+	}
+	if m.ParaId != 0 {
+		n += 1 + sovBeefy(uint64(m.ParaId))
+	}
+	if m.LatestParaHeight != 0 {
+		n += 1 + sovBeefy(uint64(m.LatestParaHeight))
+	}
+	return n
+}
+
+func (m *ClientState_FrozenHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeefy(uint64(m.FrozenHeight))
+	return n
+}
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsensusState.Size()
+	n += 1 + l + sovBeefy(uint64(l))
+	return n
+}
+
+func (m *PayloadItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	return n
+}
+
+func (m *Commitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovBeefy(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovBeefy(uint64(m.BlockNumber))
+	}
+	if m.ValidatorSetId != 0 {
+		n += 1 + sovBeefy(uint64(m.ValidatorSetId))
+	}
+	return n
+}
+
+func (m *CommitmentSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.AuthorityIndex != 0 {
+		n += 1 + sovBeefy(uint64(m.AuthorityIndex))
+	}
+	if len(m.AuthorityProof) > 0 {
+		for _, b := range m.AuthorityProof {
+			l = len(b)
+			n += 1 + l + sovBeefy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignedCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commitment != nil {
+		l = m.Commitment.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovBeefy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MmrLeafProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, b := range m.Items {
+			l = len(b)
+			n += 1 + l + sovBeefy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MmrUpdateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedCommitment != nil {
+		l = m.SignedCommitment.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.LatestMmrLeaf != nil {
+		l = m.LatestMmrLeaf.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	return n
+}
+
+func (m *ParachainHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelayHeader)
+	if l > 0 {
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.MmrLeafProof != nil {
+		l = m.MmrLeafProof.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if m.ParachainHeader != nil {
+		l = m.ParachainHeader.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MmrUpdateProof != nil {
+		l = m.MmrUpdateProof.Size()
+		n += 1 + l + sovBeefy(uint64(l))
+	}
+	if len(m.ParachainHeaders) > 0 {
+		for _, e := range m.ParachainHeaders {
+			l = e.Size()
+			n += 1 + l + sovBeefy(uint64(l))
+		}
+	}
+	if m.ParaId != 0 {
+		n += 1 + sovBeefy(uint64(m.ParaId))
+	}
+	if m.ParaHeight != 0 {
+		n += 1 + sovBeefy(uint64(m.ParaHeight))
+	}
+	return n
+}
+
+func sovBeefy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBeefy(x uint64) (n int) {
+	return sovBeefy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BeefyAuthoritySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeefyAuthoritySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeefyAuthoritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			}
+			m.Len = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Len |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MmrRootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MmrRootHash = append(m.MmrRootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MmrRootHash == nil {
+				m.MmrRootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBeefyHeight", wireType)
+			}
+			m.LatestBeefyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestBeefyHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XFrozenHeight = &ClientState_FrozenHeight{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeefyActivationBlock", wireType)
+			}
+			m.BeefyActivationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeefyActivationBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authority == nil {
+				m.Authority = &BeefyAuthoritySet{}
+			}
+			if err := m.Authority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAuthoritySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAuthoritySet == nil {
+				m.NextAuthoritySet = &BeefyAuthoritySet{}
+			}
+			if err := m.NextAuthoritySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayChain", wireType)
+			}
+			m.RelayChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayChain |= _10_grandpa.RelayChain(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParaId", wireType)
+			}
+			m.ParaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParaId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestParaHeight", wireType)
+			}
+			m.LatestParaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestParaHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, &PayloadItem{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetId", wireType)
+			}
+			m.ValidatorSetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitmentSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitmentSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitmentSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityIndex", wireType)
+			}
+			m.AuthorityIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorityIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorityProof = append(m.AuthorityProof, make([]byte, postIndex-iNdEx))
+			copy(m.AuthorityProof[len(m.AuthorityProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commitment == nil {
+				m.Commitment = &Commitment{}
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &CommitmentSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MmrLeafProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MmrLeafProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MmrLeafProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, make([]byte, postIndex-iNdEx))
+			copy(m.Items[len(m.Items)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MmrUpdateProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MmrUpdateProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MmrUpdateProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedCommitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignedCommitment == nil {
+				m.SignedCommitment = &SignedCommitment{}
+			}
+			if err := m.SignedCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestMmrLeaf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestMmrLeaf == nil {
+				m.LatestMmrLeaf = &MmrLeafProof{}
+			}
+			if err := m.LatestMmrLeaf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParachainHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParachainHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParachainHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayHeader = append(m.RelayHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.RelayHeader == nil {
+				m.RelayHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MmrLeafProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MmrLeafProof == nil {
+				m.MmrLeafProof = &MmrLeafProof{}
+			}
+			if err := m.MmrLeafProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParachainHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParachainHeader == nil {
+				m.ParachainHeader = &_10_grandpa.ParachainHeaderProofs{}
+			}
+			if err := m.ParachainHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MmrUpdateProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MmrUpdateProof == nil {
+				m.MmrUpdateProof = &MmrUpdateProof{}
+			}
+			if err := m.MmrUpdateProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParachainHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParachainHeaders = append(m.ParachainHeaders, &ParachainHeader{})
+			if err := m.ParachainHeaders[len(m.ParachainHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParaId", wireType)
+			}
+			m.ParaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParaId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParaHeight", wireType)
+			}
+			m.ParaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParaHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeefy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeefy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBeefy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBeefy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeefy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBeefy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBeefy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBeefy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBeefy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBeefy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBeefy = fmt.Errorf("proto: unexpected end of group")
+)
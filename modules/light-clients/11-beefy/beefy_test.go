@@ -0,0 +1,391 @@
+package beefy_test
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/sha3"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+	beefy "github.com/cosmos/ibc-go/v7/modules/light-clients/11-beefy"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+const (
+	clientID = "11-beefy-0"
+	paraID   = 2000
+
+	// activationBlock is the relay chain block of the first MMR leaf
+	activationBlock = 80
+	// initialBeefyHeight is the relay chain block of the MMR root the client is created with
+	initialBeefyHeight = 88
+	// sessionChangeBlock is the first relay chain block whose MMR leaf announces authority set 2
+	sessionChangeBlock = 100
+	// commitmentBlock is the relay chain block of the commitment included in the header
+	commitmentBlock = 104
+	// latestBlock is the last relay chain block with a MMR leaf
+	latestBlock = 110
+
+	authorityCount = 5
+)
+
+// testData holds the fixtures of the grandpa client captured from a Rococo local testnet
+// running parachain 2000. The relay chain headers and parachain header proofs of the grandpa
+// header are proven against a MMR built over the relay chain blocks by the test suite.
+type testData struct {
+	ConsensusStates  map[string]string `json:"consensus_states"`
+	Header           string            `json:"header"`
+	ClientStateProof string            `json:"client_state_proof"`
+}
+
+type BeefyTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	ctx         sdk.Context
+	store       sdk.KVStore
+	clientState *beefy.ClientState
+	testData    testData
+
+	// grandpaHeader is the grandpa header fixture providing the parachain headers
+	grandpaHeader *grandpa.Header
+	// relayHeaders holds the SCALE encoded relay chain headers by block hash
+	relayHeaders map[scale.Hash][]byte
+	// leaves holds the SCALE encoded MMR leaves, starting with the leaf of the activation block
+	leaves [][]byte
+	// authoritySets holds the BEEFY authority sets by id
+	authoritySets []*authoritySet
+}
+
+func (suite *BeefyTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	bz, err := os.ReadFile("../10-grandpa/test_data/data.json")
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(bz, &suite.testData))
+
+	suite.grandpaHeader = &grandpa.Header{}
+	suite.Require().NoError(suite.grandpaHeader.Unmarshal(suite.decode(suite.testData.Header)))
+
+	// relay chain block hashes, the blocks of the fixture are used where available
+	suite.relayHeaders = make(map[scale.Hash][]byte)
+	blockHashes := make(map[uint32]scale.Hash)
+	for _, bz := range suite.grandpaHeader.FinalityProof.UnknownHeaders {
+		header, err := scale.DecodeHeader(bz)
+		suite.Require().NoError(err)
+
+		hash := scale.Blake2b256(bz)
+		suite.relayHeaders[hash] = bz
+		blockHashes[header.Number] = hash
+	}
+
+	suite.authoritySets = []*authoritySet{newAuthoritySet(0), newAuthoritySet(1), newAuthoritySet(2)}
+
+	suite.leaves = nil
+	for number := uint32(activationBlock); number <= latestBlock; number++ {
+		parentHash, ok := blockHashes[number-1]
+		if !ok {
+			parentHash = keccak256([]byte("block"), binary.LittleEndian.AppendUint32(nil, number-1))
+		}
+
+		nextAuthoritySet := suite.authoritySets[1]
+		if number >= sessionChangeBlock {
+			nextAuthoritySet = suite.authoritySets[2]
+		}
+
+		suite.leaves = append(suite.leaves, encodeMmrLeaf(number-1, parentHash, nextAuthoritySet.beefyAuthoritySet()))
+	}
+
+	suite.ctx = suite.chainA.GetContext()
+	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.ctx, clientID)
+
+	suite.clientState = &beefy.ClientState{
+		MmrRootHash:          suite.mmrRoot(initialBeefyHeight),
+		LatestBeefyHeight:    initialBeefyHeight,
+		BeefyActivationBlock: activationBlock,
+		Authority:            suite.authoritySets[0].beefyAuthoritySet(),
+		NextAuthoritySet:     suite.authoritySets[1].beefyAuthoritySet(),
+		RelayChain:           grandpa.RelayChain_ROCOCO,
+		ParaId:               paraID,
+		LatestParaHeight:     33,
+	}
+	suite.Require().NoError(suite.clientState.Validate())
+
+	latestHeight := suite.clientState.GetLatestHeight()
+	for revisionHeight, encoded := range suite.testData.ConsensusStates {
+		parachainConsensusState := &grandpa.ConsensusState{}
+		suite.Require().NoError(parachainConsensusState.Unmarshal(suite.decode(encoded)))
+		consensusState := beefy.NewConsensusState(parachainConsensusState.Timestamp, parachainConsensusState.Root)
+
+		number, err := strconv.ParseUint(revisionHeight, 10, 64)
+		suite.Require().NoError(err)
+
+		height := clienttypes.NewHeight(paraID, number)
+		if height.EQ(latestHeight) {
+			suite.Require().NoError(suite.clientState.Initialize(suite.ctx, suite.chainA.Codec, suite.store, consensusState))
+			continue
+		}
+
+		suite.store.Set(host.ConsensusStateKey(height), clienttypes.MustMarshalConsensusState(suite.chainA.Codec, consensusState))
+		grandpa.SetProcessedTime(suite.store, height, uint64(suite.ctx.BlockTime().UnixNano()))
+		grandpa.SetProcessedHeight(suite.store, height, clienttypes.GetSelfHeight(suite.ctx))
+	}
+}
+
+func (suite *BeefyTestSuite) decode(encoded string) []byte {
+	bz, err := base64.StdEncoding.DecodeString(encoded)
+	suite.Require().NoError(err)
+	return bz
+}
+
+// header returns a header updating the MMR root to the commitment block, signed by the
+// first authorities of the current authority set, together with the parachain headers of
+// the grandpa header fixture.
+func (suite *BeefyTestSuite) header() *beefy.Header {
+	commitment := &beefy.Commitment{
+		Payload:        []*beefy.PayloadItem{{Id: []byte("mh"), Data: suite.mmrRoot(commitmentBlock)}},
+		BlockNumber:    commitmentBlock,
+		ValidatorSetId: 0,
+	}
+
+	header := &beefy.Header{
+		MmrUpdateProof: &beefy.MmrUpdateProof{
+			SignedCommitment: suite.authoritySets[0].sign(commitment, 0, 1, 2, 3),
+			LatestMmrLeaf:    suite.mmrLeafProof(commitmentBlock, commitmentBlock),
+		},
+		ParaId:     paraID,
+		ParaHeight: suite.grandpaHeader.ParaHeight,
+	}
+
+	for _, parachainHeader := range suite.grandpaHeader.ParachainHeaders {
+		var relayHash scale.Hash
+		copy(relayHash[:], parachainHeader.RelayHash)
+		relayHeader, ok := suite.relayHeaders[relayHash]
+		suite.Require().True(ok)
+
+		decoded, err := scale.DecodeHeader(relayHeader)
+		suite.Require().NoError(err)
+
+		header.ParachainHeaders = append(header.ParachainHeaders, &beefy.ParachainHeader{
+			RelayHeader:     relayHeader,
+			MmrLeafProof:    suite.mmrLeafProof(decoded.Number+1, commitmentBlock),
+			ParachainHeader: parachainHeader.ParachainHeader,
+		})
+	}
+
+	return header
+}
+
+// mmrRoot returns the MMR root at the given relay chain block.
+func (suite *BeefyTestSuite) mmrRoot(beefyHeight uint32) []byte {
+	root := bagPeaks(suite.peaks(beefyHeight))
+	return root[:]
+}
+
+// mmrLeafProof returns the proof of the leaf of the given block against the MMR root at beefyHeight.
+func (suite *BeefyTestSuite) mmrLeafProof(block, beefyHeight uint32) *beefy.MmrLeafProof {
+	var (
+		index     = uint64(block - activationBlock)
+		count     = uint64(beefyHeight-activationBlock) + 1
+		items     [][]byte
+		rhsPeaks  []scale.Hash
+		firstLeaf uint64
+	)
+	for height := 63; height >= 0; height-- {
+		size := uint64(1) << height
+		if count&size == 0 {
+			continue
+		}
+
+		switch {
+		case index >= firstLeaf+size:
+			peak := suite.subtreeRoot(firstLeaf, size)
+			items = append(items, peak[:])
+		case index < firstLeaf:
+			rhsPeaks = append(rhsPeaks, suite.subtreeRoot(firstLeaf, size))
+		default:
+			for level := 0; level < height; level++ {
+				sibling := (((index - firstLeaf) >> level) ^ 1) << level
+				hash := suite.subtreeRoot(firstLeaf+sibling, 1<<level)
+				items = append(items, hash[:])
+			}
+		}
+		firstLeaf += size
+	}
+
+	if len(rhsPeaks) != 0 {
+		bagged := bagPeaks(rhsPeaks)
+		items = append(items, bagged[:])
+	}
+
+	return &beefy.MmrLeafProof{Leaf: suite.leaves[index], Items: items}
+}
+
+// peaks returns the peaks of the MMR at the given relay chain block.
+func (suite *BeefyTestSuite) peaks(beefyHeight uint32) []scale.Hash {
+	var (
+		count     = uint64(beefyHeight-activationBlock) + 1
+		peaks     []scale.Hash
+		firstLeaf uint64
+	)
+	for height := 63; height >= 0; height-- {
+		size := uint64(1) << height
+		if count&size != 0 {
+			peaks = append(peaks, suite.subtreeRoot(firstLeaf, size))
+			firstLeaf += size
+		}
+	}
+	return peaks
+}
+
+// subtreeRoot returns the root of the perfect binary tree over the given leaves.
+func (suite *BeefyTestSuite) subtreeRoot(firstLeaf, size uint64) scale.Hash {
+	if size == 1 {
+		return keccak256(suite.leaves[firstLeaf])
+	}
+
+	left := suite.subtreeRoot(firstLeaf, size/2)
+	right := suite.subtreeRoot(firstLeaf+size/2, size/2)
+	return keccak256(left[:], right[:])
+}
+
+// bagPeaks hashes the MMR peaks from right to left.
+func bagPeaks(peaks []scale.Hash) scale.Hash {
+	for len(peaks) > 1 {
+		right, left := peaks[len(peaks)-1], peaks[len(peaks)-2]
+		peaks = append(peaks[:len(peaks)-2], keccak256(right[:], left[:]))
+	}
+	return peaks[0]
+}
+
+func encodeMmrLeaf(parentNumber uint32, parentHash scale.Hash, nextAuthoritySet *beefy.BeefyAuthoritySet) []byte {
+	e := &scale.Encoder{}
+	e.WriteU8(0)
+	e.WriteU32(parentNumber)
+	e.WriteBytes(parentHash[:])
+	e.WriteU64(nextAuthoritySet.Id)
+	e.WriteU32(nextAuthoritySet.Len)
+	e.WriteBytes(nextAuthoritySet.Root)
+	parachainHeadsRoot := keccak256([]byte("parachain heads"), binary.LittleEndian.AppendUint32(nil, parentNumber))
+	e.WriteBytes(parachainHeadsRoot[:])
+	return e.Bytes()
+}
+
+func encodeCommitment(commitment *beefy.Commitment) []byte {
+	e := &scale.Encoder{}
+	e.WriteCompact(uint64(len(commitment.Payload)))
+	for _, item := range commitment.Payload {
+		e.WriteBytes(item.Id)
+		e.WriteVec(item.Data)
+	}
+	e.WriteU32(commitment.BlockNumber)
+	e.WriteU64(commitment.ValidatorSetId)
+	return e.Bytes()
+}
+
+func keccak256(data ...[]byte) scale.Hash {
+	var hash scale.Hash
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	hasher.Sum(hash[:0])
+	return hash
+}
+
+// authoritySet is a BEEFY authority set with deterministic secp256k1 keys.
+type authoritySet struct {
+	id   uint64
+	keys []*secp256k1.PrivateKey
+}
+
+func newAuthoritySet(id uint64) *authoritySet {
+	set := &authoritySet{id: id}
+	for i := 0; i < authorityCount; i++ {
+		seed := keccak256([]byte("authority"), binary.LittleEndian.AppendUint64(nil, id), []byte{byte(i)})
+		set.keys = append(set.keys, secp256k1.PrivKeyFromBytes(seed[:]))
+	}
+	return set
+}
+
+// leaves returns the merkle leaves of the authority set: the hashes of the ethereum
+// addresses of the authorities.
+func (s *authoritySet) leaves() []scale.Hash {
+	leaves := make([]scale.Hash, len(s.keys))
+	for i, key := range s.keys {
+		hash := keccak256(key.PubKey().SerializeUncompressed()[1:])
+		leaves[i] = keccak256(hash[12:])
+	}
+	return leaves
+}
+
+func (s *authoritySet) beefyAuthoritySet() *beefy.BeefyAuthoritySet {
+	level := s.leaves()
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+	return &beefy.BeefyAuthoritySet{Id: s.id, Len: uint32(len(s.keys)), Root: level[0][:]}
+}
+
+// proof returns the merkle proof of the authority at the given index.
+func (s *authoritySet) proof(index int) [][]byte {
+	var proof [][]byte
+	for level := s.leaves(); len(level) > 1; level = nextMerkleLevel(level) {
+		switch {
+		case index%2 == 1:
+			proof = append(proof, level[index-1][:])
+		case index+1 < len(level):
+			proof = append(proof, level[index+1][:])
+		}
+		index /= 2
+	}
+	return proof
+}
+
+// sign signs the commitment with the authorities at the given indices.
+func (s *authoritySet) sign(commitment *beefy.Commitment, indices ...int) *beefy.SignedCommitment {
+	hash := keccak256(encodeCommitment(commitment))
+	signed := &beefy.SignedCommitment{Commitment: commitment}
+	for _, index := range indices {
+		compact := ecdsa.SignCompact(s.keys[index], hash[:], false)
+
+		// compact signatures are prefixed by 27 plus the recovery id
+		signature := append(compact[1:], compact[0]-27)
+		signed.Signatures = append(signed.Signatures, &beefy.CommitmentSignature{
+			Signature:      signature,
+			AuthorityIndex: uint32(index),
+			AuthorityProof: s.proof(index),
+		})
+	}
+	return signed
+}
+
+func nextMerkleLevel(level []scale.Hash) []scale.Hash {
+	next := make([]scale.Hash, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, keccak256(level[i][:], level[i+1][:]))
+	}
+	return next
+}
+
+func TestBeefyTestSuite(t *testing.T) {
+	suite.Run(t, new(BeefyTestSuite))
+}
@@ -0,0 +1,230 @@
+package beefy
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// ClientType is beefy.
+func (cs *ClientState) ClientType() string {
+	return exported.Beefy
+}
+
+// GetLatestHeight returns the latest parachain height tracked by the client. The
+// revision number of the height is the parachain id.
+func (cs *ClientState) GetLatestHeight() exported.Height {
+	return clienttypes.NewHeight(uint64(cs.ParaId), uint64(cs.LatestParaHeight))
+}
+
+// IsFrozen returns true if the client has been frozen due to misbehaviour.
+func (cs *ClientState) IsFrozen() bool {
+	return cs.XFrozenHeight != nil
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs *ClientState) Validate() error {
+	if len(cs.MmrRootHash) != hashLength {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "MMR root hash must be %d bytes, got %d", hashLength, len(cs.MmrRootHash))
+	}
+	if cs.LatestBeefyHeight < cs.BeefyActivationBlock {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "latest beefy height %d is lower than the beefy activation block %d", cs.LatestBeefyHeight, cs.BeefyActivationBlock)
+	}
+	if _, ok := grandpa.RelayChain_name[int32(cs.RelayChain)]; !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "unknown relay chain %d", cs.RelayChain)
+	}
+	if cs.ParaId == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "para id cannot be zero")
+	}
+	if cs.LatestParaHeight == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "latest para height cannot be zero")
+	}
+	if err := validateAuthoritySet(cs.Authority); err != nil {
+		return sdkerrors.Wrap(err, "current authority set")
+	}
+	if err := validateAuthoritySet(cs.NextAuthoritySet); err != nil {
+		return sdkerrors.Wrap(err, "next authority set")
+	}
+	if cs.NextAuthoritySet.Id != cs.Authority.Id+1 {
+		return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "next authority set id %d must follow current authority set id %d", cs.NextAuthoritySet.Id, cs.Authority.Id)
+	}
+
+	return nil
+}
+
+// validateAuthoritySet checks that the authority set is not empty and commits to its
+// authorities with a merkle root.
+func validateAuthoritySet(authoritySet *BeefyAuthoritySet) error {
+	if authoritySet == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthoritySet, "authority set cannot be nil")
+	}
+	if authoritySet.Len == 0 {
+		return sdkerrors.Wrap(ErrInvalidAuthoritySet, "authority set cannot be empty")
+	}
+	if len(authoritySet.Root) != hashLength {
+		return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "authority set root must be %d bytes, got %d", hashLength, len(authoritySet.Root))
+	}
+	return nil
+}
+
+// Status returns the status of the beefy client.
+// The client may be:
+// - Active: FrozenHeight is not set and a consensus state exists for the latest height
+// - Frozen: Frozen Height is set
+// - Expired: no consensus state exists for the latest height
+//
+// BEEFY finality does not rely on a trusting period, so a client is never
+// considered expired as long as its latest consensus state is stored.
+func (cs *ClientState) Status(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+) exported.Status {
+	if cs.IsFrozen() {
+		return exported.Frozen
+	}
+
+	if _, found := GetConsensusState(clientStore, cdc, cs.GetLatestHeight()); !found {
+		// if the client state does not have an associated consensus state for its latest height
+		// then it must be expired
+		return exported.Expired
+	}
+
+	return exported.Active
+}
+
+// ZeroCustomFields is not implemented for beefy
+func (cs *ClientState) ZeroCustomFields() exported.ClientState {
+	panic("ZeroCustomFields is not implemented as the beefy implementation does not support upgrades.")
+}
+
+// GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the given height.
+func (cs *ClientState) GetTimestampAtHeight(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+) (uint64, error) {
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return 0, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+	return consState.GetTimestamp(), nil
+}
+
+// Initialize checks that the initial consensus state is a 11-beefy consensus state and
+// sets the client state, consensus state and associated metadata in the provided client store.
+func (cs *ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	setClientState(clientStore, cdc, cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.GetLatestHeight())
+	setConsensusMetadata(ctx, clientStore, cs.GetLatestHeight())
+
+	return nil
+}
+
+// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+// Proofs have the same format as for the grandpa client.
+func (cs *ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	storedValue, found, err := cs.readStorage(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "path does not exist in the parachain state")
+	}
+
+	if !bytes.Equal(storedValue, value) {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proven value does not match the expected value")
+	}
+
+	return nil
+}
+
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
+// Proofs have the same format as for the grandpa client.
+func (cs *ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	_, found, err := cs.readStorage(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "path exists in the parachain state")
+	}
+
+	return nil
+}
+
+// readStorage performs the checks shared by membership and non-membership verification and
+// returns the value stored under the path according to the proof.
+func (cs *ClientState) readStorage(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) ([]byte, bool, error) {
+	if cs.GetLatestHeight().LT(height) {
+		return nil, false, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if err := grandpa.VerifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return nil, false, err
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, false, sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return grandpa.ReadParachainStorage(consensusState.Root, proof, path)
+}
+
+// VerifyUpgradeAndUpdateState returns an error since beefy client does not support upgrades
+func (cs *ClientState) VerifyUpgradeAndUpdateState(
+	_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore,
+	_ exported.ClientState, _ exported.ConsensusState, _, _ []byte,
+) error {
+	return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade beefy client")
+}
@@ -0,0 +1,156 @@
+package beefy_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	beefy "github.com/cosmos/ibc-go/v7/modules/light-clients/11-beefy"
+)
+
+const pathPrefix = "ibc/"
+
+func (suite *BeefyTestSuite) TestValidate() {
+	var clientState *beefy.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"valid client", func() {}, true},
+		{"invalid MMR root", func() { clientState.MmrRootHash = []byte{1} }, false},
+		{"latest beefy height before activation", func() { clientState.LatestBeefyHeight = activationBlock - 1 }, false},
+		{"unknown relay chain", func() { clientState.RelayChain = 10 }, false},
+		{"zero para id", func() { clientState.ParaId = 0 }, false},
+		{"zero latest para height", func() { clientState.LatestParaHeight = 0 }, false},
+		{"nil authority set", func() { clientState.Authority = nil }, false},
+		{"empty authority set", func() { clientState.Authority.Len = 0 }, false},
+		{"invalid authority set root", func() { clientState.NextAuthoritySet.Root = []byte{1} }, false},
+		{"next authority set does not follow", func() { clientState.NextAuthoritySet.Id = 2 }, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clientState = suite.clientState
+
+			tc.malleate()
+
+			err := clientState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BeefyTestSuite) TestStatus() {
+	suite.Require().Equal(exported.Beefy, suite.clientState.ClientType())
+	suite.Require().Equal(clienttypes.NewHeight(paraID, 33), suite.clientState.GetLatestHeight())
+	suite.Require().Equal(exported.Active, suite.clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	clientState := *suite.clientState
+	clientState.LatestParaHeight = 34
+	suite.Require().Equal(exported.Expired, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	clientState.XFrozenHeight = &beefy.ClientState_FrozenHeight{FrozenHeight: commitmentBlock}
+	suite.Require().Equal(exported.Frozen, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	timestamp, err := suite.clientState.GetTimestampAtHeight(suite.ctx, suite.store, suite.chainA.Codec, clienttypes.NewHeight(paraID, 11))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(time.Date(2023, 3, 13, 18, 31, 0, 23000000, time.UTC).UnixNano()), timestamp)
+}
+
+func (suite *BeefyTestSuite) TestVerifyMembership() {
+	var (
+		height exported.Height
+		path   exported.Path
+		proof  []byte
+		value  []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful ClientState verification", func() {}, true,
+		},
+		{
+			"latest client height < height", func() {
+				height = suite.clientState.GetLatestHeight().Increment()
+			},
+			false,
+		},
+		{
+			"consensus state not found", func() {
+				height = clienttypes.NewHeight(paraID, 12)
+			},
+			false,
+		},
+		{
+			"failed to decode proof", func() {
+				proof = []byte("invalid proof")
+			},
+			false,
+		},
+		{
+			"proof verification failed", func() {
+				value = []byte("invalid value")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			height = clienttypes.NewHeight(paraID, 11)
+			path = commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-0")))
+			proof = suite.decode(suite.testData.ClientStateProof)
+
+			var err error
+			value, err = suite.chainA.Codec.MarshalInterface(&ibctm.ClientState{
+				ChainId:         "simd",
+				TrustLevel:      ibctm.Fraction{Numerator: 1, Denominator: 3},
+				TrustingPeriod:  time.Second * 64000,
+				UnbondingPeriod: time.Second * 1814400,
+				MaxClockDrift:   time.Second * 15,
+				LatestHeight:    clienttypes.NewHeight(0, 46),
+				ProofSpecs:      commitmenttypes.GetSDKSpecs(),
+				UpgradePath:     []string{"upgrade", "upgradedIBCState"},
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.clientState.VerifyMembership(suite.ctx, suite.store, suite.chainA.Codec, height, 0, 0, proof, path, value)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BeefyTestSuite) TestVerifyNonMembership() {
+	height := clienttypes.NewHeight(paraID, 11)
+	proof := suite.decode(suite.testData.ClientStateProof)
+
+	path := commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-1")))
+	suite.Require().NoError(suite.clientState.VerifyNonMembership(suite.ctx, suite.store, suite.chainA.Codec, height, 0, 0, proof, path))
+
+	path = commitmenttypes.NewMerklePath(pathPrefix, string(host.FullClientStateKey("07-tendermint-0")))
+	suite.Require().Error(suite.clientState.VerifyNonMembership(suite.ctx, suite.store, suite.chainA.Codec, height, 0, 0, proof, path))
+}
@@ -0,0 +1,172 @@
+package beefy
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+const (
+	// payloadIDLength is the length of a commitment payload identifier
+	payloadIDLength = 2
+	// signatureLength is the length of a recoverable secp256k1 signature: r ++ s ++ v
+	signatureLength = 65
+	// addressLength is the length of the ethereum address of an authority
+	addressLength = 20
+)
+
+// mmrRootPayloadID identifies the MMR root in a commitment payload.
+var mmrRootPayloadID = []byte("mh")
+
+// encode returns the SCALE encoding of the commitment, as signed by the authorities.
+func (c *Commitment) encode() []byte {
+	e := &scale.Encoder{}
+	e.WriteCompact(uint64(len(c.Payload)))
+	for _, item := range c.Payload {
+		e.WriteBytes(item.Id)
+		e.WriteVec(item.Data)
+	}
+	e.WriteU32(c.BlockNumber)
+	e.WriteU64(c.ValidatorSetId)
+	return e.Bytes()
+}
+
+// mmrRoot returns the MMR root included in the commitment payload. Payload items must be
+// sorted by identifier without duplicates, as the payload is a sorted map in Substrate.
+func (c *Commitment) mmrRoot() ([]byte, error) {
+	var root []byte
+	for i, item := range c.Payload {
+		if item == nil || len(item.Id) != payloadIDLength {
+			return nil, sdkerrors.Wrapf(ErrInvalidCommitment, "payload item %d must have a %d byte identifier", i, payloadIDLength)
+		}
+		if i > 0 && bytes.Compare(c.Payload[i-1].Id, item.Id) >= 0 {
+			return nil, sdkerrors.Wrap(ErrInvalidCommitment, "payload items must be sorted by identifier")
+		}
+		if !bytes.Equal(item.Id, mmrRootPayloadID) {
+			continue
+		}
+
+		// the MMR root is a SCALE encoded H256, i.e. the raw hash bytes
+		if len(item.Data) != hashLength {
+			return nil, sdkerrors.Wrapf(ErrInvalidCommitment, "MMR root must be %d bytes, got %d", hashLength, len(item.Data))
+		}
+		root = item.Data
+	}
+
+	if root == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidCommitment, "commitment payload does not contain a MMR root")
+	}
+
+	return root, nil
+}
+
+// verifySignatures checks that the commitment is signed by more than two thirds of the
+// authority set. Every signature comes with a merkle proof of the ethereum address of the
+// signing authority against the authority set root.
+func (sc *SignedCommitment) verifySignatures(authoritySet *BeefyAuthoritySet) error {
+	if sc.Commitment.ValidatorSetId != authoritySet.Id {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "commitment signed by authority set %d, expected %d", sc.Commitment.ValidatorSetId, authoritySet.Id)
+	}
+
+	threshold := 2*uint64(authoritySet.Len)/3 + 1
+	if uint64(len(sc.Signatures)) < threshold {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "commitment has %d signatures, %d are required", len(sc.Signatures), threshold)
+	}
+
+	hash := keccak256(sc.Commitment.encode())
+	signed := make(map[uint32]bool, len(sc.Signatures))
+	for i, signature := range sc.Signatures {
+		if signature == nil {
+			return sdkerrors.Wrapf(ErrInvalidSignature, "signature %d cannot be nil", i)
+		}
+		if signature.AuthorityIndex >= authoritySet.Len {
+			return sdkerrors.Wrapf(ErrInvalidSignature, "authority index %d out of range for %d authorities", signature.AuthorityIndex, authoritySet.Len)
+		}
+		if signed[signature.AuthorityIndex] {
+			return sdkerrors.Wrapf(ErrInvalidSignature, "duplicate signature of authority %d", signature.AuthorityIndex)
+		}
+		signed[signature.AuthorityIndex] = true
+
+		address, err := recoverAddress(hash, signature.Signature)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "signature %d", i)
+		}
+
+		if err := verifyMerkleProof(authoritySet.Root, keccak256(address), signature.AuthorityIndex, authoritySet.Len, signature.AuthorityProof); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidSignature, "authority %d is not part of the authority set: %s", signature.AuthorityIndex, err)
+		}
+	}
+
+	return nil
+}
+
+// recoverAddress returns the ethereum address of the key that produced the recoverable
+// secp256k1 signature over hash. The recovery id of the signature may be given either as
+// 0 or 1, or in its ethereum form 27 or 28.
+func recoverAddress(hash scale.Hash, signature []byte) ([]byte, error) {
+	if len(signature) != signatureLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignature, "signature must be %d bytes, got %d", signatureLength, len(signature))
+	}
+
+	recoveryID := signature[signatureLength-1]
+	if recoveryID >= 27 {
+		recoveryID -= 27
+	}
+	if recoveryID > 1 {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignature, "invalid recovery id %d", signature[signatureLength-1])
+	}
+
+	// compact signatures are prefixed by 27 plus the recovery id for uncompressed keys
+	compact := append([]byte{27 + recoveryID}, signature[:signatureLength-1]...)
+	publicKey, _, err := ecdsa.RecoverCompact(compact, hash[:])
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSignature, err.Error())
+	}
+
+	// the address is the last 20 bytes of the hash of the uncompressed key without its prefix
+	addressHash := keccak256(publicKey.SerializeUncompressed()[1:])
+	return addressHash[hashLength-addressLength:], nil
+}
+
+// verifyMerkleProof verifies a proof of the leaf at leafIndex of a binary merkle tree of
+// leafCount leaves, as built by Substrate's binary-merkle-tree crate: nodes are hashed as
+// keccak256(left ++ right) and the last node of a level with an odd number of nodes is
+// promoted to the next level. The proof contains the siblings from the leaf to the root.
+func verifyMerkleProof(root []byte, leafHash scale.Hash, leafIndex, leafCount uint32, proof [][]byte) error {
+	if leafIndex >= leafCount {
+		return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "leaf index %d out of range for %d leaves", leafIndex, leafCount)
+	}
+
+	computed, index, width := leafHash, leafIndex, leafCount
+	for width > 1 {
+		switch {
+		case index%2 == 1:
+			if len(proof) == 0 || len(proof[0]) != hashLength {
+				return sdkerrors.Wrap(ErrInvalidAuthoritySet, "invalid merkle proof")
+			}
+			computed, proof = keccak256(proof[0], computed[:]), proof[1:]
+		case index+1 < width:
+			if len(proof) == 0 || len(proof[0]) != hashLength {
+				return sdkerrors.Wrap(ErrInvalidAuthoritySet, "invalid merkle proof")
+			}
+			computed, proof = keccak256(computed[:], proof[0]), proof[1:]
+		default:
+			// the last node of a level with an odd width is promoted
+		}
+
+		index, width = index/2, (width+1)/2
+	}
+
+	if len(proof) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "%d unused merkle proof items", len(proof))
+	}
+
+	if !bytes.Equal(computed[:], root) {
+		return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "computed merkle root %s does not match root %X", computed, root)
+	}
+
+	return nil
+}
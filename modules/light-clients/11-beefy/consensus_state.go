@@ -0,0 +1,23 @@
+package beefy
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(timestamp time.Time, root []byte) *ConsensusState {
+	return &ConsensusState{
+		ConsensusState: *grandpa.NewConsensusState(timestamp, root),
+	}
+}
+
+// ClientType returns Beefy. The timestamp and validation of the consensus state are
+// shared with the grandpa consensus state.
+func (ConsensusState) ClientType() string {
+	return exported.Beefy
+}
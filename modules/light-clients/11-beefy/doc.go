@@ -0,0 +1,10 @@
+/*
+Package beefy implements a concrete `ClientState`, `ConsensusState` and `Header`
+types for a light client tracking a Substrate parachain whose relay chain is
+followed through BEEFY signed commitments over Merkle Mountain Range roots.
+
+Parachain headers are proven against the relay chain state of blocks committed to
+by the MMR and share the parachain header and consensus state model of the
+10-grandpa client.
+*/
+package beefy
@@ -0,0 +1,17 @@
+package beefy
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC beefy client sentinel errors
+var (
+	ErrInvalidScaleEncoding   = sdkerrors.Register(ModuleName, 2, "invalid SCALE encoding")
+	ErrInvalidAuthoritySet    = sdkerrors.Register(ModuleName, 3, "invalid authority set")
+	ErrInvalidCommitment      = sdkerrors.Register(ModuleName, 4, "invalid BEEFY commitment")
+	ErrInvalidSignature       = sdkerrors.Register(ModuleName, 5, "invalid commitment signature")
+	ErrInvalidMmrProof        = sdkerrors.Register(ModuleName, 6, "invalid MMR proof")
+	ErrInvalidMmrLeaf         = sdkerrors.Register(ModuleName, 7, "invalid MMR leaf")
+	ErrInvalidHeader          = sdkerrors.Register(ModuleName, 8, "invalid header")
+	ErrInvalidParachainHeader = sdkerrors.Register(ModuleName, 9, "invalid parachain header")
+)
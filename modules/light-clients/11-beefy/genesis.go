@@ -0,0 +1,23 @@
+package beefy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+)
+
+// ExportMetadata exports all the consensus metadata in the client store so they can be included in clients genesis
+// and imported by a ClientKeeper
+func (cs *ClientState) ExportMetadata(_ sdk.Context, store sdk.KVStore) []exported.GenesisMetadata {
+	gm := make([]exported.GenesisMetadata, 0)
+	grandpa.IterateConsensusMetadata(store, func(key, val []byte) bool {
+		gm = append(gm, clienttypes.NewGenesisMetadata(key, val))
+		return false
+	})
+	if len(gm) == 0 {
+		return nil
+	}
+	return gm
+}
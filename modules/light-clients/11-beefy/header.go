@@ -0,0 +1,60 @@
+package beefy
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+var _ exported.ClientMessage = &Header{}
+
+// ClientType defines that the Header is a BEEFY MMR update and parachain headers
+func (Header) ClientType() string {
+	return exported.Beefy
+}
+
+// GetHeight returns the height of the latest parachain header included in the Header.
+func (h Header) GetHeight() exported.Height {
+	return clienttypes.NewHeight(uint64(h.ParaId), uint64(h.ParaHeight))
+}
+
+// ValidateBasic checks that the header either updates the MMR root or contains parachain
+// headers, and that every proof required to verify them is present.
+func (h Header) ValidateBasic() error {
+	if h.MmrUpdateProof == nil && len(h.ParachainHeaders) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "header must contain a MMR update proof or parachain headers")
+	}
+
+	if update := h.MmrUpdateProof; update != nil {
+		if update.SignedCommitment == nil || update.SignedCommitment.Commitment == nil {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "signed commitment cannot be nil")
+		}
+		if update.LatestMmrLeaf == nil || len(update.LatestMmrLeaf.Leaf) == 0 {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "latest MMR leaf cannot be empty")
+		}
+	}
+
+	for i, parachainHeader := range h.ParachainHeaders {
+		if parachainHeader == nil {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "parachain header %d cannot be nil", i)
+		}
+		if len(parachainHeader.RelayHeader) == 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "relay header of parachain header %d cannot be empty", i)
+		}
+		if parachainHeader.MmrLeafProof == nil || len(parachainHeader.MmrLeafProof.Leaf) == 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "MMR leaf of parachain header %d cannot be empty", i)
+		}
+		if parachainHeader.ParachainHeader == nil {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "proofs of parachain header %d cannot be nil", i)
+		}
+		if len(parachainHeader.ParachainHeader.StateProof) == 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "state proof of parachain header %d cannot be empty", i)
+		}
+		if len(parachainHeader.ParachainHeader.Extrinsic) == 0 || len(parachainHeader.ParachainHeader.ExtrinsicProof) == 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "timestamp extrinsic and proof of parachain header %d cannot be empty", i)
+		}
+	}
+
+	return nil
+}
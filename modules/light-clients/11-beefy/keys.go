@@ -0,0 +1,5 @@
+package beefy
+
+const (
+	ModuleName = "11-beefy"
+)
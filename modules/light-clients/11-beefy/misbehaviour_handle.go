@@ -0,0 +1,40 @@
+package beefy
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// CheckForMisbehaviour detects conflicting parachain headers in a submitted Header message.
+// A Header is considered misbehaviour if it proves a parachain header at a height for which
+// the client already stores a different consensus state.
+func (cs *ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, msg exported.ClientMessage) bool {
+	header, ok := msg.(*Header)
+	if !ok {
+		return false
+	}
+
+	// the header has already been verified, processing it again cannot fail
+	update, err := cs.processHeader(header)
+	if err != nil {
+		return false
+	}
+
+	for _, height := range update.heights {
+		existingConsState, _ := GetConsensusState(clientStore, cdc, height)
+		if existingConsState == nil {
+			continue
+		}
+
+		// A consensus state already exists for this height, but it does not match the provided parachain header.
+		if !reflect.DeepEqual(existingConsState, update.consensusStates[height]) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package beefy
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+// hashLength is the length of the keccak256 hashes used by BEEFY and the MMR.
+const hashLength = scale.HashLength
+
+// keccak256 returns the keccak256 hash of the concatenation of the given byte slices.
+func keccak256(data ...[]byte) scale.Hash {
+	var hash scale.Hash
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	hasher.Sum(hash[:0])
+	return hash
+}
+
+// mmrLeaf is a leaf of the BEEFY Merkle Mountain Range. Every relay chain block appends
+// a leaf committing to its parent block.
+type mmrLeaf struct {
+	version            uint8
+	parentNumber       uint32
+	parentHash         scale.Hash
+	nextAuthoritySet   BeefyAuthoritySet
+	parachainHeadsRoot scale.Hash
+	hash               scale.Hash
+}
+
+// decodeMmrLeaf decodes a SCALE encoded MMR leaf. The leaf hash is the keccak256 hash of
+// its encoding.
+func decodeMmrLeaf(bz []byte) (*mmrLeaf, error) {
+	var (
+		d    = scale.NewDecoder(bz)
+		leaf = &mmrLeaf{hash: keccak256(bz)}
+		err  error
+	)
+
+	if leaf.version, err = d.ReadU8(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+	if leaf.parentNumber, err = d.ReadU32(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+	if leaf.parentHash, err = d.ReadHash(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+	if leaf.nextAuthoritySet.Id, err = d.ReadU64(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+	if leaf.nextAuthoritySet.Len, err = d.ReadU32(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	root, err := d.ReadHash()
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+	leaf.nextAuthoritySet.Root = root[:]
+
+	if leaf.parachainHeadsRoot, err = d.ReadHash(); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+	if err := d.Finish("mmr leaf"); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidScaleEncoding, err.Error())
+	}
+
+	return leaf, nil
+}
+
+// verifyMmrProof verifies that the leaf hash is the leaf at leafIndex of a Merkle Mountain
+// Range of leafCount leaves with the given root. The MMR is made of perfect binary trees,
+// the peaks, one for every bit set in leafCount from the largest to the smallest. The proof
+// items follow the layout used by Substrate: the peaks left of the leaf's peak, the siblings
+// from the leaf up to its peak, and the peaks right of the leaf's peak bagged into a single
+// item. Nodes are hashed as keccak256(left ++ right) and peaks are bagged from the right as
+// keccak256(right ++ left).
+func verifyMmrProof(root []byte, leafHash scale.Hash, leafIndex, leafCount uint64, items [][]byte) error {
+	if leafIndex >= leafCount {
+		return sdkerrors.Wrapf(ErrInvalidMmrProof, "leaf index %d out of range for %d leaves", leafIndex, leafCount)
+	}
+
+	for i, item := range items {
+		if len(item) != hashLength {
+			return sdkerrors.Wrapf(ErrInvalidMmrProof, "proof item %d must be %d bytes, got %d", i, hashLength, len(item))
+		}
+	}
+
+	var (
+		peaks     []scale.Hash
+		firstLeaf uint64
+		found     bool
+	)
+	for height := 63; height >= 0; height-- {
+		size := uint64(1) << height
+		if leafCount&size == 0 {
+			continue
+		}
+
+		if leafIndex < firstLeaf || leafIndex >= firstLeaf+size {
+			if len(items) == 0 {
+				// the peaks right of the leaf's peak are bagged into a single item
+				break
+			}
+
+			var peak scale.Hash
+			copy(peak[:], items[0])
+			peaks, items = append(peaks, peak), items[1:]
+			firstLeaf += size
+			continue
+		}
+
+		computed, index := leafHash, leafIndex-firstLeaf
+		for i := 0; i < height; i++ {
+			if len(items) == 0 {
+				return sdkerrors.Wrap(ErrInvalidMmrProof, "proof is missing sibling hashes")
+			}
+
+			if index%2 == 0 {
+				computed = keccak256(computed[:], items[0])
+			} else {
+				computed = keccak256(items[0], computed[:])
+			}
+			items, index = items[1:], index/2
+		}
+
+		peaks, found = append(peaks, computed), true
+		firstLeaf += size
+	}
+
+	if !found {
+		return sdkerrors.Wrap(ErrInvalidMmrProof, "proof is missing peak hashes")
+	}
+
+	if len(items) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidMmrProof, "%d unused proof items", len(items))
+	}
+
+	for len(peaks) > 1 {
+		right, left := peaks[len(peaks)-1], peaks[len(peaks)-2]
+		peaks = append(peaks[:len(peaks)-2], keccak256(right[:], left[:]))
+	}
+
+	if !bytes.Equal(peaks[0][:], root) {
+		return sdkerrors.Wrapf(ErrInvalidMmrProof, "computed MMR root %s does not match MMR root %X", peaks[0], root)
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package beefy
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	grandpa "github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa"
+	"github.com/cosmos/ibc-go/v7/modules/light-clients/10-grandpa/scale"
+)
+
+// headerUpdate holds the state changes resulting from a verified Header.
+type headerUpdate struct {
+	// signedCommitment is the commitment updating the MMR root, if any, which
+	// must be signed by signingAuthoritySet
+	signedCommitment    *SignedCommitment
+	signingAuthoritySet *BeefyAuthoritySet
+
+	mmrRoot          []byte
+	beefyHeight      uint32
+	authority        *BeefyAuthoritySet
+	nextAuthoritySet *BeefyAuthoritySet
+	heights          []clienttypes.Height
+	consensusStates  map[clienttypes.Height]*ConsensusState
+}
+
+// VerifyClientMessage checks if the clientMessage is of type Header and verifies the message
+func (cs *ClientState) VerifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	clientMsg exported.ClientMessage,
+) error {
+	switch msg := clientMsg.(type) {
+	case *Header:
+		return cs.verifyHeader(msg)
+	default:
+		return clienttypes.ErrInvalidClientType
+	}
+}
+
+// verifyHeader returns an error if:
+// - the header does not pass basic validation
+// - the signed commitment is not newer than the latest MMR root known to the client
+// - the signed commitment is not signed by more than two thirds of the current or next authority set
+// - the latest MMR leaf is not proven against the signed MMR root
+// - any parachain header is not included in a relay chain block committed to by the MMR
+func (cs *ClientState) verifyHeader(header *Header) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	update, err := cs.processHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if update.signedCommitment == nil {
+		return nil
+	}
+
+	return update.signedCommitment.verifySignatures(update.signingAuthoritySet)
+}
+
+// processHeader decodes the header and verifies the MMR proofs of the MMR update and
+// parachain headers. It does not verify the commitment signatures.
+func (cs *ClientState) processHeader(header *Header) (*headerUpdate, error) {
+	if header.ParaId != cs.ParaId {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "header para id %d does not match client para id %d", header.ParaId, cs.ParaId)
+	}
+
+	update := &headerUpdate{
+		mmrRoot:          cs.MmrRootHash,
+		beefyHeight:      cs.LatestBeefyHeight,
+		authority:        cs.Authority,
+		nextAuthoritySet: cs.NextAuthoritySet,
+		consensusStates:  make(map[clienttypes.Height]*ConsensusState),
+	}
+
+	if header.MmrUpdateProof != nil {
+		if err := cs.processMmrUpdate(header.MmrUpdateProof, update); err != nil {
+			return nil, err
+		}
+	}
+
+	var latestParaHeight uint32
+	for i, parachainHeader := range header.ParachainHeaders {
+		relayHeader, err := scale.DecodeHeader(parachainHeader.RelayHeader)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidScaleEncoding, "relay header of parachain header %d: %s", i, err)
+		}
+
+		leaf, err := cs.verifyMmrLeaf(parachainHeader.MmrLeafProof, update.mmrRoot, update.beefyHeight)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "parachain header %d", i)
+		}
+
+		// as only canonical encodings are accepted, the block hash is the hash of the given bytes
+		relayHash := scale.Blake2b256(parachainHeader.RelayHeader)
+		if leaf.parentHash != relayHash || leaf.parentNumber != relayHeader.Number {
+			return nil, sdkerrors.Wrapf(ErrInvalidParachainHeader, "relay block %d (%s) of parachain header %d is not committed to by its MMR leaf", relayHeader.Number, relayHash, i)
+		}
+
+		height, parachainConsensusState, err := grandpa.VerifyParachainHeader(cs.ParaId, relayHeader.StateRoot[:], parachainHeader.ParachainHeader)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "parachain header %d", i)
+		}
+		consensusState := &ConsensusState{ConsensusState: *parachainConsensusState}
+
+		if existing, ok := update.consensusStates[height]; ok {
+			if !bytes.Equal(existing.Root, consensusState.Root) {
+				return nil, sdkerrors.Wrapf(ErrInvalidParachainHeader, "conflicting parachain headers at height %s", height)
+			}
+			continue
+		}
+
+		update.heights = append(update.heights, height)
+		update.consensusStates[height] = consensusState
+		if uint32(height.RevisionHeight) > latestParaHeight {
+			latestParaHeight = uint32(height.RevisionHeight)
+		}
+	}
+
+	if len(header.ParachainHeaders) != 0 && header.ParaHeight != latestParaHeight {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "header para height %d does not match latest parachain header height %d", header.ParaHeight, latestParaHeight)
+	}
+
+	sort.Slice(update.heights, func(i, j int) bool {
+		return update.heights[i].LT(update.heights[j])
+	})
+
+	return update, nil
+}
+
+// processMmrUpdate checks that the signed commitment of the MMR update is newer than the
+// latest MMR root known to the client and proves the latest MMR leaf against the signed root.
+// A commitment signed by the next authority set enacts the authority set change announced
+// in the latest MMR leaf.
+func (cs *ClientState) processMmrUpdate(proof *MmrUpdateProof, update *headerUpdate) error {
+	commitment := proof.SignedCommitment.Commitment
+	if commitment.BlockNumber <= cs.LatestBeefyHeight {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "commitment block %d is not newer than the latest beefy height %d", commitment.BlockNumber, cs.LatestBeefyHeight)
+	}
+
+	switch commitment.ValidatorSetId {
+	case cs.Authority.Id:
+		update.signingAuthoritySet = cs.Authority
+	case cs.NextAuthoritySet.Id:
+		update.signingAuthoritySet = cs.NextAuthoritySet
+	default:
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "commitment is signed by unknown authority set %d", commitment.ValidatorSetId)
+	}
+
+	mmrRoot, err := commitment.mmrRoot()
+	if err != nil {
+		return err
+	}
+
+	latestLeaf, err := cs.verifyMmrLeaf(proof.LatestMmrLeaf, mmrRoot, commitment.BlockNumber)
+	if err != nil {
+		return sdkerrors.Wrap(err, "latest MMR leaf")
+	}
+
+	if latestLeaf.parentNumber+1 != commitment.BlockNumber {
+		return sdkerrors.Wrapf(ErrInvalidMmrLeaf, "latest MMR leaf is the leaf of block %d, expected %d", latestLeaf.parentNumber+1, commitment.BlockNumber)
+	}
+
+	update.signedCommitment = proof.SignedCommitment
+	update.mmrRoot = mmrRoot
+	update.beefyHeight = commitment.BlockNumber
+
+	if update.signingAuthoritySet == cs.NextAuthoritySet {
+		nextAuthoritySet := latestLeaf.nextAuthoritySet
+		if nextAuthoritySet.Id != cs.NextAuthoritySet.Id+1 {
+			return sdkerrors.Wrapf(ErrInvalidAuthoritySet, "next authority set id %d must follow authority set id %d", nextAuthoritySet.Id, cs.NextAuthoritySet.Id)
+		}
+		if err := validateAuthoritySet(&nextAuthoritySet); err != nil {
+			return err
+		}
+
+		update.authority = cs.NextAuthoritySet
+		update.nextAuthoritySet = &nextAuthoritySet
+	}
+
+	return nil
+}
+
+// verifyMmrLeaf decodes the MMR leaf and proves it against the MMR root of the relay chain
+// block beefyHeight. The MMR has a leaf for every block from the beefy activation block on,
+// each leaf committing to the parent of its block.
+func (cs *ClientState) verifyMmrLeaf(proof *MmrLeafProof, mmrRoot []byte, beefyHeight uint32) (*mmrLeaf, error) {
+	leaf, err := decodeMmrLeaf(proof.Leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	blockNumber := uint64(leaf.parentNumber) + 1
+	if blockNumber < uint64(cs.BeefyActivationBlock) || blockNumber > uint64(beefyHeight) {
+		return nil, sdkerrors.Wrapf(ErrInvalidMmrLeaf, "leaf of block %d is not in the MMR of blocks %d to %d", blockNumber, cs.BeefyActivationBlock, beefyHeight)
+	}
+
+	leafIndex := blockNumber - uint64(cs.BeefyActivationBlock)
+	leafCount := uint64(beefyHeight) - uint64(cs.BeefyActivationBlock) + 1
+	if err := verifyMmrProof(mmrRoot, leaf.hash, leafIndex, leafCount, proof.Items); err != nil {
+		return nil, err
+	}
+
+	return leaf, nil
+}
+
+// UpdateState may be used to either create a consensus state for:
+// - a future height greater than the latest client state height
+// - a past height that was skipped during bisection
+// A consensus state is created for every parachain header included in the Header and the
+// MMR root and authority sets tracked by the client are advanced to the signed commitment.
+// A list containing the heights of the stored consensus states is returned.
+func (cs *ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
+	}
+
+	update, err := cs.processHeader(header)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "header must be verified before updating state"))
+	}
+
+	cs.MmrRootHash = update.mmrRoot
+	cs.LatestBeefyHeight = update.beefyHeight
+	cs.Authority = update.authority
+	cs.NextAuthoritySet = update.nextAuthoritySet
+
+	heights := make([]exported.Height, 0, len(update.heights))
+	for _, height := range update.heights {
+		heights = append(heights, height)
+
+		// check for duplicate update
+		if consensusState, _ := GetConsensusState(clientStore, cdc, height); consensusState != nil {
+			continue
+		}
+
+		setConsensusState(clientStore, cdc, update.consensusStates[height], height)
+		setConsensusMetadata(ctx, clientStore, height)
+
+		if uint32(height.RevisionHeight) > cs.LatestParaHeight {
+			cs.LatestParaHeight = uint32(height.RevisionHeight)
+		}
+	}
+
+	setClientState(clientStore, cdc, cs)
+
+	return heights
+}
+
+// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState at the
+// latest relay chain height known to the client. This method should only be called when
+// misbehaviour is detected as it does not perform any misbehaviour checks.
+func (cs *ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientMsg exported.ClientMessage) {
+	cs.XFrozenHeight = &ClientState_FrozenHeight{FrozenHeight: uint64(cs.LatestBeefyHeight)}
+
+	setClientState(clientStore, cdc, cs)
+}
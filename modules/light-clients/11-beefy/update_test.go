@@ -0,0 +1,255 @@
+package beefy_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	beefy "github.com/cosmos/ibc-go/v7/modules/light-clients/11-beefy"
+)
+
+func (suite *BeefyTestSuite) TestVerifyHeader() {
+	var header *beefy.Header
+
+	// resign replaces the commitment signatures with the signatures of the given authorities
+	resign := func(setID uint64, indices ...int) {
+		commitment := header.MmrUpdateProof.SignedCommitment.Commitment
+		commitment.ValidatorSetId = setID
+		header.MmrUpdateProof.SignedCommitment = suite.authoritySets[setID].sign(commitment, indices...)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful update", func() {}, true,
+		},
+		{
+			"successful update signed by all authorities", func() {
+				resign(0, 4, 3, 2, 1, 0)
+			},
+			true,
+		},
+		{
+			"successful update signed by the next authority set", func() {
+				resign(1, 0, 1, 2, 3)
+			},
+			true,
+		},
+		{
+			"successful MMR update without parachain headers", func() {
+				header.ParachainHeaders = nil
+			},
+			true,
+		},
+		{
+			"successful parachain headers proven against the latest MMR root", func() {
+				suite.clientState.MmrRootHash = suite.mmrRoot(commitmentBlock)
+				suite.clientState.LatestBeefyHeight = commitmentBlock
+				header.MmrUpdateProof = nil
+			},
+			true,
+		},
+		{
+			"empty header", func() {
+				header.MmrUpdateProof = nil
+				header.ParachainHeaders = nil
+			},
+			false,
+		},
+		{
+			"para id does not match", func() {
+				header.ParaId++
+			},
+			false,
+		},
+		{
+			"commitment is not newer than the latest beefy height", func() {
+				suite.clientState.LatestBeefyHeight = commitmentBlock
+			},
+			false,
+		},
+		{
+			"commitment signed by an unknown authority set", func() {
+				resign(2, 0, 1, 2, 3)
+			},
+			false,
+		},
+		{
+			"commitment without MMR root", func() {
+				header.MmrUpdateProof.SignedCommitment.Commitment.Payload[0].Id = []byte("xx")
+				resign(0, 0, 1, 2, 3)
+			},
+			false,
+		},
+		{
+			"not enough signatures", func() {
+				resign(0, 0, 1, 2)
+			},
+			false,
+		},
+		{
+			"duplicate signatures", func() {
+				resign(0, 0, 1, 2, 2)
+			},
+			false,
+		},
+		{
+			"signature of an authority of another set", func() {
+				resign(0, 0, 1, 2, 3)
+				header.MmrUpdateProof.SignedCommitment.Signatures[3] = suite.authoritySets[1].sign(
+					header.MmrUpdateProof.SignedCommitment.Commitment, 3,
+				).Signatures[0]
+			},
+			false,
+		},
+		{
+			"invalid signature", func() {
+				header.MmrUpdateProof.SignedCommitment.Signatures[0].Signature[0] ^= 0xff
+			},
+			false,
+		},
+		{
+			"invalid authority proof", func() {
+				header.MmrUpdateProof.SignedCommitment.Signatures[0].AuthorityProof[0][0] ^= 0xff
+			},
+			false,
+		},
+		{
+			"signed MMR root does not match", func() {
+				header.MmrUpdateProof.SignedCommitment.Commitment.Payload[0].Data = suite.mmrRoot(commitmentBlock - 1)
+				resign(0, 0, 1, 2, 3)
+			},
+			false,
+		},
+		{
+			"latest MMR leaf is not the leaf of the commitment block", func() {
+				header.MmrUpdateProof.LatestMmrLeaf = suite.mmrLeafProof(commitmentBlock-1, commitmentBlock)
+			},
+			false,
+		},
+		{
+			"invalid MMR leaf proof", func() {
+				header.ParachainHeaders[0].MmrLeafProof.Items[0][0] ^= 0xff
+			},
+			false,
+		},
+		{
+			"MMR leaf proof with unused items", func() {
+				leafProof := header.ParachainHeaders[0].MmrLeafProof
+				leafProof.Items = append(leafProof.Items, leafProof.Items[0])
+			},
+			false,
+		},
+		{
+			"MMR leaf does not commit to the relay header", func() {
+				header.ParachainHeaders[0].MmrLeafProof = header.ParachainHeaders[1].MmrLeafProof
+			},
+			false,
+		},
+		{
+			"parachain headers beyond the latest MMR root", func() {
+				header.MmrUpdateProof = nil
+			},
+			false,
+		},
+		{
+			"invalid parachain state proof", func() {
+				header.ParachainHeaders[0].ParachainHeader.StateProof = [][]byte{{0}}
+			},
+			false,
+		},
+		{
+			"para height does not match latest parachain header", func() {
+				header.ParaHeight++
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			header = suite.header()
+
+			tc.malleate()
+
+			err := suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BeefyTestSuite) TestUpdateState() {
+	header := suite.header()
+	suite.Require().NoError(suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header))
+	suite.Require().False(suite.clientState.CheckForMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, header))
+
+	heights := suite.clientState.UpdateState(suite.ctx, suite.chainA.Codec, suite.store, header)
+	suite.Require().Equal([]exported.Height{
+		clienttypes.NewHeight(paraID, 34),
+		clienttypes.NewHeight(paraID, 37),
+		clienttypes.NewHeight(paraID, 39),
+	}, heights)
+
+	suite.Require().Equal(suite.mmrRoot(commitmentBlock), suite.clientState.MmrRootHash)
+	suite.Require().Equal(uint32(commitmentBlock), suite.clientState.LatestBeefyHeight)
+	suite.Require().Equal(suite.authoritySets[0].beefyAuthoritySet(), suite.clientState.Authority)
+	suite.Require().Equal(suite.authoritySets[1].beefyAuthoritySet(), suite.clientState.NextAuthoritySet)
+	suite.Require().Equal(clienttypes.NewHeight(paraID, 39), suite.clientState.GetLatestHeight())
+
+	for _, height := range heights {
+		consensusState, found := beefy.GetConsensusState(suite.store, suite.chainA.Codec, height)
+		suite.Require().True(found)
+		suite.Require().NoError(consensusState.ValidateBasic())
+	}
+
+	clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.clientState, clientState)
+	suite.Require().Equal(exported.Active, clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+
+	// the same commitment cannot be applied twice
+	err := suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header)
+	suite.Require().Error(err)
+
+	// parachain headers can be proven against the latest MMR root
+	header.MmrUpdateProof = nil
+	suite.Require().NoError(suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header))
+	suite.Require().False(suite.clientState.CheckForMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, header))
+}
+
+func (suite *BeefyTestSuite) TestUpdateStateAuthoritySetChange() {
+	header := suite.header()
+	commitment := header.MmrUpdateProof.SignedCommitment.Commitment
+	commitment.ValidatorSetId = 1
+	header.MmrUpdateProof.SignedCommitment = suite.authoritySets[1].sign(commitment, 0, 1, 2, 3)
+	suite.Require().NoError(suite.clientState.VerifyClientMessage(suite.ctx, suite.chainA.Codec, suite.store, header))
+
+	suite.clientState.UpdateState(suite.ctx, suite.chainA.Codec, suite.store, header)
+
+	// the next authority set becomes the current one and the next one is taken from the latest MMR leaf
+	suite.Require().Equal(suite.authoritySets[1].beefyAuthoritySet(), suite.clientState.Authority)
+	suite.Require().Equal(suite.authoritySets[2].beefyAuthoritySet(), suite.clientState.NextAuthoritySet)
+	suite.Require().NoError(suite.clientState.Validate())
+}
+
+func (suite *BeefyTestSuite) TestCheckForMisbehaviour() {
+	header := suite.header()
+	suite.Require().False(suite.clientState.CheckForMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, header))
+
+	// store a conflicting consensus state at the height of the first parachain header
+	conflicting := beefy.NewConsensusState(suite.ctx.BlockTime(), make([]byte, 32))
+	suite.clientState.LatestParaHeight = 34
+	suite.Require().NoError(suite.clientState.Initialize(suite.ctx, suite.chainA.Codec, suite.store, conflicting))
+	suite.Require().True(suite.clientState.CheckForMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, header))
+
+	suite.clientState.UpdateStateOnMisbehaviour(suite.ctx, suite.chainA.Codec, suite.store, header)
+	suite.Require().Equal(&beefy.ClientState_FrozenHeight{FrozenHeight: initialBeefyHeight}, suite.clientState.XFrozenHeight)
+	suite.Require().Equal(exported.Frozen, suite.clientState.Status(suite.ctx, suite.store, suite.chainA.Codec))
+}
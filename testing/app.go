@@ -50,6 +50,7 @@ type TestingApp interface {
 	// Implemented by BaseApp
 	LastCommitID() storetypes.CommitID
 	LastBlockHeight() int64
+	Close() error
 }
 
 func SetupTestingApp() (TestingApp, map[string]json.RawMessage) {
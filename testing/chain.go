@@ -125,6 +125,11 @@ func NewTestChainWithValSet(t *testing.T, coord *Coordinator, chainID string, va
 
 	app := SetupWithGenesisValSet(t, valSet, genAccs, chainID, sdk.DefaultPowerReduction, genBals...)
 
+	// release the resources of the app, e.g. the 08-wasm VM, once the test has finished
+	t.Cleanup(func() {
+		require.NoError(t, app.Close())
+	})
+
 	// create current header and call begin block
 	header := tmproto.Header{
 		ChainID: chainID,
@@ -14,6 +14,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik" // this is used for serving docs
@@ -376,8 +377,29 @@ func NewSimApp(
 	// IBC Keepers
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibcexported.StoreKey], app.GetSubspace(ibcexported.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	wasmVMConfig, err := ibcwasmtypes.ReadVMConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm config: %s", err))
+	}
+	app.WasmClientKeeper = ibcwasmkeeper.NewKeeper(
+		appCodec, keys[ibcwasmtypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String(), homePath, &app.IBCKeeper.ClientKeeper,
+		ibcwasmkeeper.WithVMConfig(wasmVMConfig),
+		ibcwasmkeeper.WithQueryRouter(app.GRPCQueryRouter()),
 	)
 
+	// the client hooks must be set before the client keeper is passed to the proposal handler
+	app.IBCKeeper.ClientKeeper.SetHooks(
+		ibcclienttypes.NewMultiClientHooks(
+			app.WasmClientKeeper,
+		),
+	)
+
+	// 08-wasm client states receive the engine of the wasm keeper through the context
+	app.IBCKeeper.ClientKeeper.SetContextDecorator(app.WasmClientKeeper.ClientContext)
+
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
@@ -411,8 +433,6 @@ func NewSimApp(
 	*/
 	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper, groupConfig)
 
-	app.WasmClientKeeper = ibcwasmkeeper.NewKeeper(appCodec, keys[ibcwasmtypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String(), homePath, &app.IBCKeeper.ClientKeeper)
-
 	// IBC Fee Module keeper
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		appCodec, keys[ibcfeetypes.StoreKey],
@@ -678,10 +698,23 @@ func NewSimApp(
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
 
+	// the 08-wasm snapshotter restores the wasm codes in the VM of nodes which state sync
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(ibcwasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.WasmClientKeeper)); err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// pin the 08-wasm codes marked as pinned in the VM memory cache
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmClientKeeper.InitializePinnedCodes(ctx); err != nil {
+			tmos.Exit(fmt.Sprintf("failed to initialize pinned codes: %s", err))
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
@@ -815,6 +848,12 @@ func (app *SimApp) GetIBCKeeper() *ibckeeper.Keeper {
 	return app.IBCKeeper
 }
 
+// Close releases the resources of the app, including the VM of the 08-wasm keeper.
+func (app *SimApp) Close() error {
+	app.WasmClientKeeper.Cleanup()
+	return app.BaseApp.Close()
+}
+
 // GetWasmKeeper implements the TestingApp interface.
 func (app *SimApp) GetWasmKeeper() ibcwasmkeeper.Keeper {
 	return app.WasmClientKeeper
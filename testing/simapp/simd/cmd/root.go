@@ -36,6 +36,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
+	ibcwasmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/08-wasm/types"
 	"github.com/cosmos/ibc-go/v7/testing/simapp"
 	"github.com/cosmos/ibc-go/v7/testing/simapp/params"
 )
@@ -118,6 +119,8 @@ func initAppConfig() (string, interface{}) {
 		serverconfig.Config
 
 		WASM WASMConfig `mapstructure:"wasm"`
+
+		WasmClient ibcwasmtypes.VMConfig `mapstructure:"ibc-wasm"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -143,6 +146,7 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
+		WasmClient: ibcwasmtypes.DefaultVMConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -151,7 +155,7 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0` + ibcwasmtypes.DefaultConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }